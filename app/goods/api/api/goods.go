@@ -9,7 +9,7 @@ import (
 	"github.com/jimyag/shop/common/model"
 	"github.com/jimyag/shop/common/proto"
 	"github.com/jimyag/shop/common/utils/handle_grpc_error"
-	"github.com/jimyag/shop/common/utils/paseto"
+	"github.com/jimyag/shop/common/utils/money"
 	"github.com/jimyag/shop/common/utils/validate"
 )
 
@@ -19,17 +19,6 @@ import (
 //  @param ctx
 //
 func CreateGoods(ctx *gin.Context) {
-	payload, err := paseto.GetPayloadFormCtx(ctx)
-	if err != nil {
-		model.FailWithMsg("权限不足", ctx)
-		return
-	}
-
-	if payload.Role == 1 {
-		model.FailWithMsg("权限不足", ctx)
-		return
-	}
-
 	createGoodsRequest := request.CreateGoods{}
 	_ = ctx.ShouldBindJSON(&createGoodsRequest)
 	msg, err := validate.Validate(createGoodsRequest, global.Validate, global.Trans)
@@ -38,8 +27,11 @@ func CreateGoods(ctx *gin.Context) {
 		return
 	}
 	in := proto.CreateGoodRequest{
-		Name:  createGoodsRequest.Name,
-		Price: createGoodsRequest.Price,
+		Name:          createGoodsRequest.Name,
+		PriceCents:    money.FromMajor(createGoodsRequest.Price, createGoodsRequest.Currency),
+		Currency:      createGoodsRequest.Currency,
+		Weight:        createGoodsRequest.Weight,
+		PurchaseLimit: createGoodsRequest.PurchaseLimit,
 	}
 	goodsInfo, err := global.GoodsSrvClient.CreateGoods(ctx, &in)
 	if err != nil {
@@ -56,17 +48,6 @@ func CreateGoods(ctx *gin.Context) {
 //  @param ctx
 //
 func UpdateGoodsInfo(ctx *gin.Context) {
-	payload, err := paseto.GetPayloadFormCtx(ctx)
-	if err != nil {
-		model.FailWithMsg("权限不足", ctx)
-		return
-	}
-
-	if payload.Role == 1 {
-		model.FailWithMsg("权限不足", ctx)
-		return
-	}
-
 	arg := request.UpdateGoods{}
 	_ = ctx.ShouldBindJSON(&arg)
 	msg, err := validate.Validate(&arg, global.Validate, global.Trans)
@@ -76,9 +57,12 @@ func UpdateGoodsInfo(ctx *gin.Context) {
 	}
 
 	in := proto.GoodsInfo{
-		Id:    arg.ID,
-		Name:  arg.Name,
-		Price: arg.Price,
+		Id:            arg.ID,
+		Name:          arg.Name,
+		PriceCents:    money.FromMajor(arg.Price, arg.Currency),
+		Currency:      arg.Currency,
+		Weight:        arg.Weight,
+		PurchaseLimit: arg.PurchaseLimit,
 	}
 	goodsInfo, err := global.GoodsSrvClient.UpdateGoods(ctx, &in)
 	if err != nil {
@@ -118,17 +102,6 @@ func GetGoods(ctx *gin.Context) {
 //  @param ctx
 //
 func DeleteGoods(ctx *gin.Context) {
-	payload, err := paseto.GetPayloadFormCtx(ctx)
-	if err != nil {
-		model.FailWithMsg("权限不足", ctx)
-		return
-	}
-
-	if payload.Role == 1 {
-		model.FailWithMsg("权限不足", ctx)
-		return
-	}
-
 	goodsID := request.GoodsIDRequest{}
 	_ = ctx.ShouldBindJSON(&goodsID)
 
@@ -163,6 +136,7 @@ func GetBatchGoods(ctx *gin.Context) {
 
 	in := proto.ManyGoodsID{
 		GoodsIDs: make([]*proto.GoodID, 0),
+		Currency: arg.Currency,
 	}
 	for _, idRequest := range arg.GoodsBatchID {
 		goodId := proto.GoodID{Id: idRequest.ID}
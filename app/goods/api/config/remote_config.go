@@ -24,9 +24,10 @@ type JaegerConfig struct {
 //  @Description: token的配置
 //
 type Secret struct {
-	PrivateKey string `mapstructure:"private-key"` // token的 私钥
-	PublicKey  string `mapstructure:"public-key"`  // token的 公钥
-	Duration   int    `mapstructure:"duration"`    // token的过期时间 单位 小时
+	PrivateKey      string `mapstructure:"private-key"`      // token的 私钥
+	PublicKey       string `mapstructure:"public-key"`       // token的 公钥
+	Duration        int    `mapstructure:"duration"`         // access token的过期时间 单位 分钟
+	RefreshDuration int    `mapstructure:"refresh-duration"` // refresh token的过期时间 单位 小时
 }
 
 //
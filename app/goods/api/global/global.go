@@ -8,6 +8,7 @@ import (
 	"github.com/jimyag/shop/app/goods/api/config"
 	"github.com/jimyag/shop/common/model"
 	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
 	"github.com/jimyag/shop/common/utils/paseto"
 )
 
@@ -19,4 +20,6 @@ var (
 	Trans          ut.Translator           // 公共的翻译
 	Validate       *validator.Validate     // 公共的validate
 	PasetoMaker    *paseto.PasetoMaker     // paseto 的maker
+	Revoker        *paseto.Revoker         // 检查 token 是否被吊销
+	Auth           *auth.Authenticator     // 验证 token 的中间件
 )
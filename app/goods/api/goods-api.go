@@ -27,15 +27,15 @@ func main() {
 	// 初始化 grpc 的 client
 	initialize.InitGrpcClient()
 
+	// 初始化Paseto 路由中使用的认证中间件依赖它
+	initialize.InitPaseto()
+
 	// 初始化router
 	router := initialize.InitRouter()
 
 	// 初始化 validate 和 trans
 	initialize.InitValidateAndTrans()
 
-	// 初始化Paseto
-	initialize.InitPaseto()
-
 	registerClient := consul.NewRegistryHttpClient(
 		global.ConfigCenter.Host,
 		global.ConfigCenter.Port,
@@ -1,13 +1,17 @@
 package initialize
 
 import (
+	"context"
 	"crypto/ed25519"
 	"encoding/hex"
+	"fmt"
 	"time"
 
+	"github.com/go-redis/redis/v8"
 	"go.uber.org/zap"
 
 	"github.com/jimyag/shop/app/goods/api/global"
+	"github.com/jimyag/shop/common/utils/auth"
 	"github.com/jimyag/shop/common/utils/paseto"
 )
 
@@ -28,9 +32,31 @@ func InitPaseto() {
 	}
 	publicKey := ed25519.PublicKey(b)
 
-	global.PasetoMaker, err = paseto.NewPasetoMaker(privateKey, publicKey, time.Duration(global.RemoteConfig.Secret.Duration))
+	refreshDuration := time.Duration(global.RemoteConfig.Secret.RefreshDuration) * time.Hour
+	global.PasetoMaker, err = paseto.NewPasetoMaker(
+		privateKey,
+		publicKey,
+		time.Duration(global.RemoteConfig.Secret.Duration)*time.Minute,
+		refreshDuration,
+	)
 	if err != nil {
 		global.Logger.Fatal("初始化PASETO失败", zap.Error(err))
 	}
+
+	// 吊销的 token 记录在 redis 中，所有服务共用
+	client := redis.NewClient(&redis.Options{
+		Addr: fmt.Sprintf(
+			"%s:%d",
+			global.RemoteConfig.Redis.Host,
+			global.RemoteConfig.Redis.Port,
+		),
+		DB: 0,
+	})
+	_, err = client.Ping(context.Background()).Result()
+	if err != nil {
+		global.Logger.Fatal("初始化token吊销的redis失败", zap.Error(err))
+	}
+	global.Revoker = paseto.NewRevoker(client, refreshDuration)
+	global.Auth = auth.NewAuthenticator(global.PasetoMaker, global.Revoker, global.Logger)
 	global.Logger.Info("初始化PASETO成功......")
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/jimyag/shop/app/goods/api/global"
 	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
 	"github.com/jimyag/shop/common/utils/otgrpc"
 )
 
@@ -35,10 +36,12 @@ func initUserClient() {
 		),
 		grpc.WithInsecure(),
 		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
-		grpc.WithUnaryInterceptor(
+		grpc.WithChainUnaryInterceptor(
 			otgrpc.OpenTracingClientInterceptor(
 				opentracing.GlobalTracer(),
 			),
+			// 把当前用户的身份传给 rpc 服务
+			auth.UnaryClientInterceptor(),
 		),
 	)
 
@@ -5,8 +5,11 @@ package request
 //  @Description: 创建商品的请求
 //
 type CreateGoods struct {
-	Name  string  `json:"name" validate:"required" label:"商品名称"`
-	Price float32 `json:"price" validate:"required,min=0.1" label:"商品价格"`
+	Name          string  `json:"name" validate:"required" label:"商品名称"`
+	Price         float64 `json:"price" validate:"required,min=0.01" label:"商品价格"`
+	Currency      string  `json:"currency" validate:"omitempty,len=3" label:"币种"`
+	Weight        int32   `json:"weight" validate:"omitempty,min=0" label:"重量"`
+	PurchaseLimit int32   `json:"purchase_limit" validate:"omitempty,min=0" label:"限购数量"`
 }
 
 //
@@ -14,9 +17,12 @@ type CreateGoods struct {
 //  @Description: 更新商品信息
 //
 type UpdateGoods struct {
-	ID    int32   `json:"id" validate:"required,min=1" label:"商品ID"`
-	Name  string  `json:"name" validate:"required" label:"商品名称"`
-	Price float32 `json:"price" validate:"required,min=0.1" label:"商品价格"`
+	ID            int32   `json:"id" validate:"required,min=1" label:"商品ID"`
+	Name          string  `json:"name" validate:"required" label:"商品名称"`
+	Price         float64 `json:"price" validate:"required,min=0.01" label:"商品价格"`
+	Currency      string  `json:"currency" validate:"omitempty,len=3" label:"币种"`
+	Weight        int32   `json:"weight" validate:"omitempty,min=0" label:"重量"`
+	PurchaseLimit int32   `json:"purchase_limit" validate:"omitempty,min=-1" label:"限购数量"` // -1 表示取消限购
 }
 
 //
@@ -33,4 +39,5 @@ type GoodsIDRequest struct {
 //
 type GoodsBatchRequest struct {
 	GoodsBatchID []GoodsIDRequest `json:"goods_batch_id" validate:"required" label:"批量查询ID"`
+	Currency     string           `json:"currency" validate:"omitempty,len=3" label:"币种"`
 }
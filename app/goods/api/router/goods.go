@@ -4,7 +4,9 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/jimyag/shop/app/goods/api/api"
+	"github.com/jimyag/shop/app/goods/api/global"
 	"github.com/jimyag/shop/app/goods/api/middlewares"
+	"github.com/jimyag/shop/common/utils/auth"
 )
 
 func GoodsRouter(router *gin.RouterGroup) {
@@ -18,10 +20,10 @@ func GoodsRouter(router *gin.RouterGroup) {
 	}
 
 	privateRouter := baseRouter.Group("goods")
-	privateRouter.Use(middlewares.Paseto())
+	privateRouter.Use(global.Auth.Paseto())
 	{
-		privateRouter.POST("create", api.CreateGoods)
-		privateRouter.PUT("info", api.UpdateGoodsInfo)
-		privateRouter.DELETE("info", api.DeleteGoods)
+		privateRouter.POST("create", auth.RequirePermission(auth.PermGoodsWrite), api.CreateGoods)
+		privateRouter.PUT("info", auth.RequirePermission(auth.PermGoodsWrite), api.UpdateGoodsInfo)
+		privateRouter.DELETE("info", auth.RequirePermission(auth.PermGoodsWrite), api.DeleteGoods)
 	}
 }
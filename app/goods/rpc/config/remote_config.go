@@ -32,12 +32,22 @@ type JaegerConfig struct {
 	Port int    `mapstructure:"port"` //port
 }
 
+//
+// CurrencyConfig
+//  @Description: 币种和汇率的配置
+//
+type CurrencyConfig struct {
+	Base  string             `mapstructure:"base"`  // 基准货币
+	Rates map[string]float64 `mapstructure:"rates"` // 1 单位基准货币可以兑换多少该币种
+}
+
 //
 // ALLConfig
 //  @Description: 需要用的远程配置文件
 //
 type ALLConfig struct {
-	Postgres    Postgres     `mapstructure:"postgres"`     // postgres 的配置
-	ServiceInfo ServiceInfo  `mapstructure:"service-info"` // 服务的配置
-	JaegerInfo  JaegerConfig `mapstructure:"jaeger-info"`  // jaeger的配置文件
+	Postgres    Postgres       `mapstructure:"postgres"`     // postgres 的配置
+	ServiceInfo ServiceInfo    `mapstructure:"service-info"` // 服务的配置
+	JaegerInfo  JaegerConfig   `mapstructure:"jaeger-info"`  // jaeger的配置文件
+	Currency    CurrencyConfig `mapstructure:"currency"`     // 币种和汇率
 }
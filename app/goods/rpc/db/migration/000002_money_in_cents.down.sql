@@ -0,0 +1,2 @@
+ALTER TABLE "goods"
+    ALTER COLUMN "price" TYPE float USING "price"::float / 100;
@@ -0,0 +1,3 @@
+-- 商品价格改为以分为单位的整数，避免浮点数的误差
+ALTER TABLE "goods"
+    ALTER COLUMN "price" TYPE int8 USING round("price"::numeric * 100)::int8;
@@ -0,0 +1,2 @@
+ALTER TABLE "goods"
+    ADD COLUMN "currency" varchar(3) NOT NULL DEFAULT 'CNY'; -- 商品价格的币种 ISO 4217
@@ -0,0 +1,2 @@
+ALTER TABLE "goods"
+    ADD COLUMN "weight" int4 NOT NULL DEFAULT 0; -- 商品的重量 单位 克 用于计算运费
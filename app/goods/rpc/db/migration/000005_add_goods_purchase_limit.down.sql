@@ -0,0 +1,2 @@
+ALTER TABLE "goods"
+    DROP COLUMN IF EXISTS "purchase_limit";
@@ -0,0 +1,2 @@
+ALTER TABLE "goods"
+    ADD COLUMN "purchase_limit" int4 NOT NULL DEFAULT 0; -- 每个用户每次最多可以购买的数量 0 表示不限购
@@ -13,8 +13,8 @@ WHERE name = $1
 ;
 
 -- name: CreateGoods :one
-INSERT INTO "goods"(name, price)
-VALUES ($1, $2) returning *;
+INSERT INTO "goods"(name, price, currency, weight, purchase_limit)
+VALUES ($1, $2, $3, $4, $5) returning *;
 
 -- name: DeleteGoods :one
 UPDATE "goods"
@@ -23,8 +23,17 @@ where id = $2 returning *;
 
 -- name: UpdateGoods :one
 UPDATE "goods"
-SET updated_at = $1,
-    name       = $2,
-    price      = $3
-WHERE id = $4
+SET updated_at     = $1,
+    name           = $2,
+    price          = $3,
+    currency       = $4,
+    weight         = $5,
+    purchase_limit = $6
+WHERE id = $7
   and deleted_at IS NULL returning *;
+
+-- name: GetGoodsByIDsWithDeleted :many
+SELECT *
+FROM "goods"
+WHERE id = ANY (sqlc.arg(ids)::bigint[])
+;
@@ -7,6 +7,7 @@ import (
 
 	remoteConfig "github.com/jimyag/shop/app/goods/rpc/config"
 	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/utils/money"
 )
 
 var (
@@ -14,4 +15,5 @@ var (
 	RemoteConfig *remoteConfig.ALLConfig //远程配置中心里面的配置
 	ConfigCenter *model.ConfigCenterInfo //配置中心的位置信息
 	DB           *sql.DB                 // database
+	RateProvider money.RateProvider      // 汇率
 )
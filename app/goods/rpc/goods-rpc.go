@@ -37,6 +37,9 @@ func main() {
 	// 初始化 database
 	initialize.InitDataBase()
 
+	// 初始化汇率
+	initialize.InitRateProvider()
+
 	// 初始化jaeger
 	tracer, cl, err := initialize.InitJaeger()
 	if err != nil {
@@ -54,7 +57,7 @@ func main() {
 	// 数据库的连接
 	sqlStore := model.NewSQLStore(global.DB)
 
-	goodsServer := handler.NewGoodsServer(sqlStore)
+	goodsServer := handler.NewGoodsServer(sqlStore, global.RateProvider)
 	proto.RegisterGoodsServer(grpcServer, goodsServer)
 
 	// 优先使用配置的端口
@@ -12,6 +12,7 @@ import (
 	"github.com/jimyag/shop/app/goods/rpc/global"
 	"github.com/jimyag/shop/app/goods/rpc/model"
 	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/money"
 )
 
 //
@@ -20,11 +21,13 @@ import (
 //
 type GoodsServer struct {
 	model.Store
+	RateProvider money.RateProvider // 汇率
 }
 
-func NewGoodsServer(store model.Store) *GoodsServer {
+func NewGoodsServer(store model.Store, rateProvider money.RateProvider) *GoodsServer {
 	return &GoodsServer{
-		store,
+		Store:        store,
+		RateProvider: rateProvider,
 	}
 }
 
@@ -50,9 +53,16 @@ func (server *GoodsServer) CreateGoods(ctx context.Context, req *proto.CreateGoo
 		return &proto.GoodsInfo{}, status.Error(codes.Internal, "内部错误")
 	}
 
+	currency, err := server.checkCurrency(req.Currency)
+	if err != nil {
+		return &proto.GoodsInfo{}, err
+	}
 	arg := model.CreateGoodsParams{
-		Name:  req.Name,
-		Price: float64(req.Price),
+		Name:          req.Name,
+		Price:         requestPriceCents(req.PriceCents, req.Price, currency),
+		Currency:      currency,
+		Weight:        req.Weight,
+		PurchaseLimit: req.PurchaseLimit,
 	}
 	goods, err := server.Store.CreateGoods(ctx, arg)
 	if err != nil {
@@ -60,12 +70,7 @@ func (server *GoodsServer) CreateGoods(ctx context.Context, req *proto.CreateGoo
 		return &proto.GoodsInfo{}, status.Error(codes.Internal, "内部错误")
 	}
 
-	response := proto.GoodsInfo{
-		Id:    int32(goods.ID),
-		Name:  goods.Name,
-		Price: float32(goods.Price),
-	}
-	return &response, nil
+	return goodsModel2Info(goods), nil
 }
 
 //
@@ -88,12 +93,34 @@ func (server *GoodsServer) UpdateGoods(ctx context.Context, req *proto.GoodsInfo
 		return &proto.GoodsInfo{}, status.Error(codes.Internal, "内部错误")
 	}
 
+	// 没有传币种时保持原来的币种
+	currency := goods.Currency
+	if req.Currency != "" {
+		currency, err = server.checkCurrency(req.Currency)
+		if err != nil {
+			return &proto.GoodsInfo{}, err
+		}
+	}
+
 	arg := model.UpdateGoodsParams{
 		UpdatedAt: time.Now(),
 		Name:      req.Name,
-		Price:     float64(req.Price),
+		Price:     requestPriceCents(req.PriceCents, req.Price, currency),
+		Currency:  currency,
+		Weight:    goods.Weight,
 		ID:        int64(req.Id),
 	}
+	// 没有传重量时保持原来的重量
+	if req.Weight > 0 {
+		arg.Weight = req.Weight
+	}
+	// 没有传限购数量时保持原来的限购，小于 0 时取消限购
+	arg.PurchaseLimit = goods.PurchaseLimit
+	if req.PurchaseLimit > 0 {
+		arg.PurchaseLimit = req.PurchaseLimit
+	} else if req.PurchaseLimit < 0 {
+		arg.PurchaseLimit = 0
+	}
 
 	goods, err = server.Store.UpdateGoods(ctx, arg)
 	if err != nil {
@@ -101,12 +128,7 @@ func (server *GoodsServer) UpdateGoods(ctx context.Context, req *proto.GoodsInfo
 		return &proto.GoodsInfo{}, status.Error(codes.Internal, "内部错误")
 	}
 
-	response := proto.GoodsInfo{
-		Id:    int32(goods.ID),
-		Name:  goods.Name,
-		Price: float32(goods.Price),
-	}
-	return &response, nil
+	return goodsModel2Info(goods), nil
 }
 
 //
@@ -119,7 +141,6 @@ func (server *GoodsServer) UpdateGoods(ctx context.Context, req *proto.GoodsInfo
 //  @return error
 //
 func (server *GoodsServer) GetGoods(ctx context.Context, req *proto.GoodID) (*proto.GoodsInfo, error) {
-	response := proto.GoodsInfo{}
 	goods, err := server.Store.GetGoodsByID(ctx, int64(req.Id))
 	if errors.Is(err, sql.ErrNoRows) {
 		return &proto.GoodsInfo{}, status.Error(codes.NotFound, "没有找到该商品")
@@ -129,10 +150,7 @@ func (server *GoodsServer) GetGoods(ctx context.Context, req *proto.GoodID) (*pr
 		return &proto.GoodsInfo{}, status.Error(codes.Internal, "内部错误")
 	}
 
-	response.Id = int32(goods.ID)
-	response.Price = float32(goods.Price)
-	response.Name = goods.Name
-	return &response, nil
+	return goodsModel2Info(goods), nil
 }
 
 //
@@ -175,6 +193,29 @@ func (server *GoodsServer) GetGoodsBatchInfo(ctx context.Context, req *proto.Man
 	rsp := proto.ManyGoodsInfos{
 		Data: make([]*proto.GoodsInfo, 0),
 	}
+	// 返回已删除的商品，不存在的商品直接忽略
+	if req.GetWithDeleted() {
+		ids := make([]int64, 0, len(req.GoodsIDs))
+		for _, d := range req.GoodsIDs {
+			ids = append(ids, int64(d.GetId()))
+		}
+		goodsList, err := server.Store.GetGoodsByIDsWithDeleted(ctx, ids)
+		if err != nil {
+			global.Logger.Error(err.Error())
+			return &rsp, status.Error(codes.Internal, "内部错误")
+		}
+		for _, goods := range goodsList {
+			info := goodsModel2Info(goods)
+			info.Deleted = goods.DeletedAt.Valid
+			rsp.Data = append(rsp.Data, info)
+		}
+		rsp.Total = int32(len(rsp.Data))
+		if err = server.convertGoodsInfos(&rsp, req.Currency); err != nil {
+			return &rsp, err
+		}
+		return &rsp, nil
+	}
+
 	var err error
 	err = server.Store.ExecTx(ctx, func(queries *model.Queries) error {
 		for _, d := range req.GoodsIDs {
@@ -182,12 +223,7 @@ func (server *GoodsServer) GetGoodsBatchInfo(ctx context.Context, req *proto.Man
 			if getGoodErr != nil {
 				return getGoodErr
 			}
-			info := proto.GoodsInfo{
-				Id:    int32(goods.ID),
-				Name:  goods.Name,
-				Price: float32(goods.Price),
-			}
-			rsp.Data = append(rsp.GetData(), &info)
+			rsp.Data = append(rsp.GetData(), goodsModel2Info(goods))
 		}
 		return nil
 	})
@@ -195,5 +231,98 @@ func (server *GoodsServer) GetGoodsBatchInfo(ctx context.Context, req *proto.Man
 	if err != nil {
 		return &rsp, status.Error(codes.Unknown, err.Error())
 	}
+	if err = server.convertGoodsInfos(&rsp, req.Currency); err != nil {
+		return &rsp, err
+	}
 	return &rsp, nil
 }
+
+//
+// checkCurrency
+//  @Description: 检查币种是否支持，为空时使用基准货币
+//  @receiver server
+//  @param currency
+//  @return string
+//  @return error
+//
+func (server *GoodsServer) checkCurrency(currency string) (string, error) {
+	currency = money.NormalizeCurrency(currency)
+	if currency == "" {
+		return server.RateProvider.Base(), nil
+	}
+	if _, err := server.RateProvider.Rate(server.RateProvider.Base(), currency); err != nil {
+		return "", status.Error(codes.InvalidArgument, "不支持的币种")
+	}
+	return currency, nil
+}
+
+//
+// convertGoodsInfos
+//  @Description: 将所有商品的价格转换为同一个币种，并返回使用的汇率
+//  @receiver server
+//  @param rsp
+//  @param currency
+//  @return error
+//
+func (server *GoodsServer) convertGoodsInfos(rsp *proto.ManyGoodsInfos, currency string) error {
+	currency, err := server.checkCurrency(currency)
+	if err != nil {
+		return err
+	}
+	base := server.RateProvider.Base()
+	rate, err := server.RateProvider.Rate(base, currency)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "不支持的币种")
+	}
+	rsp.Currency = currency
+	rsp.ExchangeRate = rate
+	rsp.BaseCurrency = base
+
+	for _, info := range rsp.Data {
+		if info.Currency == currency {
+			continue
+		}
+		priceCents, _, err := money.Convert(info.PriceCents, info.Currency, currency, server.RateProvider)
+		if err != nil {
+			global.Logger.Error("商品的币种没有汇率 " + info.Currency)
+			return status.Error(codes.Internal, "内部错误")
+		}
+		info.PriceCents = priceCents
+		info.Price = float32(money.ToMajor(priceCents, currency))
+		info.Currency = currency
+	}
+	return nil
+}
+
+//
+// requestPriceCents
+//  @Description: 优先使用以分为单位的价格，旧的客户端只传了 float 的价格时再转换
+//  @param priceCents
+//  @param price
+//  @param currency
+//  @return int64
+//
+func requestPriceCents(priceCents int64, price float32, currency string) int64 {
+	if priceCents != 0 {
+		return priceCents
+	}
+	return money.FromMajor(float64(price), currency)
+}
+
+//
+// goodsModel2Info
+//  @Description: 商品的 model 转换为 proto，同时填充旧的 float 价格
+//  @param goods
+//  @return *proto.GoodsInfo
+//
+func goodsModel2Info(goods model.Good) *proto.GoodsInfo {
+	return &proto.GoodsInfo{
+		Id:            int32(goods.ID),
+		Name:          goods.Name,
+		Price:         float32(money.ToMajor(goods.Price, goods.Currency)),
+		PriceCents:    goods.Price,
+		Currency:      goods.Currency,
+		Weight:        goods.Weight,
+		PurchaseLimit: goods.PurchaseLimit,
+	}
+}
@@ -12,13 +12,13 @@ import (
 
 func createGoods(t *testing.T) *proto.GoodsInfo {
 	in := proto.CreateGoodRequest{
-		Name:  test_util.RandomString(20),
-		Price: test_util.RandomPrice(),
+		Name:       test_util.RandomString(20),
+		PriceCents: test_util.RandomPriceCents(),
 	}
 	goods, err := goodsClient.CreateGoods(context.Background(), &in)
 	require.NoError(t, err)
 	require.NotNil(t, goods)
-	require.Equal(t, in.Price, goods.Price)
+	require.Equal(t, in.PriceCents, goods.PriceCents)
 	require.Equal(t, in.Name, goods.Name)
 	return goods
 }
@@ -27,13 +27,25 @@ func TestGoodsServer_CreateGoods(t *testing.T) {
 
 	goods := createGoods(t)
 	in := proto.CreateGoodRequest{
-		Name:  goods.Name,
-		Price: goods.Price,
+		Name:       goods.Name,
+		PriceCents: goods.PriceCents,
 	}
 	_, err := goodsClient.CreateGoods(context.Background(), &in)
 	require.Error(t, err)
 }
 
+func TestGoodsServer_CreateGoodsWithFloatPrice(t *testing.T) {
+	// 旧的客户端只传 float 的价格
+	in := proto.CreateGoodRequest{
+		Name:  test_util.RandomString(20),
+		Price: 19.99,
+	}
+	goods, err := goodsClient.CreateGoods(context.Background(), &in)
+	require.NoError(t, err)
+	require.Equal(t, int64(1999), goods.PriceCents)
+	require.Equal(t, float32(19.99), goods.Price)
+}
+
 func TestGoodsServer_GetGoods(t *testing.T) {
 	goods := createGoods(t)
 	getGoods, err := goodsClient.GetGoods(context.Background(), &proto.GoodID{Id: goods.GetId()})
@@ -44,15 +56,15 @@ func TestGoodsServer_GetGoods(t *testing.T) {
 func TestGoodsServer_UpdateGoods(t *testing.T) {
 	goods := createGoods(t)
 	arg := proto.GoodsInfo{
-		Id:    goods.Id,
-		Name:  goods.Name,
-		Price: test_util.RandomPrice(),
+		Id:         goods.Id,
+		Name:       goods.Name,
+		PriceCents: test_util.RandomPriceCents(),
 	}
 	getGoods, err := goodsClient.UpdateGoods(context.Background(), &arg)
 	require.NoError(t, err)
 	require.Equal(t, arg.Id, getGoods.Id)
 	require.Equal(t, arg.Name, getGoods.Name)
-	require.Equal(t, arg.Price, getGoods.Price)
+	require.Equal(t, arg.PriceCents, getGoods.PriceCents)
 	arg.Id += 1
 	getGoods, err = goodsClient.UpdateGoods(context.Background(), &arg)
 	require.Error(t, err)
@@ -61,3 +73,35 @@ func TestGoodsServer_UpdateGoods(t *testing.T) {
 func TestGoodsServer_DeleteGoods(t *testing.T) {
 
 }
+
+func TestGoodsServer_GetGoodsBatchInfoWithCurrency(t *testing.T) {
+	goods := createGoods(t)
+	usdGoods, err := goodsClient.CreateGoods(context.Background(), &proto.CreateGoodRequest{
+		Name:       test_util.RandomString(20),
+		PriceCents: 1000,
+		Currency:   "usd",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "USD", usdGoods.Currency)
+
+	rsp, err := goodsClient.GetGoodsBatchInfo(context.Background(), &proto.ManyGoodsID{
+		GoodsIDs: []*proto.GoodID{{Id: goods.Id}, {Id: usdGoods.Id}},
+		Currency: "USD",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "USD", rsp.Currency)
+	require.True(t, rsp.ExchangeRate > 0)
+	for _, info := range rsp.Data {
+		require.Equal(t, "USD", info.Currency)
+		if info.Id == usdGoods.Id {
+			require.Equal(t, int64(1000), info.PriceCents)
+		}
+	}
+
+	// 不支持的币种
+	_, err = goodsClient.GetGoodsBatchInfo(context.Background(), &proto.ManyGoodsID{
+		GoodsIDs: []*proto.GoodID{{Id: goods.Id}},
+		Currency: "XXX",
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,20 @@
+package initialize
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/goods/rpc/global"
+	"github.com/jimyag/shop/common/utils/money"
+)
+
+//
+// InitRateProvider
+//  @Description: 使用远程配置中的汇率表初始化汇率
+//
+func InitRateProvider() {
+	global.RateProvider = money.NewStaticRateProvider(
+		global.RemoteConfig.Currency.Base,
+		global.RemoteConfig.Currency.Rates,
+	)
+	global.Logger.Info("初始化汇率成功......", zap.String("base", global.RateProvider.Base()))
+}
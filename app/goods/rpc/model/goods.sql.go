@@ -7,20 +7,31 @@ import (
 	"context"
 	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 const createGoods = `-- name: CreateGoods :one
-INSERT INTO "goods"(name, price)
-VALUES ($1, $2) returning id, created_at, updated_at, deleted_at, name, price
+INSERT INTO "goods"(name, price, currency, weight, purchase_limit)
+VALUES ($1, $2, $3, $4, $5) returning id, created_at, updated_at, deleted_at, name, price, currency, weight, purchase_limit
 `
 
 type CreateGoodsParams struct {
-	Name  string  `json:"name"`
-	Price float64 `json:"price"`
+	Name          string `json:"name"`
+	Price         int64  `json:"price"`
+	Currency      string `json:"currency"`
+	Weight        int32  `json:"weight"`
+	PurchaseLimit int32  `json:"purchase_limit"`
 }
 
 func (q *Queries) CreateGoods(ctx context.Context, arg CreateGoodsParams) (Good, error) {
-	row := q.db.QueryRowContext(ctx, createGoods, arg.Name, arg.Price)
+	row := q.db.QueryRowContext(ctx, createGoods,
+		arg.Name,
+		arg.Price,
+		arg.Currency,
+		arg.Weight,
+		arg.PurchaseLimit,
+	)
 	var i Good
 	err := row.Scan(
 		&i.ID,
@@ -29,6 +40,9 @@ func (q *Queries) CreateGoods(ctx context.Context, arg CreateGoodsParams) (Good,
 		&i.DeletedAt,
 		&i.Name,
 		&i.Price,
+		&i.Currency,
+		&i.Weight,
+		&i.PurchaseLimit,
 	)
 	return i, err
 }
@@ -36,7 +50,7 @@ func (q *Queries) CreateGoods(ctx context.Context, arg CreateGoodsParams) (Good,
 const deleteGoods = `-- name: DeleteGoods :one
 UPDATE "goods"
 set deleted_at =$1
-where id = $2 returning id, created_at, updated_at, deleted_at, name, price
+where id = $2 returning id, created_at, updated_at, deleted_at, name, price, currency, weight, purchase_limit
 `
 
 type DeleteGoodsParams struct {
@@ -54,12 +68,15 @@ func (q *Queries) DeleteGoods(ctx context.Context, arg DeleteGoodsParams) (Good,
 		&i.DeletedAt,
 		&i.Name,
 		&i.Price,
+		&i.Currency,
+		&i.Weight,
+		&i.PurchaseLimit,
 	)
 	return i, err
 }
 
 const getGoodsByID = `-- name: GetGoodsByID :one
-SELECT id, created_at, updated_at, deleted_at, name, price
+SELECT id, created_at, updated_at, deleted_at, name, price, currency, weight, purchase_limit
 FROM "goods"
 WHERE id = $1
   and deleted_at IS NULL
@@ -75,12 +92,54 @@ func (q *Queries) GetGoodsByID(ctx context.Context, id int64) (Good, error) {
 		&i.DeletedAt,
 		&i.Name,
 		&i.Price,
+		&i.Currency,
+		&i.Weight,
+		&i.PurchaseLimit,
 	)
 	return i, err
 }
 
+const getGoodsByIDsWithDeleted = `-- name: GetGoodsByIDsWithDeleted :many
+SELECT id, created_at, updated_at, deleted_at, name, price, currency, weight, purchase_limit
+FROM "goods"
+WHERE id = ANY ($1::bigint[])
+`
+
+func (q *Queries) GetGoodsByIDsWithDeleted(ctx context.Context, ids []int64) ([]Good, error) {
+	rows, err := q.db.QueryContext(ctx, getGoodsByIDsWithDeleted, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Good
+	for rows.Next() {
+		var i Good
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Name,
+			&i.Price,
+			&i.Currency,
+			&i.Weight,
+			&i.PurchaseLimit,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getGoodsByName = `-- name: GetGoodsByName :one
-SELECT id, created_at, updated_at, deleted_at, name, price
+SELECT id, created_at, updated_at, deleted_at, name, price, currency, weight, purchase_limit
 FROM "goods"
 WHERE name = $1
   and deleted_at IS NULL
@@ -96,24 +155,33 @@ func (q *Queries) GetGoodsByName(ctx context.Context, name string) (Good, error)
 		&i.DeletedAt,
 		&i.Name,
 		&i.Price,
+		&i.Currency,
+		&i.Weight,
+		&i.PurchaseLimit,
 	)
 	return i, err
 }
 
 const updateGoods = `-- name: UpdateGoods :one
 UPDATE "goods"
-SET updated_at = $1,
-    name       = $2,
-    price      = $3
-WHERE id = $4
-  and deleted_at IS NULL returning id, created_at, updated_at, deleted_at, name, price
+SET updated_at     = $1,
+    name           = $2,
+    price          = $3,
+    currency       = $4,
+    weight         = $5,
+    purchase_limit = $6
+WHERE id = $7
+  and deleted_at IS NULL returning id, created_at, updated_at, deleted_at, name, price, currency, weight, purchase_limit
 `
 
 type UpdateGoodsParams struct {
-	UpdatedAt time.Time `json:"updated_at"`
-	Name      string    `json:"name"`
-	Price     float64   `json:"price"`
-	ID        int64     `json:"id"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	Name          string    `json:"name"`
+	Price         int64     `json:"price"`
+	Currency      string    `json:"currency"`
+	Weight        int32     `json:"weight"`
+	PurchaseLimit int32     `json:"purchase_limit"`
+	ID            int64     `json:"id"`
 }
 
 func (q *Queries) UpdateGoods(ctx context.Context, arg UpdateGoodsParams) (Good, error) {
@@ -121,6 +189,9 @@ func (q *Queries) UpdateGoods(ctx context.Context, arg UpdateGoodsParams) (Good,
 		arg.UpdatedAt,
 		arg.Name,
 		arg.Price,
+		arg.Currency,
+		arg.Weight,
+		arg.PurchaseLimit,
 		arg.ID,
 	)
 	var i Good
@@ -131,6 +202,9 @@ func (q *Queries) UpdateGoods(ctx context.Context, arg UpdateGoodsParams) (Good,
 		&i.DeletedAt,
 		&i.Name,
 		&i.Price,
+		&i.Currency,
+		&i.Weight,
+		&i.PurchaseLimit,
 	)
 	return i, err
 }
@@ -8,10 +8,13 @@ import (
 )
 
 type Good struct {
-	ID        int64        `json:"id"`
-	CreatedAt time.Time    `json:"created_at"`
-	UpdatedAt time.Time    `json:"updated_at"`
-	DeletedAt sql.NullTime `json:"deleted_at"`
-	Name      string       `json:"name"`
-	Price     float64      `json:"price"`
+	ID            int64        `json:"id"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+	DeletedAt     sql.NullTime `json:"deleted_at"`
+	Name          string       `json:"name"`
+	Price         int64        `json:"price"`
+	Currency      string       `json:"currency"`
+	Weight        int32        `json:"weight"`
+	PurchaseLimit int32        `json:"purchase_limit"`
 }
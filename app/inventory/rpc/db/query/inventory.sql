@@ -27,4 +27,14 @@ values ($1, $2, $3::goodsdetail[])
 returning *;
 
 
+-- name: RebackSellDetail :one
+update "stock_sell_detail"
+set status = 2
+where order_id = $1
+  and status = 1
+returning *;
 
+-- name: GetInventoriesByGoodsIDs :many
+SELECT *
+FROM "inventory"
+WHERE goods_id = ANY (sqlc.arg(goods_ids)::int[]);
@@ -106,6 +106,34 @@ func (i *InventoryServer) InvDetail(ctx context.Context, req *proto.GoodInvInfo)
 	return &rsp, nil
 }
 
+//
+// BatchInvDetail
+//  @Description: 批量获得库存
+//  @receiver i
+//  @param ctx
+//  @param req
+//  @return *proto.BatchInvResponse 没有库存记录的商品不会返回
+//  @return error
+//
+func (i *InventoryServer) BatchInvDetail(ctx context.Context, req *proto.BatchInvRequest) (*proto.BatchInvResponse, error) {
+	rsp := proto.BatchInvResponse{GoodsInfo: make([]*proto.GoodInvInfo, 0, len(req.GoodsIds))}
+	if len(req.GoodsIds) == 0 {
+		return &rsp, nil
+	}
+	inventories, err := i.GetInventoriesByGoodsIDs(ctx, req.GoodsIds)
+	if err != nil {
+		global.Logger.Error("批量获取库存失败", zap.Error(err))
+		return &proto.BatchInvResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+	for _, inventory := range inventories {
+		rsp.GoodsInfo = append(rsp.GoodsInfo, &proto.GoodInvInfo{
+			GoodsId: inventory.GoodsID,
+			Num:     inventory.Sticks,
+		})
+	}
+	return &rsp, nil
+}
+
 // Sell 扣减库存
 func (i *InventoryServer) Sell(ctx context.Context, req *proto.SellInfo) (*proto.Empty, error) {
 	// 本地事务  要不都卖，要不都不卖
@@ -178,27 +206,51 @@ func (i *InventoryServer) Sell(ctx context.Context, req *proto.SellInfo) (*proto
 
 	return &proto.Empty{}, nil
 }
+
+//
+// Rollback
+//  @Description: 按照订单归还扣减的库存，归还的数量使用扣减时的记录
+//  同一个订单只会归还一次，没有扣减过库存的订单不会归还，重复调用不会出错
+//  @receiver i
+//  @param ctx
+//  @param req 只使用订单号
+//  @return *proto.Empty
+//  @return error
+//
 func (i *InventoryServer) Rollback(ctx context.Context, req *proto.SellInfo) (*proto.Empty, error) {
-	// 订单超时归还
-	// 订单创建失败
-	// 收到取消归还
-	// 批量归还
-	err := i.ExecTx(ctx, func(queries *model.Queries) error {
-		for _, info := range req.GoodsInfo {
-			//判断是否有库存
-			inventory, err := queries.GetInventoryByGoodsID(ctx, info.GoodsId)
-			if err != nil {
-				if errors.Is(err, sql.ErrNoRows) {
-					return status.Error(codes.NotFound, "货物不存在")
-				}
-				return status.Error(codes.Internal, "内部错误")
-			}
+	if req.OrderId <= 0 {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, "订单号不合法")
+	}
+	if err := i.rebackOrder(ctx, req.OrderId); err != nil {
+		global.Logger.Error("归还库存失败", zap.Int64("orderID", req.OrderId), zap.Error(err))
+		return &proto.Empty{}, status.Error(codes.Internal, "内部错误")
+	}
+	return &proto.Empty{}, nil
+}
 
-			//增加库存 - 库存 会出现数据不一致的问题
+//
+// rebackOrder
+//  @Description: 把订单扣减记录的状态改为已归还，同时把库存加回去
+//  状态只会从已扣减改为已归还一次，所以重复的消息和重复的调用不会重复归还
+//  @receiver i
+//  @param ctx
+//  @param orderID
+//  @return error
+//
+func (i *InventoryServer) rebackOrder(ctx context.Context, orderID int64) error {
+	return i.ExecTx(ctx, func(queries *model.Queries) error {
+		sellDetail, err := queries.RebackSellDetail(ctx, orderID)
+		if errors.Is(err, sql.ErrNoRows) {
+			// 已经归还过或者没有扣减过库存
+			return nil
+		} else if err != nil {
+			return err
+		}
+		for _, detail := range sellDetail.Detail {
 			_, err = queries.UpdateInventory(ctx, model.UpdateInventoryParams{
 				UpdatedAt: time.Now(),
-				GoodsID:   inventory.GoodsID,
-				Counts:    info.Num,
+				GoodsID:   detail.GoodsID,
+				Counts:    detail.Nums,
 			})
 			if err != nil {
 				return err
@@ -206,21 +258,25 @@ func (i *InventoryServer) Rollback(ctx context.Context, req *proto.SellInfo) (*p
 		}
 		return nil
 	})
-	if err != nil {
-		return &proto.Empty{}, err
-	}
-
-	return &proto.Empty{}, nil
 }
 
-func AutoRollBack(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
+//
+// AutoRollBack
+//  @Description: 消费库存归还的消息，订单创建失败、超时关闭和用户取消订单时都会发送
+//  @receiver i
+//  @param ctx
+//  @param msgs
+//  @return consumer.ConsumeResult
+//  @return error
+//
+func (i *InventoryServer) AutoRollBack(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
 	type OrderInfo struct {
 		OrderID int64 `json:"order_id"`
 	}
 	for _, msg := range msgs {
 		// 既然要归还库存，就应该直到每件商品应该归还多少， 这时候出现 重复归还的问题
 		// 这个接口应该保证幂等性，不能因为消息的重复发送而导致一个订单的库存归还多次，没有扣减的库存不能归还。
-		// 新建一张表，记录了详细的订单扣减细节，以及归还的情况
+		// 扣减库存时记录了订单扣减的细节，归还时把状态改为已归还
 		var orderInfo OrderInfo
 		err := json.Unmarshal(msg.Body, &orderInfo)
 		if err != nil {
@@ -228,17 +284,12 @@ func AutoRollBack(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.
 			// 根据业务来，如果赶紧时自己代码问题就用
 			//return consumer.ConsumeRetryLater,nil
 			// 否则就直接忽略这个消息
-			return consumer.ConsumeSuccess, nil
+			continue
 		}
-		// 将inv的库存加回去，同时将sell status 变为2
-		// todo
-		_, err = global.DB.Begin()
-		if err != nil {
-			global.Logger.Error("获得事务失败", zap.Error(err))
+		if err = i.rebackOrder(ctx, orderInfo.OrderID); err != nil {
+			global.Logger.Error("归还库存失败", zap.Int64("orderID", orderInfo.OrderID), zap.Error(err))
 			return consumer.ConsumeRetryLater, nil
 		}
-
-		//	将状态变为2
 	}
 	return consumer.ConsumeSuccess, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -109,34 +110,37 @@ func TestInventoryServer_Sell(t *testing.T) {
 }
 
 func TestRollBack(t *testing.T) {
-	in := proto.SellInfo{
+	orderID := time.Now().UnixNano()
+	before, err := inventoryClient.InvDetail(context.Background(), &proto.GoodInvInfo{GoodsId: 1})
+	require.NoError(t, err)
+	_, err = inventoryClient.Sell(context.Background(), &proto.SellInfo{
+		OrderId: orderID,
 		GoodsInfo: []*proto.GoodInvInfo{
 			{
 				GoodsId: 1,
 				Num:     10,
 			},
-			{
-				GoodsId: 2,
-				Num:     100,
-			},
 		},
-	}
-	_, err := inventoryClient.Rollback(context.Background(), &in)
+	})
 	require.NoError(t, err)
 
-	in = proto.SellInfo{
-		GoodsInfo: []*proto.GoodInvInfo{
-			{
-				GoodsId: 1,
-				Num:     10,
-			},
-			{
-				GoodsId: 10000,
-				Num:     1,
-			},
-		},
+	// 重复归还只会归还一次
+	for i := 0; i < 2; i++ {
+		_, err = inventoryClient.Rollback(context.Background(), &proto.SellInfo{OrderId: orderID})
+		require.NoError(t, err)
 	}
-	_, err = inventoryClient.Rollback(context.Background(), &in)
+	after, err := inventoryClient.InvDetail(context.Background(), &proto.GoodInvInfo{GoodsId: 1})
+	require.NoError(t, err)
+	require.Equal(t, before.Num, after.Num)
+
+	// 没有扣减过库存的订单不会归还
+	_, err = inventoryClient.Rollback(context.Background(), &proto.SellInfo{OrderId: orderID + 1})
+	require.NoError(t, err)
+	after, err = inventoryClient.InvDetail(context.Background(), &proto.GoodInvInfo{GoodsId: 1})
+	require.NoError(t, err)
+	require.Equal(t, before.Num, after.Num)
+
+	_, err = inventoryClient.Rollback(context.Background(), &proto.SellInfo{})
 	require.Error(t, err)
 }
 
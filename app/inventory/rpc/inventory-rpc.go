@@ -137,9 +137,12 @@ func main() {
 		consumer.WithNameServer([]string{"192.168.0.2:9876"}),
 		consumer.WithGroupName("inventory-group"))
 
-	if err = c.Subscribe("order_reback", consumer.MessageSelector{}); err != nil {
+	if err = c.Subscribe("order_reback", consumer.MessageSelector{}, inventoryServer.AutoRollBack); err != nil {
 		global.Logger.Error("订阅库存归还消息失败", zap.Error(err))
 	}
+	if err = c.Start(); err != nil {
+		global.Logger.Error("启动库存归还消息的消费者失败", zap.Error(err))
+	}
 
 	// 监听终止事件
 	quit := make(chan os.Signal, 1)
@@ -150,6 +153,7 @@ func main() {
 	if err = registerClient.DeRegister(serviceID.String()); err != nil {
 		global.Logger.Info("服务注销失败", zap.String("serviceID", serviceID.String()))
 	}
+	_ = c.Shutdown()
 	cl.Close()
 
 	global.Logger.Info("服务已注销", zap.String("serviceID", serviceID.String()))
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+//
+// Value
+//  @Description: 转换为 postgres 中 goodsdetail 类型的文本 (goods_id,nums)
+//  @receiver detail
+//  @return driver.Value
+//  @return error
+//
+func (detail GoodsDetail) Value() (driver.Value, error) {
+	return fmt.Sprintf("(%d,%d)", detail.GoodsID, detail.Nums), nil
+}
+
+//
+// Scan
+//  @Description: 解析 postgres 中 goodsdetail 类型的文本 (goods_id,nums)
+//  @receiver detail
+//  @param src
+//  @return error
+//
+func (detail *GoodsDetail) Scan(src interface{}) error {
+	var text string
+	switch v := src.(type) {
+	case []byte:
+		text = string(v)
+	case string:
+		text = v
+	default:
+		return fmt.Errorf("goodsdetail: 不支持的类型 %T", src)
+	}
+	if _, err := fmt.Sscanf(text, "(%d,%d)", &detail.GoodsID, &detail.Nums); err != nil {
+		return fmt.Errorf("goodsdetail: 解析 %q 失败: %w", text, err)
+	}
+	return nil
+}
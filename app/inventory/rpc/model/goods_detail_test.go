@@ -0,0 +1,21 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoodsDetailArray(t *testing.T) {
+	details := []GoodsDetail{{GoodsID: 1, Nums: 10}, {GoodsID: 2, Nums: 100}}
+	value, err := pq.Array(details).Value()
+	require.NoError(t, err)
+	require.Equal(t, `{"(1,10)","(2,100)"}`, value)
+
+	var scanned []GoodsDetail
+	require.NoError(t, pq.Array(&scanned).Scan([]byte(`{"(1,10)","(2,100)"}`)))
+	require.Equal(t, details, scanned)
+
+	require.Error(t, pq.Array(&scanned).Scan([]byte(`{"(1)"}`)))
+}
@@ -59,6 +59,43 @@ func (q *Queries) CreateSellDetail(ctx context.Context, arg CreateSellDetailPara
 	return i, err
 }
 
+const getInventoriesByGoodsIDs = `-- name: GetInventoriesByGoodsIDs :many
+SELECT id, created_at, updated_at, deleted_at, goods_id, sticks, version
+FROM "inventory"
+WHERE goods_id = ANY ($1::int[])
+`
+
+func (q *Queries) GetInventoriesByGoodsIDs(ctx context.Context, goodsIds []int32) ([]Inventory, error) {
+	rows, err := q.db.QueryContext(ctx, getInventoriesByGoodsIDs, pq.Array(goodsIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Inventory
+	for rows.Next() {
+		var i Inventory
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.GoodsID,
+			&i.Sticks,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getInventoryByGoodsID = `-- name: GetInventoryByGoodsID :one
 SELECT id, created_at, updated_at, deleted_at, goods_id, sticks, version
 FROM "inventory"
@@ -81,6 +118,21 @@ func (q *Queries) GetInventoryByGoodsID(ctx context.Context, goodsID int32) (Inv
 	return i, err
 }
 
+const rebackSellDetail = `-- name: RebackSellDetail :one
+update "stock_sell_detail"
+set status = 2
+where order_id = $1
+  and status = 1
+returning order_id, status, detail
+`
+
+func (q *Queries) RebackSellDetail(ctx context.Context, orderID int64) (StockSellDetail, error) {
+	row := q.db.QueryRowContext(ctx, rebackSellDetail, orderID)
+	var i StockSellDetail
+	err := row.Scan(&i.OrderID, &i.Status, pq.Array(&i.Detail))
+	return i, err
+}
+
 const updateInventory = `-- name: UpdateInventory :one
 update "inventory"
 set updated_at = $1,
@@ -9,7 +9,9 @@ import (
 type Querier interface {
 	CreateInventory(ctx context.Context, arg CreateInventoryParams) (Inventory, error)
 	CreateSellDetail(ctx context.Context, arg CreateSellDetailParams) (StockSellDetail, error)
+	GetInventoriesByGoodsIDs(ctx context.Context, goodsIds []int32) ([]Inventory, error)
 	GetInventoryByGoodsID(ctx context.Context, goodsID int32) (Inventory, error)
+	RebackSellDetail(ctx context.Context, orderID int64) (StockSellDetail, error)
 	UpdateInventory(ctx context.Context, arg UpdateInventoryParams) (Inventory, error)
 }
 
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jimyag/shop/app/order/api/global"
+	"github.com/jimyag/shop/app/order/api/model/request"
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/paseto"
+	"github.com/jimyag/shop/common/utils/validate"
+)
+
+//
+// CheckShopCartItems
+//  @Description: 批量选中或取消选中购物车中的商品，没有传商品时全选或全不选
+//  @param ctx
+//
+func CheckShopCartItems(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	checkRequest := request.CheckCartItemsRequest{}
+	_ = ctx.ShouldBindJSON(&checkRequest)
+	msg, err := validate.Validate(checkRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+	rsp, err := global.OrderSrvClient.CheckCartItems(ctx, &proto.CheckCartItemsRequest{
+		UserID:   payload.UID,
+		GoodsIDs: checkRequest.GoodsIds,
+		Checked:  checkRequest.Checked,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp.Data, ctx)
+}
+
+//
+// BatchDeleteShopCartItems
+//  @Description: 批量删除购物车中的商品
+//  @param ctx
+//
+func BatchDeleteShopCartItems(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	deleteRequest := request.BatchDeleteCartRequest{}
+	_ = ctx.ShouldBindJSON(&deleteRequest)
+	msg, err := validate.Validate(deleteRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+	rsp, err := global.OrderSrvClient.BatchDeleteCartItems(ctx, &proto.BatchDeleteCartItemsRequest{
+		UserID:   payload.UID,
+		GoodsIDs: deleteRequest.GoodsIds,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp.Data, ctx)
+}
+
+//
+// BatchUpdateShopCartNums
+//  @Description: 批量修改购物车中商品的数量
+//  @param ctx
+//
+func BatchUpdateShopCartNums(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	updateRequest := request.BatchUpdateCartNumsRequest{}
+	_ = ctx.ShouldBindJSON(&updateRequest)
+	msg, err := validate.Validate(updateRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+	items := make([]*proto.CartItemNums, 0, len(updateRequest.Items))
+	for _, item := range updateRequest.Items {
+		items = append(items, &proto.CartItemNums{GoodsID: item.GoodsId, Nums: item.Nums})
+	}
+	rsp, err := global.OrderSrvClient.BatchUpdateCartNums(ctx, &proto.BatchUpdateCartNumsRequest{
+		UserID: payload.UID,
+		Items:  items,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp.Data, ctx)
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jimyag/shop/app/order/api/global"
+	"github.com/jimyag/shop/app/order/api/model/request"
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/money"
+	"github.com/jimyag/shop/common/utils/paseto"
+	"github.com/jimyag/shop/common/utils/validate"
+)
+
+//
+// CreateCouponTemplate
+//  @Description: 新建优惠券模板，只有管理员可以操作
+//  @param ctx
+//
+func CreateCouponTemplate(ctx *gin.Context) {
+	templateRequest := request.CreateCouponTemplateRequest{}
+	_ = ctx.ShouldBindJSON(&templateRequest)
+	msg, err := validate.Validate(templateRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	rsp, err := global.OrderSrvClient.CreateCouponTemplate(ctx, &proto.CouponTemplateInfo{
+		Name:           templateRequest.Name,
+		Type:           templateRequest.Type,
+		AmountCents:    money.FromYuan(templateRequest.Amount),
+		Rate:           templateRequest.Rate,
+		ThresholdCents: money.FromYuan(templateRequest.Threshold),
+		GoodsID:        templateRequest.GoodsID,
+		Total:          templateRequest.Total,
+		PerUserLimit:   templateRequest.PerUserLimit,
+		ValidFrom:      templateRequest.ValidFrom,
+		ValidTo:        templateRequest.ValidTo,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp, ctx)
+}
+
+//
+// IssueCoupon
+//  @Description: 当前用户领取优惠券
+//  @param ctx
+//
+func IssueCoupon(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	issueRequest := request.IssueCouponRequest{}
+	_ = ctx.ShouldBindJSON(&issueRequest)
+	msg, err := validate.Validate(issueRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	rsp, err := global.OrderSrvClient.IssueCoupon(ctx, &proto.IssueCouponRequest{
+		UserID:     payload.UID,
+		TemplateID: issueRequest.TemplateID,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp, ctx)
+}
+
+//
+// GetUserCouponList
+//  @Description: 获取当前用户的优惠券
+//  @param ctx
+//
+func GetUserCouponList(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	rsp, err := global.OrderSrvClient.UserCouponList(ctx, &proto.UserCouponListRequest{UserID: payload.UID})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp, ctx)
+}
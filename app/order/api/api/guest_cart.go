@@ -0,0 +1,125 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jimyag/shop/app/order/api/global"
+	"github.com/jimyag/shop/app/order/api/model/request"
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/cart_token"
+	"github.com/jimyag/shop/common/utils/validate"
+)
+
+//
+// CreateGuestCartItem
+//  @Description: 游客添加商品到购物车，没有购物车标识时生成新的标识
+//  @param ctx
+//
+func CreateGuestCartItem(ctx *gin.Context) {
+	cartItemRequest := request.GuestCartItemRequest{}
+	_ = ctx.ShouldBindJSON(&cartItemRequest)
+	msg, err := validate.Validate(cartItemRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	token := cart_token.FromRequest(ctx)
+	if token == "" {
+		token = cart_token.New()
+	}
+	rsp, err := global.OrderSrvClient.CreateCartItem(ctx, &proto.CreateCartItemRequest{
+		CartToken: token,
+		GoodsID:   cartItemRequest.GoodsId,
+		Nums:      cartItemRequest.Nums,
+		Checked:   cartItemRequest.Checked,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	cart_token.Set(ctx, token)
+	model.OkWithData(rsp, ctx)
+}
+
+//
+// GetGuestCartList
+//  @Description: 获取游客的购物车，detail=true 时返回商品的详细信息
+//  @param ctx
+//
+func GetGuestCartList(ctx *gin.Context) {
+	token := cart_token.FromRequest(ctx)
+	detail := ctx.Query("detail") == "true"
+	if token == "" {
+		if detail {
+			model.OkWithData(&proto.CartDetailResponse{}, ctx)
+			return
+		}
+		model.OkWithData([]*proto.ShopCartInfoResponse{}, ctx)
+		return
+	}
+
+	if detail {
+		getCartDetail(ctx, &proto.CartItemListRequest{CartToken: token})
+		return
+	}
+	rsp, err := global.OrderSrvClient.CartItemList(ctx, &proto.CartItemListRequest{CartToken: token})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp.Data, ctx)
+}
+
+//
+// DeleteGuestCartItem
+//  @Description: 删除游客购物车中的商品
+//  @param ctx
+//
+func DeleteGuestCartItem(ctx *gin.Context) {
+	deleteRequest := request.GuestDeleteCartRequest{}
+	_ = ctx.ShouldBindJSON(&deleteRequest)
+	msg, err := validate.Validate(deleteRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	_, err = global.OrderSrvClient.DeleteCartItems(ctx, &proto.DeleteCartItemsRequest{
+		CartToken: cart_token.FromRequest(ctx),
+		GoodsID:   deleteRequest.GoodsId,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.Ok(ctx)
+}
+
+//
+// UpdateGuestCartItem
+//  @Description: 更新游客购物车中商品的数量和选中状态
+//  @param ctx
+//
+func UpdateGuestCartItem(ctx *gin.Context) {
+	updateRequest := request.GuestCartItemRequest{}
+	_ = ctx.ShouldBindJSON(&updateRequest)
+	msg, err := validate.Validate(updateRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	_, err = global.OrderSrvClient.UpdateCartItem(ctx, &proto.UpdateCartItemRequest{
+		CartToken: cart_token.FromRequest(ctx),
+		GoodsID:   updateRequest.GoodsId,
+		Nums:      updateRequest.Nums,
+		Checked:   updateRequest.Checked,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.Ok(ctx)
+}
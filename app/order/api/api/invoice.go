@@ -0,0 +1,97 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/order/api/global"
+	"github.com/jimyag/shop/app/order/api/model/request"
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/paseto"
+	"github.com/jimyag/shop/common/utils/validate"
+)
+
+//
+// IssueInvoice
+//  @Description: 为已支付的订单开具发票，已经开具过时返回原来的发票
+//  @param ctx
+//
+func IssueInvoice(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	issueRequest := request.IssueInvoiceRequest{}
+	_ = ctx.ShouldBindJSON(&issueRequest)
+	msg, err := validate.Validate(issueRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+	info, err := global.OrderSrvClient.IssueInvoice(ctx, &proto.IssueInvoiceRequest{
+		OrderID:      issueRequest.OrderID,
+		UserID:       payload.UID,
+		BuyerCompany: issueRequest.BuyerCompany,
+		BuyerTaxID:   issueRequest.BuyerTaxID,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(info, ctx)
+}
+
+//
+// GetInvoice
+//  @Description: 下载已经开具的发票，默认为 PDF，format=html 时返回 HTML，没有开具时返回错误
+//  @param ctx
+//
+func GetInvoice(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	invoiceRequest := request.GetInvoiceRequest{}
+	_ = ctx.ShouldBindQuery(&invoiceRequest)
+	msg, err := validate.Validate(invoiceRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+	info, err := global.OrderSrvClient.GetInvoice(ctx, &proto.GetInvoiceRequest{
+		OrderID: invoiceRequest.OrderID,
+		UserID:  payload.UID,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+
+	// 先渲染到 buffer 中，渲染失败时还可以返回错误信息
+	buf := bytes.Buffer{}
+	if invoiceRequest.Format == "html" {
+		err = global.Invoice.HTML(&buf, info)
+	} else {
+		err = global.Invoice.PDF(&buf, info)
+	}
+	if err != nil {
+		global.Logger.Error("渲染发票失败", zap.Error(err))
+		model.FailWithMsg("生成发票失败", ctx)
+		return
+	}
+	if invoiceRequest.Format == "html" {
+		ctx.Data(http.StatusOK, "text/html; charset=utf-8", buf.Bytes())
+		return
+	}
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.pdf", info.InvoiceNo))
+	ctx.Data(http.StatusOK, "application/pdf", buf.Bytes())
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/jimyag/shop/app/order/api/model/request"
 	"github.com/jimyag/shop/common/model"
 	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
+	"github.com/jimyag/shop/common/utils/paseto"
 	"github.com/jimyag/shop/common/utils/validate"
 )
 
@@ -23,12 +25,19 @@ func CreateOrder(ctx *gin.Context) {
 		model.FailWithMsg(msg, ctx)
 		return
 	}
+	uid, ok := auth.ResolveUserID(ctx, createOrderRequest.UserID, auth.PermOrderWriteAll)
+	if !ok {
+		return
+	}
 	orderInfo, err := global.OrderSrvClient.CreateOrder(ctx, &proto.CreateOrderRequest{
-		UserID:  createOrderRequest.UserID,
-		Address: createOrderRequest.Address,
-		Mobile:  createOrderRequest.Mobile,
-		Name:    createOrderRequest.Name,
-		Post:    createOrderRequest.Post,
+		UserID:    uid,
+		Address:   createOrderRequest.Address,
+		Mobile:    createOrderRequest.Mobile,
+		Name:      createOrderRequest.Name,
+		Post:      createOrderRequest.Post,
+		AddressID: createOrderRequest.AddressID,
+		CouponID:  createOrderRequest.CouponID,
+		Currency:  createOrderRequest.Currency,
 	})
 
 	if err != nil {
@@ -38,10 +47,105 @@ func CreateOrder(ctx *gin.Context) {
 	model.OkWithData(orderInfo, ctx)
 }
 
+//
+// PreviewCheckout
+//  @Description: 结算前检查当前用户购物车中选中商品的价格和库存
+//  @param ctx
+//
+func PreviewCheckout(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	previewRequest := request.PreviewCheckoutRequest{}
+	_ = ctx.ShouldBindJSON(&previewRequest)
+	msg, err := validate.Validate(previewRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+	preview, err := global.OrderSrvClient.PreviewCheckout(ctx, &proto.PreviewCheckoutRequest{
+		UserID:   payload.UID,
+		Currency: previewRequest.Currency,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(preview, ctx)
+}
+
+//
+// CancelOrder
+//  @Description: 取消当前用户未支付的订单
+//  @param ctx
+//
+func CancelOrder(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	cancelRequest := request.CancelOrderRequest{}
+	_ = ctx.ShouldBindJSON(&cancelRequest)
+	msg, err := validate.Validate(cancelRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+	orderInfo, err := global.OrderSrvClient.CancelOrder(ctx, &proto.CancelOrderRequest{
+		OrderID:     cancelRequest.OrderID,
+		UserID:      payload.UID,
+		Reason:      cancelRequest.Reason,
+		RestoreCart: cancelRequest.RestoreCart,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(orderInfo, ctx)
+}
+
+//
+// QuoteOrder
+//  @Description: 下单前计算订单的金额、优惠、运费和税费，参数和创建订单相同
+//  @param ctx
+//
+func QuoteOrder(ctx *gin.Context) {
+	quoteRequest := request.CreateOrderRequest{}
+	_ = ctx.ShouldBindJSON(&quoteRequest)
+	msg, err := validate.Validate(quoteRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+	uid, ok := auth.ResolveUserID(ctx, quoteRequest.UserID, auth.PermOrderReadAll)
+	if !ok {
+		return
+	}
+	quote, err := global.OrderSrvClient.QuoteOrder(ctx, &proto.CreateOrderRequest{
+		UserID:    uid,
+		Address:   quoteRequest.Address,
+		Mobile:    quoteRequest.Mobile,
+		Name:      quoteRequest.Name,
+		Post:      quoteRequest.Post,
+		AddressID: quoteRequest.AddressID,
+		CouponID:  quoteRequest.CouponID,
+		Currency:  quoteRequest.Currency,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(quote, ctx)
+}
+
 //
 // GetOrderDetail
-//  @Description:  获取个人订单详情
-//  todo 验证是否是当前用户的订单
+//  @Description:  获取个人订单详情，只能查看自己的订单
 //
 func GetOrderDetail(ctx *gin.Context) {
 	orderDetailRequest := &request.GetOrderDetailRequest{}
@@ -58,6 +162,9 @@ func GetOrderDetail(ctx *gin.Context) {
 		model.FailWithMsg(err.Error(), ctx)
 		return
 	}
+	if !auth.CheckOwner(ctx, rsp.OrderInfo.GetUserID(), auth.PermOrderReadAll) {
+		return
+	}
 	model.OkWithData(rsp, ctx)
 
 }
@@ -75,9 +182,13 @@ func GetOrderList(ctx *gin.Context) {
 		model.FailWithMsg(msg, ctx)
 		return
 	}
+	uid, ok := auth.ResolveUserID(ctx, getOrderListRequest.UserID, auth.PermOrderReadAll)
+	if !ok {
+		return
+	}
 
 	rsp, err := global.OrderSrvClient.GetOrderList(ctx, &proto.GetOrderListRequest{
-		UserID:   getOrderListRequest.UserID,
+		UserID:   uid,
 		PageNum:  getOrderListRequest.PageNum,
 		PageSize: getOrderListRequest.PageSize,
 	})
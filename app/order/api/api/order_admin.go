@@ -0,0 +1,166 @@
+package api
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/order/api/global"
+	"github.com/jimyag/shop/app/order/api/model/request"
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/money"
+	"github.com/jimyag/shop/common/utils/validate"
+)
+
+const (
+	exportPageSize = 500   // 导出时每次查询的数量
+	maxExportRows  = 10000 // 一次最多导出的订单数量
+)
+
+// 订单的状态
+var orderStatusNames = map[int32]string{
+	1: "待支付",
+	2: "已支付",
+	3: "已关闭",
+	4: "已发货",
+	5: "已签收",
+}
+
+//
+// SearchOrders
+//  @Description: 管理员按照条件搜索所有用户的订单
+//  @param ctx
+//
+func SearchOrders(ctx *gin.Context) {
+	searchRequest, ok := bindSearchOrdersRequest(ctx)
+	if !ok {
+		return
+	}
+	if searchRequest.PageNum == 0 {
+		searchRequest.PageNum = 1
+	}
+	if searchRequest.PageSize == 0 {
+		searchRequest.PageSize = 20
+	}
+	rsp, err := global.OrderSrvClient.SearchOrders(ctx, searchOrdersRequest2Proto(searchRequest))
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp, ctx)
+}
+
+//
+// ExportOrders
+//  @Description: 管理员按照条件导出订单为 CSV，忽略分页参数，最多导出 maxExportRows 条
+//  @param ctx
+//
+func ExportOrders(ctx *gin.Context) {
+	searchRequest, ok := bindSearchOrdersRequest(ctx)
+	if !ok {
+		return
+	}
+	arg := searchOrdersRequest2Proto(searchRequest)
+	arg.PageSize = exportPageSize
+
+	// 先查询完再写入，查询失败时还可以返回错误信息
+	orders := make([]*proto.OrderInfo, 0)
+	for arg.PageNum = 1; len(orders) < maxExportRows; arg.PageNum++ {
+		rsp, err := global.OrderSrvClient.SearchOrders(ctx, arg)
+		if err != nil {
+			model.FailWithMsg(err.Error(), ctx)
+			return
+		}
+		orders = append(orders, rsp.Data...)
+		if len(rsp.Data) < exportPageSize {
+			break
+		}
+	}
+	if len(orders) > maxExportRows {
+		orders = orders[:maxExportRows]
+	}
+
+	ctx.Header("Content-Type", "text/csv; charset=utf-8")
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=orders-%s.csv", time.Now().Format("20060102150405")))
+	ctx.Status(http.StatusOK)
+	// 加上 BOM 让 Excel 可以正确识别中文
+	_, _ = ctx.Writer.WriteString("\xEF\xBB\xBF")
+	writer := csv.NewWriter(ctx.Writer)
+	_ = writer.Write([]string{"订单号", "用户ID", "状态", "币种", "商品金额", "优惠", "运费", "税费", "订单金额", "收货人", "手机号", "收货地址", "下单时间", "取消原因"})
+	for _, order := range orders {
+		_ = writer.Write([]string{
+			strconv.FormatInt(order.OrderID, 10),
+			strconv.FormatInt(int64(order.UserID), 10),
+			orderStatusNames[order.Status],
+			order.Currency,
+			money.FormatAmount(order.GoodsTotalCents, order.Currency),
+			money.FormatAmount(order.DiscountCents, order.Currency),
+			money.FormatAmount(order.ShippingCents, order.Currency),
+			money.FormatAmount(order.TaxCents, order.Currency),
+			money.FormatAmount(order.TotalCents, order.Currency),
+			csvCell(order.Name),
+			csvCell(order.Mobile),
+			csvCell(order.Address),
+			time.Unix(order.CreatedAt, 0).Format("2006-01-02 15:04:05"),
+			csvCell(order.CancelReason),
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		global.Logger.Error("导出订单失败", zap.Error(err))
+	}
+}
+
+//
+// csvCell
+//  @Description: 用户填写的内容以 = + - @ 制表符或回车开头时加上 '，Excel 打开时不会当作公式执行
+//  @param value
+//  @return string
+//
+func csvCell(value string) string {
+	if value != "" && strings.ContainsAny(value[:1], "=+-@\t\r") {
+		return "'" + value
+	}
+	return value
+}
+
+//
+// bindSearchOrdersRequest
+//  @Description: 解析搜索条件，失败时已经返回了错误信息
+//  @param ctx
+//  @return request.SearchOrdersRequest
+//  @return bool
+//
+func bindSearchOrdersRequest(ctx *gin.Context) (request.SearchOrdersRequest, bool) {
+	searchRequest := request.SearchOrdersRequest{}
+	_ = ctx.ShouldBindQuery(&searchRequest)
+	msg, err := validate.Validate(searchRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return searchRequest, false
+	}
+	return searchRequest, true
+}
+
+func searchOrdersRequest2Proto(searchRequest request.SearchOrdersRequest) *proto.SearchOrdersRequest {
+	return &proto.SearchOrdersRequest{
+		Status:         searchRequest.Status,
+		UserID:         searchRequest.UserID,
+		CreatedFrom:    searchRequest.CreatedFrom,
+		CreatedTo:      searchRequest.CreatedTo,
+		MinAmountCents: searchRequest.MinAmountCents,
+		MaxAmountCents: searchRequest.MaxAmountCents,
+		OrderIDPrefix:  searchRequest.OrderIDPrefix,
+		GoodsID:        searchRequest.GoodsID,
+		Sort:           searchRequest.Sort,
+		PageNum:        searchRequest.PageNum,
+		PageSize:       searchRequest.PageSize,
+	}
+}
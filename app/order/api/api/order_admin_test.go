@@ -0,0 +1,18 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCsvCell(t *testing.T) {
+	require.Equal(t, "'=HYPERLINK(\"http://example.com\")", csvCell("=HYPERLINK(\"http://example.com\")"))
+	require.Equal(t, "'+86 123", csvCell("+86 123"))
+	require.Equal(t, "'-1", csvCell("-1"))
+	require.Equal(t, "'@SUM(A1)", csvCell("@SUM(A1)"))
+	require.Equal(t, "'\tA", csvCell("\tA"))
+	require.Equal(t, "'\rA", csvCell("\rA"))
+	require.Equal(t, "上海市 1号", csvCell("上海市 1号"))
+	require.Equal(t, "", csvCell(""))
+}
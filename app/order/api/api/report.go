@@ -0,0 +1,61 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jimyag/shop/app/order/api/global"
+	"github.com/jimyag/shop/app/order/api/model/request"
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/validate"
+)
+
+//
+// SalesReport
+//  @Description: 管理员查看一段时间内的销售额、平均订单金额和转化率
+//  @param ctx
+//
+func SalesReport(ctx *gin.Context) {
+	reportRequest := request.SalesReportRequest{}
+	_ = ctx.ShouldBindQuery(&reportRequest)
+	msg, err := validate.Validate(reportRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+	rsp, err := global.OrderSrvClient.SalesReport(ctx, &proto.SalesReportRequest{
+		FromDay: reportRequest.FromDay,
+		ToDay:   reportRequest.ToDay,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp, ctx)
+}
+
+//
+// GoodsSalesReport
+//  @Description: 管理员查看一段时间内商品的销量和销售额排行
+//  @param ctx
+//
+func GoodsSalesReport(ctx *gin.Context) {
+	reportRequest := request.GoodsSalesRequest{}
+	_ = ctx.ShouldBindQuery(&reportRequest)
+	msg, err := validate.Validate(reportRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+	rsp, err := global.OrderSrvClient.GoodsSalesReport(ctx, &proto.GoodsSalesRequest{
+		FromDay: reportRequest.FromDay,
+		ToDay:   reportRequest.ToDay,
+		Sort:    reportRequest.Sort,
+		Limit:   reportRequest.Limit,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp.Data, ctx)
+}
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/order/api/carrier"
+	"github.com/jimyag/shop/app/order/api/global"
+	"github.com/jimyag/shop/app/order/api/model/request"
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/paseto"
+	"github.com/jimyag/shop/common/utils/validate"
+)
+
+// 回调内容的最大长度
+const maxCallbackSize = 1 << 20
+
+//
+// CreateShipment
+//  @Description: 订单发货，只有管理员可以操作
+//  @param ctx
+//
+func CreateShipment(ctx *gin.Context) {
+	shipmentRequest := request.CreateShipmentRequest{}
+	_ = ctx.ShouldBindJSON(&shipmentRequest)
+	msg, err := validate.Validate(shipmentRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+	if _, ok := carrier.Get(shipmentRequest.Carrier); !ok {
+		model.FailWithMsg("不支持该物流公司", ctx)
+		return
+	}
+
+	rsp, err := global.OrderSrvClient.CreateShipment(ctx, &proto.CreateShipmentRequest{
+		OrderID:    shipmentRequest.OrderID,
+		Carrier:    shipmentRequest.Carrier,
+		TrackingNo: shipmentRequest.TrackingNo,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp, ctx)
+}
+
+//
+// CarrierCallback
+//  @Description: 物流公司推送物流事件，由对应的适配器验证并解析回调的内容
+//  @param ctx
+//
+func CarrierCallback(ctx *gin.Context) {
+	adapter, ok := carrier.Get(ctx.Param("carrier"))
+	if !ok {
+		ctx.JSON(http.StatusNotFound, gin.H{"msg": "不支持该物流公司"})
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxCallbackSize))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"msg": carrier.ErrInvalidPayload.Error()})
+		return
+	}
+	events, err := adapter.ParseCallback(ctx.Request.Header, body)
+	if errors.Is(err, carrier.ErrUnauthorized) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"msg": err.Error()})
+		return
+	} else if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		return
+	}
+
+	// 一次回调可能包含多个运单的事件，按照运单号分组
+	trackingNos := make([]string, 0)
+	grouped := make(map[string][]*proto.ShipmentEvent)
+	for _, event := range events {
+		if _, ok := grouped[event.TrackingNo]; !ok {
+			trackingNos = append(trackingNos, event.TrackingNo)
+		}
+		grouped[event.TrackingNo] = append(grouped[event.TrackingNo], &proto.ShipmentEvent{
+			Status:      event.Status,
+			Location:    event.Location,
+			Description: event.Description,
+			OccurredAt:  event.OccurredAt.Unix(),
+		})
+	}
+	for _, trackingNo := range trackingNos {
+		_, err = global.OrderSrvClient.AddShipmentEvents(ctx, &proto.ShipmentEventsRequest{
+			Carrier:    adapter.Name(),
+			TrackingNo: trackingNo,
+			Events:     grouped[trackingNo],
+		})
+		if err != nil {
+			global.Logger.Error("保存物流事件失败",
+				zap.String("carrier", adapter.Name()),
+				zap.String("trackingNo", trackingNo),
+				zap.Error(err),
+			)
+			// 返回错误让物流公司重试，已经保存的事件会被忽略
+			ctx.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
+			return
+		}
+	}
+	ctx.JSON(http.StatusOK, gin.H{"msg": "ok"})
+}
+
+//
+// GetOrderTracking
+//  @Description: 获取当前用户订单的物流信息
+//  @param ctx
+//
+func GetOrderTracking(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	trackingRequest := request.GetOrderTrackingRequest{}
+	_ = ctx.ShouldBindJSON(&trackingRequest)
+	msg, err := validate.Validate(trackingRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	rsp, err := global.OrderSrvClient.GetOrderTracking(ctx, &proto.OrderTrackingRequest{
+		UserID:  payload.UID,
+		OrderID: trackingRequest.OrderID,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp, ctx)
+}
@@ -45,7 +45,7 @@ func CreateShopCart(ctx *gin.Context) {
 
 //
 // GetShopCartList
-//  @Description: 获取购物车列表
+//  @Description: 获取购物车列表，detail=true 时返回商品的名称、价格、库存和选中商品的小计
 //
 func GetShopCartList(ctx *gin.Context) {
 	cartListRequest := request.GetCartListRequest{}
@@ -58,6 +58,10 @@ func GetShopCartList(ctx *gin.Context) {
 	}
 
 	arg := proto.CartItemListRequest{Uid: cartListRequest.UserId}
+	if ctx.Query("detail") == "true" {
+		getCartDetail(ctx, &arg)
+		return
+	}
 	rsp, err := global.OrderSrvClient.CartItemList(ctx, &arg)
 	if err != nil {
 		model.FailWithMsg(err.Error(), ctx)
@@ -118,3 +122,19 @@ func UpdateShopCartItem(ctx *gin.Context) {
 	model.Ok(ctx)
 
 }
+
+//
+// getCartDetail
+//  @Description: 获取购物车中商品的详细信息，可以使用 currency 指定价格的币种
+//  @param ctx
+//  @param arg
+//
+func getCartDetail(ctx *gin.Context, arg *proto.CartItemListRequest) {
+	arg.Currency = ctx.Query("currency")
+	rsp, err := global.OrderSrvClient.CartItemDetailList(ctx, arg)
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp, ctx)
+}
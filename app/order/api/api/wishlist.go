@@ -0,0 +1,126 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jimyag/shop/app/order/api/global"
+	"github.com/jimyag/shop/app/order/api/model/request"
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/paseto"
+	"github.com/jimyag/shop/common/utils/validate"
+)
+
+//
+// GetWishlist
+//  @Description: 获取当前用户的收藏列表
+//  @param ctx
+//
+func GetWishlist(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	rsp, err := global.OrderSrvClient.WishlistItemList(ctx, &proto.WishlistItemListRequest{UserID: payload.UID})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp, ctx)
+}
+
+//
+// CreateWishlistItem
+//  @Description: 收藏商品
+//  @param ctx
+//
+func CreateWishlistItem(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	wishlistItemRequest := request.WishlistItemRequest{}
+	_ = ctx.ShouldBindJSON(&wishlistItemRequest)
+	msg, err := validate.Validate(wishlistItemRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	rsp, err := global.OrderSrvClient.CreateWishlistItem(ctx, &proto.WishlistItemRequest{
+		UserID:  payload.UID,
+		GoodsID: wishlistItemRequest.GoodsId,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp, ctx)
+}
+
+//
+// DeleteWishlistItem
+//  @Description: 取消收藏
+//  @param ctx
+//
+func DeleteWishlistItem(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	wishlistItemRequest := request.WishlistItemRequest{}
+	_ = ctx.ShouldBindJSON(&wishlistItemRequest)
+	msg, err := validate.Validate(wishlistItemRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	_, err = global.OrderSrvClient.DeleteWishlistItem(ctx, &proto.WishlistItemRequest{
+		UserID:  payload.UID,
+		GoodsID: wishlistItemRequest.GoodsId,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.Ok(ctx)
+}
+
+//
+// MoveWishlistItemToCart
+//  @Description: 将收藏的商品移入购物车
+//  @param ctx
+//
+func MoveWishlistItemToCart(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	moveRequest := request.MoveWishlistItemToCartRequest{}
+	_ = ctx.ShouldBindJSON(&moveRequest)
+	msg, err := validate.Validate(moveRequest, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	rsp, err := global.OrderSrvClient.MoveWishlistItemToCart(ctx, &proto.MoveWishlistItemToCartRequest{
+		UserID:  payload.UID,
+		GoodsID: moveRequest.GoodsId,
+		Nums:    moveRequest.Nums,
+	})
+	if err != nil {
+		model.FailWithMsg(err.Error(), ctx)
+		return
+	}
+	model.OkWithData(rsp, ctx)
+}
@@ -0,0 +1,75 @@
+package carrier
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// 物流事件的状态，和订单服务中包裹的状态一致
+const (
+	StatusPickedUp  int32 = 2 // 已揽收
+	StatusInTransit int32 = 3 // 运输中
+	StatusDelivered int32 = 4 // 已签收
+)
+
+// 解析物流公司回调时返回的错误
+var (
+	ErrUnauthorized   = errors.New("回调签名验证失败")
+	ErrInvalidPayload = errors.New("回调内容格式错误")
+	ErrUnknownStatus  = errors.New("未知的物流状态")
+)
+
+//
+// Event
+//  @Description: 统一格式的物流事件
+//
+type Event struct {
+	TrackingNo  string
+	Status      int32
+	Location    string
+	Description string
+	OccurredAt  time.Time
+}
+
+//
+// Adapter
+//  @Description: 物流公司的适配器，把不同物流公司的回调转换为统一格式的物流事件
+//
+type Adapter interface {
+	// Name 物流公司的名称，和发货时填写的 carrier 一致
+	Name() string
+	// ParseCallback 验证并解析回调的内容
+	ParseCallback(header http.Header, body []byte) ([]Event, error)
+}
+
+var (
+	adapters = make(map[string]Adapter)
+	mu       sync.RWMutex
+)
+
+//
+// Register
+//  @Description: 注册物流公司的适配器，同名的会被覆盖
+//  @param adapter
+//
+func Register(adapter Adapter) {
+	mu.Lock()
+	defer mu.Unlock()
+	adapters[adapter.Name()] = adapter
+}
+
+//
+// Get
+//  @Description: 获取物流公司的适配器
+//  @param name
+//  @return Adapter
+//  @return bool
+//
+func Get(name string) (Adapter, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	adapter, ok := adapters[name]
+	return adapter, ok
+}
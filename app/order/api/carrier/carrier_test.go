@@ -0,0 +1,50 @@
+package carrier
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister(t *testing.T) {
+	Register(NewMockAdapter("secret"))
+	adapter, ok := Get("mock")
+	require.True(t, ok)
+	require.Equal(t, "mock", adapter.Name())
+
+	_, ok = Get("unknown")
+	require.False(t, ok)
+}
+
+func TestMockAdapter_ParseCallback(t *testing.T) {
+	adapter := NewMockAdapter("secret")
+	header := http.Header{}
+	header.Set(MockTokenHeader, "secret")
+
+	body := []byte(`{"tracking_no":"SF123","events":[
+		{"status":"picked_up","location":"上海","time":1650000000},
+		{"status":"delivered","location":"杭州","description":"本人签收","time":1650086400}]}`)
+	events, err := adapter.ParseCallback(header, body)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, "SF123", events[0].TrackingNo)
+	require.Equal(t, StatusPickedUp, events[0].Status)
+	require.Equal(t, StatusDelivered, events[1].Status)
+	require.Equal(t, "本人签收", events[1].Description)
+	require.Equal(t, int64(1650086400), events[1].OccurredAt.Unix())
+
+	// token 错误
+	_, err = adapter.ParseCallback(http.Header{}, body)
+	require.ErrorIs(t, err, ErrUnauthorized)
+
+	// 没有配置 token 时拒绝所有的回调
+	_, err = NewMockAdapter("").ParseCallback(http.Header{}, body)
+	require.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = adapter.ParseCallback(header, []byte(`{"events":[]}`))
+	require.ErrorIs(t, err, ErrInvalidPayload)
+
+	_, err = adapter.ParseCallback(header, []byte(`{"tracking_no":"SF123","events":[{"status":"lost"}]}`))
+	require.ErrorIs(t, err, ErrUnknownStatus)
+}
@@ -0,0 +1,76 @@
+package carrier
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// MockTokenHeader mock 物流公司回调时携带 token 的 header
+const MockTokenHeader = "X-Mock-Token"
+
+var mockStatus = map[string]int32{
+	"picked_up":  StatusPickedUp,
+	"in_transit": StatusInTransit,
+	"delivered":  StatusDelivered,
+}
+
+//
+// MockAdapter
+//  @Description: 用于测试的物流公司，回调使用 json 并在 header 中携带 token
+//
+type MockAdapter struct {
+	token string
+}
+
+type mockCallback struct {
+	TrackingNo string `json:"tracking_no"`
+	Events     []struct {
+		Status      string `json:"status"` // picked_up in_transit delivered
+		Location    string `json:"location"`
+		Description string `json:"description"`
+		Time        int64  `json:"time"` // unix 时间戳
+	} `json:"events"`
+}
+
+//
+// NewMockAdapter
+//  @Description: 新建 mock 物流公司的适配器
+//  @param token 回调时需要携带的 token
+//  @return *MockAdapter
+//
+func NewMockAdapter(token string) *MockAdapter {
+	return &MockAdapter{token: token}
+}
+
+func (adapter *MockAdapter) Name() string {
+	return "mock"
+}
+
+func (adapter *MockAdapter) ParseCallback(header http.Header, body []byte) ([]Event, error) {
+	token := header.Get(MockTokenHeader)
+	if adapter.token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(adapter.token)) != 1 {
+		return nil, ErrUnauthorized
+	}
+
+	callback := mockCallback{}
+	if err := json.Unmarshal(body, &callback); err != nil || callback.TrackingNo == "" {
+		return nil, ErrInvalidPayload
+	}
+	events := make([]Event, 0, len(callback.Events))
+	for _, e := range callback.Events {
+		status, ok := mockStatus[e.Status]
+		if !ok {
+			return nil, ErrUnknownStatus
+		}
+		events = append(events, Event{
+			TrackingNo:  callback.TrackingNo,
+			Status:      status,
+			Location:    e.Location,
+			Description: e.Description,
+			OccurredAt:  time.Unix(e.Time, 0),
+		})
+	}
+	return events, nil
+}
@@ -24,9 +24,10 @@ type JaegerConfig struct {
 //  @Description: token的配置
 //
 type Secret struct {
-	PrivateKey string `mapstructure:"private-key"` // token的 私钥
-	PublicKey  string `mapstructure:"public-key"`  // token的 公钥
-	Duration   int    `mapstructure:"duration"`    // token的过期时间 单位 小时
+	PrivateKey      string `mapstructure:"private-key"`      // token的 私钥
+	PublicKey       string `mapstructure:"public-key"`       // token的 公钥
+	Duration        int    `mapstructure:"duration"`         // access token的过期时间 单位 分钟
+	RefreshDuration int    `mapstructure:"refresh-duration"` // refresh token的过期时间 单位 小时
 }
 
 //
@@ -38,6 +39,16 @@ type RedisInfo struct {
 	Port int    `mapstructure:"port"` // redis的port
 }
 
+//
+// InvoiceConfig
+//  @Description: 发票中销售方的信息
+//
+type InvoiceConfig struct {
+	SellerName    string `mapstructure:"seller-name"`    // 销售方名称
+	SellerTaxID   string `mapstructure:"seller-tax-id"`  // 销售方税号
+	SellerAddress string `mapstructure:"seller-address"` // 销售方地址
+}
+
 type GrpcServer struct {
 	Name string `mapstructure:"name"` // 服务的名称 服务的名称应该是唯一的
 }
@@ -47,10 +58,12 @@ type GrpcServer struct {
 //  @Description: 整个服务的配置
 //
 type ServerInfo struct {
-	ServiceInfo     ServiceInfo  `mapstructure:"server-info"`       // 本地服务的信息
-	JaegerInfo      JaegerConfig `mapstructure:"jaeger-info"`       // jaeger 的 配置
-	Secret          Secret       `mapstructure:"secret"`            // token 密钥
-	Redis           RedisInfo    `mapstructure:"redis"`             // redis 的配置
-	OrderGrpcServer GrpcServer   `mapstructure:"order-grpc-server"` // order grpc server 的配置
-	GoodsGrpcServer GrpcServer   `mapstructure:"goods-grpc-server"` // goods grpc server 的配置
+	ServiceInfo     ServiceInfo       `mapstructure:"server-info"`       // 本地服务的信息
+	JaegerInfo      JaegerConfig      `mapstructure:"jaeger-info"`       // jaeger 的 配置
+	Secret          Secret            `mapstructure:"secret"`            // token 密钥
+	Redis           RedisInfo         `mapstructure:"redis"`             // redis 的配置
+	OrderGrpcServer GrpcServer        `mapstructure:"order-grpc-server"` // order grpc server 的配置
+	GoodsGrpcServer GrpcServer        `mapstructure:"goods-grpc-server"` // goods grpc server 的配置
+	Carrier         map[string]string `mapstructure:"carrier"`           // 物流公司回调的密钥 物流公司的名称 -> 密钥
+	Invoice         InvoiceConfig     `mapstructure:"invoice"`           // 发票的配置
 }
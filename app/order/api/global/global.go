@@ -6,8 +6,10 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/jimyag/shop/app/order/api/config"
+	"github.com/jimyag/shop/app/order/api/invoice"
 	"github.com/jimyag/shop/common/model"
 	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
 	"github.com/jimyag/shop/common/utils/paseto"
 )
 
@@ -20,4 +22,7 @@ var (
 	Trans          ut.Translator           // 公共的翻译
 	Validate       *validator.Validate     // 公共的validate
 	PasetoMaker    *paseto.PasetoMaker     // paseto 的maker
+	Revoker        *paseto.Revoker         // 检查 token 是否被吊销
+	Auth           *auth.Authenticator     // 验证 token 的中间件
+	Invoice        *invoice.Renderer       // 发票的渲染
 )
@@ -0,0 +1,18 @@
+package initialize
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/order/api/carrier"
+	"github.com/jimyag/shop/app/order/api/global"
+)
+
+//
+// InitCarrier
+//  @Description: 注册物流公司回调的适配器
+//
+func InitCarrier() {
+	secrets := global.RemoteConfig.Carrier
+	carrier.Register(carrier.NewMockAdapter(secrets["mock"]))
+	global.Logger.Info("初始化物流公司适配器成功......", zap.Int("carrier", len(secrets)))
+}
@@ -0,0 +1,26 @@
+package initialize
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/order/api/global"
+	"github.com/jimyag/shop/app/order/api/invoice"
+)
+
+//
+// InitInvoice
+//  @Description: 初始化发票的模板
+//
+func InitInvoice() {
+	info := global.RemoteConfig.Invoice
+	renderer, err := invoice.NewRenderer(invoice.Seller{
+		Name:    info.SellerName,
+		TaxID:   info.SellerTaxID,
+		Address: info.SellerAddress,
+	})
+	if err != nil {
+		global.Logger.Fatal("初始化发票模板失败", zap.Error(err))
+	}
+	global.Invoice = renderer
+	global.Logger.Info("初始化发票模板成功......")
+}
@@ -23,5 +23,8 @@ func InitRouter() *gin.Engine {
 	orderRouter := router.Group("/order/v1")
 	router2.OrderRouter(orderRouter)
 	router2.ShopCartRouter(orderRouter)
+	router2.WishlistRouter(orderRouter)
+	router2.CouponRouter(orderRouter)
+	router2.ShipmentRouter(orderRouter)
 	return router
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/jimyag/shop/app/order/api/global"
 	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
 	"github.com/jimyag/shop/common/utils/otgrpc"
 )
 
@@ -36,10 +37,12 @@ func initOrderClient() {
 		),
 		grpc.WithInsecure(),
 		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
-		grpc.WithUnaryInterceptor(
+		grpc.WithChainUnaryInterceptor(
 			otgrpc.OpenTracingClientInterceptor(
 				opentracing.GlobalTracer(),
 			),
+			// 把当前用户的身份传给 rpc 服务
+			auth.UnaryClientInterceptor(),
 		),
 	)
 
@@ -65,10 +68,12 @@ func initGoodsClient() {
 		),
 		grpc.WithInsecure(),
 		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
-		grpc.WithUnaryInterceptor(
+		grpc.WithChainUnaryInterceptor(
 			otgrpc.OpenTracingClientInterceptor(
 				opentracing.GlobalTracer(),
 			),
+			// 把当前用户的身份传给 rpc 服务
+			auth.UnaryClientInterceptor(),
 		),
 	)
 
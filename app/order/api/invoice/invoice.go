@@ -0,0 +1,102 @@
+package invoice
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"io"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/money"
+)
+
+//go:embed templates
+var templates embed.FS
+
+//
+// Seller
+//  @Description: 发票中销售方的信息
+//
+type Seller struct {
+	Name    string
+	TaxID   string
+	Address string
+}
+
+//
+// Renderer
+//  @Description: 使用模板把发票渲染为 HTML 和 PDF
+//
+type Renderer struct {
+	seller Seller
+	html   *htmltemplate.Template
+	text   *texttemplate.Template
+}
+
+// 模板中使用的数据
+type templateData struct {
+	Seller  Seller
+	Invoice *proto.InvoiceInfo
+}
+
+var templateFuncs = map[string]interface{}{
+	"amount": money.FormatAmount,
+	"date": func(unix int64) string {
+		return time.Unix(unix, 0).Format("2006-01-02")
+	},
+	"rate": func(rate float64) string {
+		return strconv.FormatFloat(rate*100, 'f', -1, 64) + "%"
+	},
+}
+
+//
+// NewRenderer
+//  @Description: 解析发票的模板
+//  @param seller
+//  @return *Renderer
+//  @return error
+//
+func NewRenderer(seller Seller) (*Renderer, error) {
+	html, err := htmltemplate.New("invoice.html").Funcs(templateFuncs).ParseFS(templates, "templates/invoice.html")
+	if err != nil {
+		return nil, err
+	}
+	text, err := texttemplate.New("invoice.txt").Funcs(templateFuncs).ParseFS(templates, "templates/invoice.txt")
+	if err != nil {
+		return nil, err
+	}
+	return &Renderer{seller: seller, html: html, text: text}, nil
+}
+
+//
+// HTML
+//  @Description: 把发票渲染为 HTML
+//  @receiver r
+//  @param w
+//  @param info
+//  @return error
+//
+func (r *Renderer) HTML(w io.Writer, info *proto.InvoiceInfo) error {
+	return r.html.Execute(w, templateData{Seller: r.seller, Invoice: info})
+}
+
+//
+// PDF
+//  @Description: 把发票渲染为 PDF，每一行文本是 PDF 中的一行
+//  @receiver r
+//  @param w
+//  @param info
+//  @return error
+//
+func (r *Renderer) PDF(w io.Writer, info *proto.InvoiceInfo) error {
+	buf := bytes.Buffer{}
+	if err := r.text.Execute(&buf, templateData{Seller: r.seller, Invoice: info}); err != nil {
+		return err
+	}
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	return writePDF(w, lines)
+}
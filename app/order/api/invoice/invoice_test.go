@@ -0,0 +1,94 @@
+package invoice
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jimyag/shop/common/proto"
+)
+
+func testInvoice() *proto.InvoiceInfo {
+	return &proto.InvoiceInfo{
+		InvoiceNo:       "INV2022000001",
+		OrderID:         10,
+		BuyerName:       "张三",
+		BuyerCompany:    "<某某公司>",
+		BuyerTaxID:      "91310000X",
+		Currency:        "CNY",
+		Lines:           []*proto.InvoiceLine{{GoodsName: "苹果", PriceCents: 1050, Nums: 2, AmountCents: 2100}},
+		Taxes:           []*proto.TaxLine{{Name: "增值税", Rate: 0.13, AmountCents: 273}},
+		GoodsTotalCents: 2100,
+		TaxCents:        273,
+		TotalCents:      2373,
+		IssuedAt:        1650000000,
+	}
+}
+
+func TestRenderer_HTML(t *testing.T) {
+	renderer, err := NewRenderer(Seller{Name: "商城", TaxID: "123"})
+	require.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	require.NoError(t, renderer.HTML(&buf, testInvoice()))
+	html := buf.String()
+	require.Contains(t, html, "INV2022000001")
+	require.Contains(t, html, "苹果")
+	require.Contains(t, html, "23.73")
+	require.Contains(t, html, "13%")
+	// 用户填写的抬头需要转义
+	require.Contains(t, html, "&lt;某某公司&gt;")
+}
+
+func TestRenderer_PDF(t *testing.T) {
+	renderer, err := NewRenderer(Seller{Name: "商城", TaxID: "123"})
+	require.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	require.NoError(t, renderer.PDF(&buf, testInvoice()))
+	pdf := buf.Bytes()
+	require.True(t, bytes.HasPrefix(pdf, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(pdf, []byte("%%EOF\n")))
+	require.Contains(t, string(pdf), encodeText("INV2022000001"))
+	checkXref(t, pdf)
+}
+
+func TestWritePDF_Pages(t *testing.T) {
+	lines := make([]string, linesPerPage*2+1)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("第%d行", i)
+	}
+	buf := bytes.Buffer{}
+	require.NoError(t, writePDF(&buf, lines))
+	require.Contains(t, buf.String(), "/Count 3")
+	checkXref(t, buf.Bytes())
+}
+
+func TestEncodeText(t *testing.T) {
+	require.Equal(t, "0041002D4E2D", encodeText("A-中"))
+	require.Equal(t, "003F", encodeText("😀"))
+}
+
+// checkXref 检查 xref 中的偏移量都指向对应的对象
+func checkXref(t *testing.T, pdf []byte) {
+	matches := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(pdf)
+	require.Len(t, matches, 2)
+	xref, err := strconv.Atoi(string(matches[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(pdf[xref:], []byte("xref\n")))
+
+	entries := strings.Split(string(pdf[xref:]), "\n")[3:]
+	for i, entry := range entries {
+		if !strings.HasSuffix(entry, " n ") {
+			break
+		}
+		offset, err := strconv.Atoi(entry[:10])
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(pdf[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))))
+	}
+}
@@ -33,3 +33,55 @@ type UpdateShopCartRequest struct {
 	Nums    int32 `json:"nums" validate:"required,min=1" label:"数量"`
 	Checker bool  `json:"checker" validate:"required" label:"是否选中"`
 }
+
+//
+// GuestCartItemRequest
+//  @Description: 游客添加商品到购物车的参数，购物车使用 cart token 区分
+//
+type GuestCartItemRequest struct {
+	GoodsId int32 `json:"goods_id" validate:"required,min=1" label:"商品ID"`
+	Nums    int32 `json:"nums" validate:"required,min=1" label:"数量"`
+	Checked bool  `json:"checked" label:"是否选中"`
+}
+
+//
+// GuestDeleteCartRequest
+//  @Description: 游客删除购物车项的参数
+//
+type GuestDeleteCartRequest struct {
+	GoodsId int32 `json:"goods_id" validate:"required,min=1" label:"商品ID"`
+}
+
+//
+// CheckCartItemsRequest
+//  @Description: 批量选中购物车商品的参数，没有商品时修改所有的商品
+//
+type CheckCartItemsRequest struct {
+	GoodsIds []int32 `json:"goods_ids" validate:"omitempty,dive,min=1" label:"商品ID"`
+	Checked  bool    `json:"checked" label:"是否选中"`
+}
+
+//
+// BatchDeleteCartRequest
+//  @Description: 批量删除购物车商品的参数
+//
+type BatchDeleteCartRequest struct {
+	GoodsIds []int32 `json:"goods_ids" validate:"required,min=1,dive,min=1" label:"商品ID"`
+}
+
+//
+// CartItemNums
+//  @Description: 购物车中商品的数量
+//
+type CartItemNums struct {
+	GoodsId int32 `json:"goods_id" validate:"required,min=1" label:"商品ID"`
+	Nums    int32 `json:"nums" validate:"required,min=1" label:"数量"`
+}
+
+//
+// BatchUpdateCartNumsRequest
+//  @Description: 批量修改购物车商品数量的参数
+//
+type BatchUpdateCartNumsRequest struct {
+	Items []CartItemNums `json:"items" validate:"required,min=1,dive" label:"商品"`
+}
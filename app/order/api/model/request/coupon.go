@@ -0,0 +1,26 @@
+package request
+
+//
+// CreateCouponTemplateRequest
+//  @Description: 新建优惠券模板的参数，有效期使用 unix 时间戳
+//
+type CreateCouponTemplateRequest struct {
+	Name         string  `json:"name" validate:"required" label:"名称"`
+	Type         int32   `json:"type" validate:"required,oneof=1 2 3" label:"类型"`
+	Amount       float64 `json:"amount" validate:"omitempty,min=0" label:"优惠金额"`
+	Rate         float32 `json:"rate" validate:"omitempty,gt=0,max=1" label:"折扣率"`
+	Threshold    float64 `json:"threshold" validate:"omitempty,min=0" label:"使用门槛"`
+	GoodsID      int32   `json:"goods_id" validate:"omitempty,min=1" label:"商品ID"`
+	Total        int32   `json:"total" validate:"omitempty,min=1" label:"发放总量"`
+	PerUserLimit int32   `json:"per_user_limit" validate:"omitempty,min=1" label:"每人限领"`
+	ValidFrom    int64   `json:"valid_from" validate:"required,min=1" label:"生效时间"`
+	ValidTo      int64   `json:"valid_to" validate:"required,gtfield=ValidFrom" label:"失效时间"`
+}
+
+//
+// IssueCouponRequest
+//  @Description: 领取优惠券的参数
+//
+type IssueCouponRequest struct {
+	TemplateID int64 `json:"template_id" validate:"required,min=1" label:"优惠券ID"`
+}
@@ -0,0 +1,20 @@
+package request
+
+//
+// IssueInvoiceRequest
+//  @Description: 为已支付的订单开具发票，已经开具过时返回原来的发票，抬头和税号只在第一次开具时生效
+//
+type IssueInvoiceRequest struct {
+	OrderID      int64  `json:"order_id" form:"order_id" validate:"required,min=1" label:"订单ID"`
+	BuyerCompany string `json:"buyer_company" form:"buyer_company" validate:"omitempty,max=128" label:"发票抬头"`
+	BuyerTaxID   string `json:"buyer_tax_id" form:"buyer_tax_id" validate:"omitempty,alphanum,max=32" label:"税号"`
+}
+
+//
+// GetInvoiceRequest
+//  @Description: 下载已经开具的发票
+//
+type GetInvoiceRequest struct {
+	OrderID int64  `json:"order_id" form:"order_id" validate:"required,min=1" label:"订单ID"`
+	Format  string `json:"format" form:"format" validate:"omitempty,oneof=html pdf" label:"格式"`
+}
@@ -7,7 +7,7 @@ package request
 type GetOrderListRequest struct {
 	PageNum  int32 `json:"page_num" validate:"required,min=1" label:"页码"`
 	PageSize int32 `json:"page_size" validate:"required,min=1" label:"每页数量"`
-	UserID   int32 `json:"user_id" validate:"required,min=1" label:"用户ID"`
+	UserID   int32 `json:"user_id" validate:"omitempty,min=1" label:"用户ID"` // 不传时为当前用户
 }
 
 //
@@ -21,13 +21,54 @@ type GetOrderDetailRequest struct {
 //
 // CreateOrderRequest
 //  @Description:  创建订单
+//  传了 address_id 时使用用户保存的收货地址，否则需要填写完整的收货信息
+//  传了 coupon_id 时使用该优惠券，currency 为空时使用基准货币下单
 //
 type CreateOrderRequest struct {
-	UserID  int32  `json:"user_id" validate:"required,min=1" label:"用户ID"`
-	Address string `json:"address" validate:"required" label:"收货地址"`
-	Mobile  string `json:"mobile" validate:"required" label:"手机号"`
-	Name    string `json:"name" validate:"required" label:"收货人"`
-	Post    string `json:"post" validate:"required" label:"邮编"`
+	UserID    int32  `json:"user_id" validate:"omitempty,min=1" label:"用户ID"` // 不传时为当前用户
+	AddressID int32  `json:"address_id" validate:"omitempty,min=1" label:"收货地址ID"`
+	Address   string `json:"address" validate:"required_without=AddressID" label:"收货地址"`
+	Mobile    string `json:"mobile" validate:"required_without=AddressID" label:"手机号"`
+	Name      string `json:"name" validate:"required_without=AddressID" label:"收货人"`
+	Post      string `json:"post" validate:"required_without=AddressID" label:"邮编"`
+	CouponID  int64  `json:"coupon_id" validate:"omitempty,min=1" label:"优惠券ID"`
+	Currency  string `json:"currency" validate:"omitempty,len=3" label:"币种"`
+}
+
+//
+// PreviewCheckoutRequest
+//  @Description: 结算前预览购物车，currency 为空时使用基准货币
+//
+type PreviewCheckoutRequest struct {
+	Currency string `json:"currency" validate:"omitempty,len=3" label:"币种"`
+}
+
+//
+// CancelOrderRequest
+//  @Description: 取消未支付的订单，restore_cart 为 true 时把商品放回购物车
+//
+type CancelOrderRequest struct {
+	OrderID     int64  `json:"order_id" validate:"required,min=1" label:"订单ID"`
+	Reason      string `json:"reason" validate:"omitempty,max=255" label:"取消原因"`
+	RestoreCart bool   `json:"restore_cart" label:"放回购物车"`
+}
+
+//
+// SearchOrdersRequest
+//  @Description: 后台搜索订单，条件为 0 或者空时不过滤，时间是 unix 秒
+//
+type SearchOrdersRequest struct {
+	Status         int32  `json:"status" form:"status" validate:"omitempty,oneof=1 2 3 4 5" label:"订单状态"`
+	UserID         int32  `json:"user_id" form:"user_id" validate:"omitempty,min=1" label:"用户ID"`
+	CreatedFrom    int64  `json:"created_from" form:"created_from" validate:"omitempty,min=0" label:"开始时间"`
+	CreatedTo      int64  `json:"created_to" form:"created_to" validate:"omitempty,gtfield=CreatedFrom" label:"结束时间"`
+	MinAmountCents int64  `json:"min_amount_cents" form:"min_amount_cents" validate:"omitempty,min=0" label:"最小金额"`
+	MaxAmountCents int64  `json:"max_amount_cents" form:"max_amount_cents" validate:"omitempty,min=0" label:"最大金额"`
+	OrderIDPrefix  string `json:"order_id_prefix" form:"order_id_prefix" validate:"omitempty,numeric,max=20" label:"订单号前缀"`
+	GoodsID        int32  `json:"goods_id" form:"goods_id" validate:"omitempty,min=1" label:"商品ID"`
+	Sort           string `json:"sort" form:"sort" validate:"omitempty,oneof=created_at_desc created_at_asc amount_desc amount_asc" label:"排序"`
+	PageNum        int32  `json:"page_num" form:"page_num" validate:"omitempty,min=1" label:"页码"`
+	PageSize       int32  `json:"page_size" form:"page_size" validate:"omitempty,min=1,max=1000" label:"每页数量"`
 }
 
 //
@@ -0,0 +1,21 @@
+package request
+
+//
+// SalesReportRequest
+//  @Description: 销售报表的日期范围，格式为 2006-01-02，包含开始和结束的日期
+//
+type SalesReportRequest struct {
+	FromDay string `json:"from_day" form:"from_day" validate:"required,datetime=2006-01-02" label:"开始日期"`
+	ToDay   string `json:"to_day" form:"to_day" validate:"required,datetime=2006-01-02" label:"结束日期"`
+}
+
+//
+// GoodsSalesRequest
+//  @Description: 商品销售排行的参数
+//
+type GoodsSalesRequest struct {
+	FromDay string `json:"from_day" form:"from_day" validate:"required,datetime=2006-01-02" label:"开始日期"`
+	ToDay   string `json:"to_day" form:"to_day" validate:"required,datetime=2006-01-02" label:"结束日期"`
+	Sort    string `json:"sort" form:"sort" validate:"omitempty,oneof=nums revenue" label:"排序"`
+	Limit   int32  `json:"limit" form:"limit" validate:"omitempty,min=1,max=100" label:"数量"`
+}
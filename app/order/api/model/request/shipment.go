@@ -0,0 +1,19 @@
+package request
+
+//
+// CreateShipmentRequest
+//  @Description: 订单发货的参数
+//
+type CreateShipmentRequest struct {
+	OrderID    int64  `json:"order_id" validate:"required,min=1" label:"订单ID"`
+	Carrier    string `json:"carrier" validate:"required,max=32" label:"物流公司"`
+	TrackingNo string `json:"tracking_no" validate:"required,max=64" label:"运单号"`
+}
+
+//
+// GetOrderTrackingRequest
+//  @Description: 获取订单物流信息的参数
+//
+type GetOrderTrackingRequest struct {
+	OrderID int64 `json:"order_id" validate:"required,min=1" label:"订单ID"`
+}
@@ -0,0 +1,18 @@
+package request
+
+//
+// WishlistItemRequest
+//  @Description: 收藏或取消收藏商品的参数
+//
+type WishlistItemRequest struct {
+	GoodsId int32 `json:"goods_id" validate:"required,min=1" label:"商品ID"`
+}
+
+//
+// MoveWishlistItemToCartRequest
+//  @Description: 将收藏的商品移入购物车的参数
+//
+type MoveWishlistItemToCartRequest struct {
+	GoodsId int32 `json:"goods_id" validate:"required,min=1" label:"商品ID"`
+	Nums    int32 `json:"nums" validate:"omitempty,min=1" label:"数量"`
+}
@@ -27,14 +27,20 @@ func main() {
 	// 初始化 grpc 的 client
 	initialize.InitGrpcClient()
 
+	// 初始化Paseto 路由中使用的认证中间件依赖它
+	initialize.InitPaseto()
+
 	// 初始化router
 	router := initialize.InitRouter()
 
 	// 初始化 validate 和 trans
 	initialize.InitValidateAndTrans()
 
-	// 初始化Paseto
-	initialize.InitPaseto()
+	// 初始化物流公司的适配器
+	initialize.InitCarrier()
+
+	// 初始化发票的模板
+	initialize.InitInvoice()
 
 	registerClient := consul.NewRegistryHttpClient(
 		global.ConfigCenter.Host,
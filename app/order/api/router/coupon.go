@@ -0,0 +1,23 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jimyag/shop/app/order/api/api"
+	"github.com/jimyag/shop/app/order/api/global"
+	"github.com/jimyag/shop/app/order/api/middlewares"
+	"github.com/jimyag/shop/common/utils/auth"
+)
+
+func CouponRouter(router *gin.RouterGroup) {
+	baseRouter := router.Group("")
+	baseRouter.Use(middlewares.Tracing())
+
+	privateRouter := baseRouter.Group("coupon")
+	privateRouter.Use(global.Auth.Paseto())
+	{
+		privateRouter.POST("template", auth.RequirePermission(auth.PermCouponWrite), api.CreateCouponTemplate) // 新建优惠券模板
+		privateRouter.POST("issue", api.IssueCoupon)                                                           // 领取优惠券
+		privateRouter.GET("list", api.GetUserCouponList)                                                       // 获得我的优惠券
+	}
+}
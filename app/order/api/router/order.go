@@ -4,7 +4,9 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/jimyag/shop/app/order/api/api"
+	"github.com/jimyag/shop/app/order/api/global"
 	"github.com/jimyag/shop/app/order/api/middlewares"
+	"github.com/jimyag/shop/common/utils/auth"
 )
 
 func OrderRouter(router *gin.RouterGroup) {
@@ -14,11 +16,27 @@ func OrderRouter(router *gin.RouterGroup) {
 	publicRouter.Use()
 
 	privateRouter := baseRouter.Group("order")
-	privateRouter.Use(middlewares.Paseto())
+	privateRouter.Use(global.Auth.Paseto())
 	{
-		privateRouter.POST("create", api.CreateOrder)    // 创建订单
-		privateRouter.GET("info", api.GetOrderDetail)    // 获得订单详情
-		privateRouter.GET("infos", api.GetOrderList)     // 获得个人订单列表
-		privateRouter.PUT("update", api.UpdateOrderInfo) // 更新订单
+		privateRouter.POST("preview", api.PreviewCheckout)  // 结算前检查价格和库存
+		privateRouter.POST("quote", api.QuoteOrder)         // 计算订单的运费和税费
+		privateRouter.POST("create", api.CreateOrder)       // 创建订单
+		privateRouter.POST("cancel", api.CancelOrder)       // 取消未支付的订单
+		privateRouter.GET("info", api.GetOrderDetail)       // 获得订单详情
+		privateRouter.GET("infos", api.GetOrderList)        // 获得个人订单列表
+		privateRouter.GET("tracking", api.GetOrderTracking) // 获得订单的物流信息
+		privateRouter.POST("invoice", api.IssueInvoice)     // 开具订单的发票
+		privateRouter.GET("invoice", api.GetInvoice)        // 下载已经开具的发票
+
+		// 修改订单的状态会核销优惠券和计入销售额，只有管理员可以修改
+		privateRouter.PUT("update", auth.RequirePermission(auth.PermOrderWriteAll), api.UpdateOrderInfo)
+	}
+
+	adminRouter := privateRouter.Group("admin")
+	{
+		adminRouter.GET("search", auth.RequirePermission(auth.PermOrderReadAll), api.SearchOrders)         // 管理员搜索所有用户的订单
+		adminRouter.GET("export", auth.RequirePermission(auth.PermOrderReadAll), api.ExportOrders)         // 管理员导出订单为 CSV
+		adminRouter.GET("report/sales", auth.RequirePermission(auth.PermReportRead), api.SalesReport)      // 管理员查看销售报表
+		adminRouter.GET("report/goods", auth.RequirePermission(auth.PermReportRead), api.GoodsSalesReport) // 管理员查看商品销售排行
 	}
 }
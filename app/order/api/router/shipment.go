@@ -0,0 +1,27 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jimyag/shop/app/order/api/api"
+	"github.com/jimyag/shop/app/order/api/global"
+	"github.com/jimyag/shop/app/order/api/middlewares"
+	"github.com/jimyag/shop/common/utils/auth"
+)
+
+func ShipmentRouter(router *gin.RouterGroup) {
+	baseRouter := router.Group("")
+	baseRouter.Use(middlewares.Tracing())
+
+	// 物流公司的回调使用各自的签名验证
+	publicRouter := baseRouter.Group("shipment")
+	{
+		publicRouter.POST("callback/:carrier", api.CarrierCallback) // 物流公司推送物流事件
+	}
+
+	privateRouter := baseRouter.Group("shipment")
+	privateRouter.Use(global.Auth.Paseto())
+	{
+		privateRouter.POST("create", auth.RequirePermission(auth.PermShipmentWrite), api.CreateShipment) // 订单发货
+	}
+}
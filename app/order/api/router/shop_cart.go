@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/jimyag/shop/app/order/api/api"
+	"github.com/jimyag/shop/app/order/api/global"
 	"github.com/jimyag/shop/app/order/api/middlewares"
 )
 
@@ -14,11 +15,23 @@ func ShopCartRouter(router *gin.RouterGroup) {
 	publicRouter.Use()
 
 	privateRouter := baseRouter.Group("cart")
-	privateRouter.Use(middlewares.Paseto())
+	privateRouter.Use(global.Auth.Paseto())
 	{
-		privateRouter.POST("create", api.CreateShopCart)       // 添加商品到购物车记录
-		privateRouter.GET("list", api.GetShopCartList)         // 获得购物车列表
-		privateRouter.DELETE("remove", api.DeleteShopCartItem) // 删除购物车的某条记录
-		privateRouter.PUT("update", api.UpdateShopCartItem)    // 更新的某条记录
+		privateRouter.POST("create", api.CreateShopCart)                   // 添加商品到购物车记录
+		privateRouter.GET("list", api.GetShopCartList)                     // 获得购物车列表 detail=true 时返回商品价格和库存
+		privateRouter.DELETE("remove", api.DeleteShopCartItem)             // 删除购物车的某条记录
+		privateRouter.PUT("update", api.UpdateShopCartItem)                // 更新的某条记录
+		privateRouter.PUT("check", api.CheckShopCartItems)                 // 批量选中 没有商品时全选
+		privateRouter.DELETE("batch/remove", api.BatchDeleteShopCartItems) // 批量删除
+		privateRouter.PUT("batch/update", api.BatchUpdateShopCartNums)     // 批量修改数量
+	}
+
+	// 游客的购物车使用 cart token 区分，登录后合并到用户的购物车
+	guestRouter := baseRouter.Group("guest/cart")
+	{
+		guestRouter.POST("create", api.CreateGuestCartItem)   // 添加商品到游客的购物车
+		guestRouter.GET("list", api.GetGuestCartList)         // 获得游客的购物车
+		guestRouter.DELETE("remove", api.DeleteGuestCartItem) // 删除游客购物车的某条记录
+		guestRouter.PUT("update", api.UpdateGuestCartItem)    // 更新游客购物车的某条记录
 	}
 }
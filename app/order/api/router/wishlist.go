@@ -0,0 +1,23 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jimyag/shop/app/order/api/api"
+	"github.com/jimyag/shop/app/order/api/global"
+	"github.com/jimyag/shop/app/order/api/middlewares"
+)
+
+func WishlistRouter(router *gin.RouterGroup) {
+	baseRouter := router.Group("")
+	baseRouter.Use(middlewares.Tracing())
+
+	privateRouter := baseRouter.Group("wishlist")
+	privateRouter.Use(global.Auth.Paseto())
+	{
+		privateRouter.GET("list", api.GetWishlist)                     // 获得收藏列表
+		privateRouter.POST("create", api.CreateWishlistItem)           // 收藏商品
+		privateRouter.DELETE("remove", api.DeleteWishlistItem)         // 取消收藏
+		privateRouter.POST("move_to_cart", api.MoveWishlistItemToCart) // 将收藏的商品移入购物车
+	}
+}
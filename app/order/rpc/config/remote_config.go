@@ -40,11 +40,14 @@ type JaegerConfig struct {
 
 // ALLConfig 需要用的远程配置文件
 type ALLConfig struct {
-	Postgres    Postgres     `mapstructure:"postgres"`
-	ServiceInfo ServiceInfo  `mapstructure:"service-info"`
-	ConsulInfo  ConsulInfo   `mapstructure:"consul-info"`
-	JaegerInfo  JaegerConfig `mapstructure:"jaeger-info"`
-	ThirdServer ThirdServer  `mapstructure:"third-server"`
+	Postgres     Postgres      `mapstructure:"postgres"`
+	ServiceInfo  ServiceInfo   `mapstructure:"service-info"`
+	ConsulInfo   ConsulInfo    `mapstructure:"consul-info"`
+	JaegerInfo   JaegerConfig  `mapstructure:"jaeger-info"`
+	ThirdServer  ThirdServer   `mapstructure:"third-server"`
+	Pricing      PricingConfig `mapstructure:"pricing"`
+	Report       ReportConfig  `mapstructure:"report"`
+	BaseCurrency string        `mapstructure:"base-currency"` // 基准货币 和商品服务一致 优惠券和运费规则的金额使用这个币种
 }
 
 //
@@ -62,4 +65,49 @@ type GrpcServer struct {
 type ThirdServer struct {
 	GoodsGrpcServer     GrpcServer `mapstructure:"goods-grpc-server"`
 	InventoryGrpcServer GrpcServer `mapstructure:"inventory-grpc-server"`
+	UserGrpcServer      GrpcServer `mapstructure:"user-grpc-server"`
+}
+
+//
+// ShippingRule
+//  @Description: 运费规则，金额是基准货币的最小单位
+//
+type ShippingRule struct {
+	Name          string   `mapstructure:"name"`           // 规则的名称
+	Regions       []string `mapstructure:"regions"`        // 适用的地区 纯数字为邮编的前缀 其他为地址中包含的地名 为空表示默认规则
+	Mode          string   `mapstructure:"mode"`           // 计算方式 count 按件数 weight 按重量
+	FirstUnits    int64    `mapstructure:"first-units"`    // 首件的件数或首重的克数
+	FirstFee      int64    `mapstructure:"first-fee"`      // 首件或首重的运费
+	ExtraUnits    int64    `mapstructure:"extra-units"`    // 每个续件的件数或续重的克数
+	ExtraFee      int64    `mapstructure:"extra-fee"`      // 每个续件或续重的运费
+	FreeThreshold int64    `mapstructure:"free-threshold"` // 满额包邮 0 表示不包邮
+}
+
+//
+// TaxRule
+//  @Description: 税费规则
+//
+type TaxRule struct {
+	Name            string   `mapstructure:"name"`             // 税的名称
+	Rate            float64  `mapstructure:"rate"`             // 税率
+	Regions         []string `mapstructure:"regions"`          // 适用的地区 为空表示所有地区
+	IncludeShipping bool     `mapstructure:"include-shipping"` // 运费是否计税
+}
+
+//
+// PricingConfig
+//  @Description: 运费和税费的配置
+//
+type PricingConfig struct {
+	Shipping        []ShippingRule `mapstructure:"shipping"`
+	Taxes           []TaxRule      `mapstructure:"taxes"`
+	RefreshInterval int            `mapstructure:"refresh-interval"` // 重新拉取规则的间隔 单位 秒 0 表示不刷新
+}
+
+//
+// ReportConfig
+//  @Description: 销售报表的配置
+//
+type ReportConfig struct {
+	RefreshInterval int `mapstructure:"refresh-interval"` // 刷新汇总表的间隔 单位 秒 0 表示不刷新
 }
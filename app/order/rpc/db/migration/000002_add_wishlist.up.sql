@@ -0,0 +1,13 @@
+CREATE TABLE "wishlist"
+(
+    "id"         bigserial PRIMARY KEY,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now()),
+    "deleted_at" timestamptz          DEFAULT null,
+    "user_id"    integer     NOT NULL,
+    "goods_id"   integer     NOT NULL
+);
+
+CREATE INDEX ON "wishlist" ("user_id");
+
+CREATE UNIQUE INDEX ON "wishlist" ("user_id", "goods_id") WHERE deleted_at IS NULL;
@@ -0,0 +1,10 @@
+ALTER TABLE "order_goods"
+    DROP COLUMN IF EXISTS "discount_amount";
+
+ALTER TABLE "order_info"
+    DROP COLUMN IF EXISTS "coupon_id",
+    DROP COLUMN IF EXISTS "goods_amount",
+    DROP COLUMN IF EXISTS "discount_amount";
+
+DROP TABLE IF EXISTS "user_coupon";
+DROP TABLE IF EXISTS "coupon_template";
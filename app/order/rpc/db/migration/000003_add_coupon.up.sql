@@ -0,0 +1,45 @@
+CREATE TABLE "coupon_template"
+(
+    "id"             bigserial PRIMARY KEY,
+    "created_at"     timestamptz NOT NULL DEFAULT (now()),
+    "updated_at"     timestamptz NOT NULL DEFAULT (now()),
+    "deleted_at"     timestamptz          DEFAULT null,
+    "name"           varchar     NOT NULL,
+    "type"           int2        NOT NULL, -- 1 立减 2 折扣 3 满减
+    "amount"         float       NOT NULL DEFAULT 0, -- 立减和满减的金额
+    "rate"           float       NOT NULL DEFAULT 1, -- 折扣率 0.8 表示八折
+    "threshold"      float       NOT NULL DEFAULT 0, -- 使用门槛 0 表示没有门槛
+    "goods_id"       integer     NOT NULL DEFAULT 0, -- 适用的商品 0 表示全部商品
+    "total"          integer     NOT NULL DEFAULT 0, -- 发放总量 0 表示不限量
+    "issued"         integer     NOT NULL DEFAULT 0, -- 已经发放的数量
+    "per_user_limit" integer     NOT NULL DEFAULT 1, -- 每个用户最多领取的数量
+    "valid_from"     timestamptz NOT NULL,
+    "valid_to"       timestamptz NOT NULL
+);
+
+CREATE TABLE "user_coupon"
+(
+    "id"          bigserial PRIMARY KEY,
+    "created_at"  timestamptz NOT NULL DEFAULT (now()),
+    "updated_at"  timestamptz NOT NULL DEFAULT (now()),
+    "deleted_at"  timestamptz          DEFAULT null,
+    "user_id"     integer     NOT NULL,
+    "template_id" int8        NOT NULL,
+    "status"      int2        NOT NULL DEFAULT 1, -- 1 未使用 2 下单锁定 3 已使用
+    "order_id"    int8,                           -- 锁定或者使用这张优惠券的订单
+    "used_at"     timestamptz
+);
+
+CREATE INDEX ON "user_coupon" ("user_id");
+
+CREATE INDEX ON "user_coupon" ("template_id");
+
+CREATE INDEX ON "user_coupon" ("order_id");
+
+ALTER TABLE "order_info"
+    ADD COLUMN "coupon_id"       int8,                       -- 使用的用户优惠券
+    ADD COLUMN "goods_amount"    float NOT NULL DEFAULT 0,   -- 商品总金额
+    ADD COLUMN "discount_amount" float NOT NULL DEFAULT 0;   -- 优惠金额
+
+ALTER TABLE "order_goods"
+    ADD COLUMN "discount_amount" float NOT NULL DEFAULT 0; -- 分摊到这件商品上的优惠金额
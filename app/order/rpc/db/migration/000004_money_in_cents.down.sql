@@ -0,0 +1,12 @@
+ALTER TABLE "coupon_template"
+    ALTER COLUMN "amount" TYPE float USING "amount"::float / 100,
+    ALTER COLUMN "threshold" TYPE float USING "threshold"::float / 100;
+
+ALTER TABLE "order_goods"
+    ALTER COLUMN "goods_price" TYPE float USING "goods_price"::float / 100,
+    ALTER COLUMN "discount_amount" TYPE float USING "discount_amount"::float / 100;
+
+ALTER TABLE "order_info"
+    ALTER COLUMN "order_mount" TYPE float USING "order_mount"::float / 100,
+    ALTER COLUMN "goods_amount" TYPE float USING "goods_amount"::float / 100,
+    ALTER COLUMN "discount_amount" TYPE float USING "discount_amount"::float / 100;
@@ -0,0 +1,13 @@
+-- 所有的金额改为以分为单位的整数，避免浮点数的误差
+ALTER TABLE "order_info"
+    ALTER COLUMN "order_mount" TYPE int8 USING round("order_mount"::numeric * 100)::int8,
+    ALTER COLUMN "goods_amount" TYPE int8 USING round("goods_amount"::numeric * 100)::int8,
+    ALTER COLUMN "discount_amount" TYPE int8 USING round("discount_amount"::numeric * 100)::int8;
+
+ALTER TABLE "order_goods"
+    ALTER COLUMN "goods_price" TYPE int8 USING round("goods_price"::numeric * 100)::int8,
+    ALTER COLUMN "discount_amount" TYPE int8 USING round("discount_amount"::numeric * 100)::int8;
+
+ALTER TABLE "coupon_template"
+    ALTER COLUMN "amount" TYPE int8 USING round("amount"::numeric * 100)::int8,
+    ALTER COLUMN "threshold" TYPE int8 USING round("threshold"::numeric * 100)::int8;
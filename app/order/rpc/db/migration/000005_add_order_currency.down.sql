@@ -0,0 +1,3 @@
+ALTER TABLE "order_info"
+    DROP COLUMN IF EXISTS "currency",
+    DROP COLUMN IF EXISTS "exchange_rate";
@@ -0,0 +1,4 @@
+-- 订单的币种和下单时的汇率快照，订单中的金额都是该币种的最小单位，之后汇率变化不会影响历史订单
+ALTER TABLE "order_info"
+    ADD COLUMN "currency"      varchar(3) NOT NULL DEFAULT 'CNY',
+    ADD COLUMN "exchange_rate" float      NOT NULL DEFAULT 1; -- 1 单位基准货币可以兑换多少该币种
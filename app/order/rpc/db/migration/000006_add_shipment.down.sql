@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "shipment_event";
+DROP TABLE IF EXISTS "shipment";
@@ -0,0 +1,31 @@
+-- 订单的状态增加 4 已发货 5 已签收
+CREATE TABLE "shipment"
+(
+    "id"           bigserial PRIMARY KEY,
+    "created_at"   timestamptz NOT NULL DEFAULT (now()),
+    "updated_at"   timestamptz NOT NULL DEFAULT (now()),
+    "deleted_at"   timestamptz          DEFAULT null,
+    "order_id"     int8        NOT NULL,
+    "carrier"      varchar(32) NOT NULL,           -- 物流公司
+    "tracking_no"  varchar(64) NOT NULL,           -- 运单号
+    "status"       int2        NOT NULL DEFAULT 1, -- 1 待揽收 2 已揽收 3 运输中 4 已签收
+    "delivered_at" timestamptz
+);
+
+CREATE TABLE "shipment_event"
+(
+    "id"          bigserial PRIMARY KEY,
+    "created_at"  timestamptz NOT NULL DEFAULT (now()),
+    "shipment_id" int8        NOT NULL,
+    "status"      int2        NOT NULL,
+    "location"    varchar     NOT NULL DEFAULT '',
+    "description" varchar     NOT NULL DEFAULT '',
+    "occurred_at" timestamptz NOT NULL -- 物流公司记录的时间
+);
+
+CREATE INDEX ON "shipment" ("order_id");
+
+CREATE UNIQUE INDEX ON "shipment" ("carrier", "tracking_no") WHERE deleted_at IS NULL;
+
+-- 物流公司的回调可能重复推送
+CREATE UNIQUE INDEX ON "shipment_event" ("shipment_id", "status", "occurred_at");
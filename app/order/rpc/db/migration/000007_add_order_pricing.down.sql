@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "order_tax";
+
+ALTER TABLE "order_info"
+    DROP COLUMN IF EXISTS "shipping_fee",
+    DROP COLUMN IF EXISTS "shipping_rule",
+    DROP COLUMN IF EXISTS "tax_amount";
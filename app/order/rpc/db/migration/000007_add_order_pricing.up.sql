@@ -0,0 +1,17 @@
+-- 订单的金额 order_mount = goods_amount - discount_amount + shipping_fee + tax_amount
+ALTER TABLE "order_info"
+    ADD COLUMN "shipping_fee"  int8        NOT NULL DEFAULT 0,  -- 运费 单位 分
+    ADD COLUMN "shipping_rule" varchar(64) NOT NULL DEFAULT '', -- 计算运费使用的规则
+    ADD COLUMN "tax_amount"    int8        NOT NULL DEFAULT 0;  -- 所有税费的和 单位 分
+
+CREATE TABLE "order_tax"
+(
+    "id"         bigserial PRIMARY KEY,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "order_id"   int8        NOT NULL,
+    "name"       varchar(32) NOT NULL, -- 税的名称
+    "rate"       float       NOT NULL, -- 下单时的税率
+    "amount"     int8        NOT NULL  -- 税费 单位 分
+);
+
+CREATE INDEX ON "order_tax" ("order_id");
@@ -0,0 +1,2 @@
+ALTER TABLE "shopping_cart"
+    DROP COLUMN IF EXISTS "price";
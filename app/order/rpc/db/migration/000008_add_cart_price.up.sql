@@ -0,0 +1,3 @@
+-- 加入购物车时的商品价格，结算前用来提示价格变化
+ALTER TABLE "shopping_cart"
+    ADD COLUMN "price" int8 NOT NULL DEFAULT 0; -- 基准货币 单位 分 0 表示没有记录
@@ -0,0 +1,6 @@
+DELETE
+FROM "shopping_cart"
+WHERE cart_token <> '';
+
+ALTER TABLE "shopping_cart"
+    DROP COLUMN IF EXISTS "cart_token";
@@ -0,0 +1,5 @@
+-- 游客的购物车 user_id 为 0 使用 cart_token 区分，登录后合并到用户的购物车
+ALTER TABLE "shopping_cart"
+    ADD COLUMN "cart_token" varchar(64) NOT NULL DEFAULT ''; -- 登录用户的购物车为空
+
+CREATE INDEX ON "shopping_cart" ("cart_token") WHERE cart_token <> '';
@@ -0,0 +1,3 @@
+ALTER TABLE "order_info"
+    DROP COLUMN IF EXISTS "cancelled_at",
+    DROP COLUMN IF EXISTS "cancel_reason";
@@ -0,0 +1,4 @@
+-- 用户取消未支付的订单时记录取消的原因
+ALTER TABLE "order_info"
+    ADD COLUMN "cancel_reason" varchar(255) NOT NULL DEFAULT '',
+    ADD COLUMN "cancelled_at"  timestamptz NULL;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS "order_info_order_id_text_idx";
+
+DROP INDEX IF EXISTS "order_info_order_mount_idx";
+
+DROP INDEX IF EXISTS "order_info_created_at_idx";
+
+DROP INDEX IF EXISTS "order_info_status_idx";
@@ -0,0 +1,9 @@
+-- 后台搜索订单使用的索引
+CREATE INDEX ON "order_info" ("status");
+
+CREATE INDEX ON "order_info" ("created_at");
+
+CREATE INDEX ON "order_info" ("order_mount");
+
+-- 订单号前缀查询
+CREATE INDEX "order_info_order_id_text_idx" ON "order_info" ((order_id::text) text_pattern_ops);
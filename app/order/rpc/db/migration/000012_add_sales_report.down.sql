@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS "shopping_cart_updated_at_idx";
+
+DROP INDEX IF EXISTS "order_info_updated_at_idx";
+
+DROP TABLE IF EXISTS "report_refresh";
+
+DROP TABLE IF EXISTS "conversion_daily";
+
+DROP TABLE IF EXISTS "sales_goods_daily";
+
+DROP TABLE IF EXISTS "sales_daily";
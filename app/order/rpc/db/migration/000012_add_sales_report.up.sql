@@ -0,0 +1,46 @@
+-- 销售报表的汇总表 由后台任务增量刷新 金额都是订单币种的最小单位
+CREATE TABLE "sales_daily"
+(
+    "day"              date        NOT NULL,
+    "currency"         varchar(3)  NOT NULL,
+    "order_count"      integer     NOT NULL DEFAULT 0, -- 下单数量
+    "paid_order_count" integer     NOT NULL DEFAULT 0, -- 已支付的订单数量 包括已发货和已签收
+    "revenue"          int8        NOT NULL DEFAULT 0, -- 已支付订单的金额
+    "discount"         int8        NOT NULL DEFAULT 0, -- 已支付订单的优惠
+    "updated_at"       timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("day", "currency")
+);
+
+CREATE TABLE "sales_goods_daily"
+(
+    "day"        date        NOT NULL,
+    "goods_id"   integer     NOT NULL,
+    "currency"   varchar(3)  NOT NULL,
+    "goods_name" varchar     NOT NULL,
+    "nums"       integer     NOT NULL DEFAULT 0, -- 已支付订单中的销量
+    "revenue"    int8        NOT NULL DEFAULT 0, -- 扣除优惠后的销售额
+    "updated_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("day", "goods_id", "currency")
+);
+
+CREATE INDEX ON "sales_goods_daily" ("goods_id");
+
+-- 转化率 当天加入购物车的用户中有多少下了单
+CREATE TABLE "conversion_daily"
+(
+    "day"         date        NOT NULL PRIMARY KEY,
+    "cart_users"  integer     NOT NULL DEFAULT 0, -- 加入购物车的用户 游客按照 cart_token 计算
+    "order_users" integer     NOT NULL DEFAULT 0, -- 下单的用户
+    "updated_at"  timestamptz NOT NULL DEFAULT (now())
+);
+
+-- 每个报表刷新到的时间
+CREATE TABLE "report_refresh"
+(
+    "name"            varchar(32) NOT NULL PRIMARY KEY,
+    "refreshed_until" timestamptz NOT NULL
+);
+
+CREATE INDEX ON "order_info" ("updated_at");
+
+CREATE INDEX ON "shopping_cart" ("updated_at");
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "invoice_line";
+
+DROP TABLE IF EXISTS "invoice";
+
+DROP TABLE IF EXISTS "invoice_sequence";
@@ -0,0 +1,47 @@
+-- 每年的发票号从 1 开始连续递增，和发票在同一个事务中更新，事务回滚时不会跳号
+CREATE TABLE "invoice_sequence"
+(
+    "year"        integer NOT NULL PRIMARY KEY,
+    "last_number" integer NOT NULL
+);
+
+-- 已支付订单的发票 开具后不再修改 金额都是订单币种的最小单位
+CREATE TABLE "invoice"
+(
+    "id"              bigserial    PRIMARY KEY,
+    "created_at"      timestamptz  NOT NULL DEFAULT (now()),
+    "invoice_no"      varchar(32)  UNIQUE NOT NULL,
+    "year"            integer      NOT NULL,
+    "number"          integer      NOT NULL,
+    "order_id"        int8 UNIQUE  NOT NULL,
+    "user_id"         integer      NOT NULL,
+    "buyer_name"      varchar(40)  NOT NULL,
+    "buyer_mobile"    varchar(20)  NOT NULL,
+    "buyer_address"   varchar      NOT NULL,
+    "buyer_post"      varchar      NOT NULL,
+    "buyer_company"   varchar(128) NOT NULL DEFAULT '', -- 企业的发票抬头
+    "buyer_tax_id"    varchar(32)  NOT NULL DEFAULT '', -- 企业的税号
+    "currency"        varchar(3)   NOT NULL,
+    "goods_amount"    int8         NOT NULL,
+    "discount_amount" int8         NOT NULL,
+    "shipping_fee"    int8         NOT NULL,
+    "tax_amount"      int8         NOT NULL,
+    "total_amount"    int8         NOT NULL,
+    UNIQUE ("year", "number")
+);
+
+CREATE INDEX ON "invoice" ("user_id");
+
+CREATE TABLE "invoice_line"
+(
+    "id"              bigserial PRIMARY KEY,
+    "invoice_id"      int8    NOT NULL,
+    "goods_id"        integer NOT NULL,
+    "goods_name"      varchar NOT NULL,
+    "price"           int8    NOT NULL,
+    "nums"            integer NOT NULL,
+    "discount_amount" int8    NOT NULL,
+    "amount"          int8    NOT NULL -- price * nums - discount_amount
+);
+
+CREATE INDEX ON "invoice_line" ("invoice_id");
@@ -0,0 +1,73 @@
+-- name: CreateCouponTemplate :one
+INSERT INTO "coupon_template"(name, type, amount, rate, threshold, goods_id, total, per_user_limit, valid_from, valid_to)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+returning *;
+
+-- name: GetCouponTemplate :one
+SELECT *
+FROM "coupon_template"
+WHERE id = $1
+  and deleted_at IS NULL;
+
+-- name: IssueCouponTemplate :one
+UPDATE "coupon_template"
+set updated_at = $1,
+    issued     = issued + 1
+where id = $2
+  and (total = 0 or issued < total)
+  and deleted_at IS NULL
+returning *;
+
+-- name: CreateUserCoupon :one
+INSERT INTO "user_coupon"(user_id, template_id)
+VALUES ($1, $2)
+returning *;
+
+-- name: CountUserCouponByTemplate :one
+SELECT count(*)
+FROM "user_coupon"
+WHERE user_id = $1
+  and template_id = $2
+  and deleted_at IS NULL;
+
+-- name: GetUserCoupon :one
+SELECT *
+FROM "user_coupon"
+WHERE id = $1
+  and user_id = $2
+  and deleted_at IS NULL;
+
+-- name: GetUserCouponList :many
+SELECT *
+FROM "user_coupon"
+WHERE user_id = $1
+  and deleted_at IS NULL
+order by id desc;
+
+-- name: LockUserCoupon :execrows
+UPDATE "user_coupon"
+set updated_at = $1,
+    status     = 2,
+    order_id   = $2
+where id = $3
+  and user_id = $4
+  and status = 1
+  and deleted_at IS NULL;
+
+-- name: UseUserCouponByOrderID :execrows
+UPDATE "user_coupon"
+set updated_at = $1,
+    status     = 3,
+    used_at    = $1
+where order_id = $2
+  and status = 2
+  and deleted_at IS NULL;
+
+-- name: ReleaseUserCouponByOrderID :execrows
+UPDATE "user_coupon"
+set updated_at = $1,
+    status     = 1,
+    order_id   = null
+where order_id = $2
+  and status = 2
+  and deleted_at IS NULL;
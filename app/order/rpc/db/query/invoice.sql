@@ -0,0 +1,42 @@
+-- name: NextInvoiceNumber :one
+INSERT INTO "invoice_sequence"(year, last_number)
+VALUES ($1, 1)
+ON CONFLICT (year) DO UPDATE SET last_number = invoice_sequence.last_number + 1
+returning last_number;
+
+-- name: CreateInvoice :one
+INSERT INTO "invoice"(invoice_no,
+                      year,
+                      number,
+                      order_id,
+                      user_id,
+                      buyer_name,
+                      buyer_mobile,
+                      buyer_address,
+                      buyer_post,
+                      buyer_company,
+                      buyer_tax_id,
+                      currency,
+                      goods_amount,
+                      discount_amount,
+                      shipping_fee,
+                      tax_amount,
+                      total_amount)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+returning *;
+
+-- name: GetInvoiceByOrderID :one
+SELECT *
+FROM "invoice"
+WHERE order_id = $1;
+
+-- name: CreateInvoiceLine :one
+INSERT INTO "invoice_line"(invoice_id, goods_id, goods_name, price, nums, discount_amount, amount)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+returning *;
+
+-- name: GetInvoiceLines :many
+SELECT *
+FROM "invoice_line"
+WHERE invoice_id = $1
+ORDER BY id;
@@ -2,19 +2,30 @@
 SELECT *
 FROM "shopping_cart"
 WHERE user_id = $1
+  and cart_token = $2
   and deleted_at IS  NULL
 ;
 
 -- name: CreateCart :one
-INSERT INTO "shopping_cart"(user_id, goods_id, nums, checked)
-VALUES ($1, $2, $3, $4)
+INSERT INTO "shopping_cart"(user_id, cart_token, goods_id, nums, checked, price)
+VALUES ($1, $2, $3, $4, $5, $6)
+returning *;
+
+-- name: UpdateCartItemPrice :one
+UPDATE "shopping_cart"
+SET updated_at = $1,
+    price      = $2
+WHERE user_id = $3
+  and cart_token = $4
+  and goods_id = $5 and deleted_at IS  NULL
 returning *;
 
 -- name: DeleteCartItem :one
 UPDATE "shopping_cart"
 set deleted_at =$1
 where user_id = $2
-  and goods_id = $3 and deleted_at IS  NULL
+  and cart_token = $3
+  and goods_id = $4 and deleted_at IS  NULL
 returning *;
 
 -- name: UpdateCartItem :one
@@ -23,7 +34,8 @@ SET updated_at = $1,
     nums       = $2,
     checked    = $3
 WHERE user_id = $4
-  and goods_id = $5 and deleted_at IS  NULL
+  and cart_token = $5
+  and goods_id = $6 and deleted_at IS  NULL
 returning *;
 
 
@@ -31,7 +43,8 @@ returning *;
 SELECT *
 FROM "shopping_cart"
 where user_id = $1
-  and goods_id = $2 and deleted_at IS  NULL;
+  and cart_token = $2
+  and goods_id = $3 and deleted_at IS  NULL;
 
 -- name: GetCartListChecked :many
 SELECT *
@@ -40,6 +53,40 @@ WHERE user_id = $1
   and checked = $2 
   and deleted_at IS  NULL;
 
+-- name: UpdateCartItemNums :one
+UPDATE "shopping_cart"
+SET updated_at = $1,
+    nums       = $2
+WHERE user_id = $3
+  and cart_token = $4
+  and goods_id = $5 and deleted_at IS  NULL
+returning *;
+
+-- name: UpdateCartCheckedAll :execrows
+UPDATE "shopping_cart"
+SET updated_at = $1,
+    checked    = $2
+WHERE user_id = $3
+  and cart_token = $4
+  and deleted_at IS  NULL;
+
+-- name: UpdateCartCheckedByGoodsIDs :execrows
+UPDATE "shopping_cart"
+SET updated_at = sqlc.arg(updated_at),
+    checked    = sqlc.arg(checked)
+WHERE user_id = sqlc.arg(user_id)
+  and cart_token = sqlc.arg(cart_token)
+  and goods_id = ANY (sqlc.arg(goods_ids)::int[])
+  and deleted_at IS  NULL;
+
+-- name: DeleteCartItemsByGoodsIDs :execrows
+UPDATE "shopping_cart"
+set deleted_at = sqlc.arg(deleted_at)
+where user_id = sqlc.arg(user_id)
+  and cart_token = sqlc.arg(cart_token)
+  and goods_id = ANY (sqlc.arg(goods_ids)::int[])
+  and deleted_at IS  NULL;
+
 -- name: CreateOrder :one
 INSERT INTO "order_info"(user_id,
                          order_id,
@@ -48,8 +95,16 @@ INSERT INTO "order_info"(user_id,
                          address,
                          signer_name,
                          signer_mobile,
-                         post)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+                         post,
+                         coupon_id,
+                         goods_amount,
+                         discount_amount,
+                         currency,
+                         exchange_rate,
+                         shipping_fee,
+                         shipping_rule,
+                         tax_amount)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 returning *;
 
 -- name: GetOrderList :many
@@ -59,6 +114,52 @@ WHERE user_id = $1 and deleted_at IS  NULL
 limit $2 offset $3;
 
 
+-- name: CountOrderList :one
+SELECT count(*)
+FROM "order_info"
+WHERE user_id = $1 and deleted_at IS  NULL;
+
+-- 后台搜索订单 条件为 0 或者空时不过滤
+-- name: SearchOrders :many
+SELECT *
+FROM "order_info"
+WHERE deleted_at IS NULL
+  and (sqlc.arg(status)::int2 = 0 or status = sqlc.arg(status)::int2)
+  and (sqlc.arg(user_id)::int4 = 0 or user_id = sqlc.arg(user_id)::int4)
+  and created_at >= sqlc.arg(created_from)::timestamptz
+  and created_at < sqlc.arg(created_to)::timestamptz
+  and coalesce(order_mount, 0) >= sqlc.arg(min_amount)::int8
+  and (sqlc.arg(max_amount)::int8 = 0 or coalesce(order_mount, 0) <= sqlc.arg(max_amount)::int8)
+  and order_id::text LIKE sqlc.arg(order_id_prefix)::text || '%'
+  and (sqlc.arg(goods_id)::int4 = 0 or exists(SELECT 1
+                                               FROM "order_goods"
+                                               WHERE order_goods.order_id = order_info.order_id
+                                                 and order_goods.goods_id = sqlc.arg(goods_id)::int4
+                                                 and order_goods.deleted_at IS NULL))
+ORDER BY CASE WHEN sqlc.arg(sort)::text = 'created_at_asc' THEN created_at END,
+         CASE WHEN sqlc.arg(sort)::text = 'amount_desc' THEN order_mount END DESC,
+         CASE WHEN sqlc.arg(sort)::text = 'amount_asc' THEN order_mount END,
+         created_at DESC,
+         id DESC
+limit sqlc.arg(page_size) offset sqlc.arg(page_offset);
+
+-- name: CountSearchOrders :one
+SELECT count(*)
+FROM "order_info"
+WHERE deleted_at IS NULL
+  and (sqlc.arg(status)::int2 = 0 or status = sqlc.arg(status)::int2)
+  and (sqlc.arg(user_id)::int4 = 0 or user_id = sqlc.arg(user_id)::int4)
+  and created_at >= sqlc.arg(created_from)::timestamptz
+  and created_at < sqlc.arg(created_to)::timestamptz
+  and coalesce(order_mount, 0) >= sqlc.arg(min_amount)::int8
+  and (sqlc.arg(max_amount)::int8 = 0 or coalesce(order_mount, 0) <= sqlc.arg(max_amount)::int8)
+  and order_id::text LIKE sqlc.arg(order_id_prefix)::text || '%'
+  and (sqlc.arg(goods_id)::int4 = 0 or exists(SELECT 1
+                                               FROM "order_goods"
+                                               WHERE order_goods.order_id = order_info.order_id
+                                                 and order_goods.goods_id = sqlc.arg(goods_id)::int4
+                                                 and order_goods.deleted_at IS NULL));
+
 -- name: GetOrderDetail :one
 SELECT *
 FROM "order_info"
@@ -77,8 +178,8 @@ returning *;
 
 
 -- name: CreateOrderGoods :one
-INSERT INTO "order_goods"(ORDER_ID, GOODS_ID, GOODS_NAME, GOODS_PRICE, NUMS)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO "order_goods"(ORDER_ID, GOODS_ID, GOODS_NAME, GOODS_PRICE, NUMS, DISCOUNT_AMOUNT)
+VALUES ($1, $2, $3, $4, $5, $6)
 returning *;
 
 -- name: GetOrderListByOrderID :many
@@ -87,4 +188,31 @@ FROM order_goods
 WHERE order_id = $1
   and deleted_at IS  NULL;
 
+-- 只有已支付和已发货的订单可以发货和签收，关闭的订单不会被修改
+-- name: SetOrderStatus :one
+UPDATE "order_info"
+set updated_at = $1,
+    status     = $2
+where order_id = $3
+  and status IN (2, 4)
+  and deleted_at IS NULL
+returning *;
+
+-- name: CancelOrder :one
+UPDATE "order_info"
+set updated_at    = sqlc.arg(cancelled_at),
+    status        = 3,
+    cancel_reason = sqlc.arg(cancel_reason),
+    cancelled_at  = sqlc.arg(cancelled_at)
+where order_id = sqlc.arg(order_id)
+  and user_id = sqlc.arg(user_id)
+  and status = 1
+  and deleted_at IS NULL
+returning *;
 
+-- name: DeleteGuestCart :execrows
+UPDATE "shopping_cart"
+set deleted_at = $1
+where user_id = 0
+  and cart_token = $2
+  and deleted_at IS NULL;
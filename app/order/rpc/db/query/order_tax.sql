@@ -0,0 +1,10 @@
+-- name: CreateOrderTax :one
+INSERT INTO "order_tax"(order_id, name, rate, amount)
+VALUES ($1, $2, $3, $4)
+returning *;
+
+-- name: GetOrderTaxes :many
+SELECT *
+FROM "order_tax"
+WHERE order_id = $1
+ORDER BY id;
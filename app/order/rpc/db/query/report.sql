@@ -0,0 +1,107 @@
+-- name: GetReportWatermark :one
+SELECT refreshed_until
+FROM "report_refresh"
+WHERE name = $1;
+
+-- name: SetReportWatermark :exec
+INSERT INTO "report_refresh"(name, refreshed_until)
+VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET refreshed_until = excluded.refreshed_until;
+
+-- 上次刷新之后有变化的日期
+-- name: GetReportDaysChangedSince :many
+SELECT DISTINCT created_at::date AS day
+FROM "order_info"
+WHERE updated_at >= sqlc.arg(since)::timestamptz
+UNION
+SELECT DISTINCT created_at::date AS day
+FROM "shopping_cart"
+WHERE updated_at >= sqlc.arg(since)::timestamptz
+ORDER BY day;
+
+-- name: DeleteSalesDaily :exec
+DELETE
+FROM "sales_daily"
+WHERE day = $1;
+
+-- name: RefreshSalesDaily :exec
+INSERT INTO "sales_daily"(day, currency, order_count, paid_order_count, revenue, discount)
+SELECT sqlc.arg(day)::date,
+       currency,
+       count(*),
+       count(*) FILTER (WHERE status IN (2, 4, 5)),
+       coalesce(sum(order_mount) FILTER (WHERE status IN (2, 4, 5)), 0)::int8,
+       coalesce(sum(discount_amount) FILTER (WHERE status IN (2, 4, 5)), 0)::int8
+FROM "order_info"
+WHERE created_at::date = sqlc.arg(day)::date
+  and deleted_at IS NULL
+GROUP BY currency;
+
+-- name: DeleteSalesGoodsDaily :exec
+DELETE
+FROM "sales_goods_daily"
+WHERE day = $1;
+
+-- name: RefreshSalesGoodsDaily :exec
+INSERT INTO "sales_goods_daily"(day, goods_id, currency, goods_name, nums, revenue)
+SELECT sqlc.arg(day)::date,
+       g.goods_id,
+       o.currency,
+       max(g.goods_name),
+       sum(g.nums),
+       sum(g.goods_price * g.nums - g.discount_amount)::int8
+FROM "order_goods" g
+         JOIN "order_info" o ON o.order_id = g.order_id
+WHERE o.created_at::date = sqlc.arg(day)::date
+  and o.status IN (2, 4, 5)
+  and o.deleted_at IS NULL
+  and g.deleted_at IS NULL
+GROUP BY g.goods_id, o.currency;
+
+-- name: RefreshConversionDaily :exec
+INSERT INTO "conversion_daily"(day, cart_users, order_users, updated_at)
+VALUES (sqlc.arg(day)::date,
+        (SELECT count(DISTINCT CASE WHEN user_id > 0 THEN user_id::text ELSE cart_token END)
+         FROM "shopping_cart"
+         WHERE created_at::date = sqlc.arg(day)::date),
+        (SELECT count(DISTINCT user_id)
+         FROM "order_info"
+         WHERE created_at::date = sqlc.arg(day)::date
+           and deleted_at IS NULL),
+        now())
+ON CONFLICT (day) DO UPDATE SET cart_users  = excluded.cart_users,
+                                order_users = excluded.order_users,
+                                updated_at  = excluded.updated_at;
+
+-- name: GetSalesDaily :many
+SELECT *
+FROM "sales_daily"
+WHERE day >= sqlc.arg(from_day)::date
+  and day <= sqlc.arg(to_day)::date
+ORDER BY day, currency;
+
+-- name: GetGoodsSales :many
+SELECT goods_id,
+       currency,
+       max(goods_name)::varchar AS goods_name,
+       sum(nums)::int8          AS nums,
+       sum(revenue)::int8       AS revenue
+FROM "sales_goods_daily"
+WHERE day >= sqlc.arg(from_day)::date
+  and day <= sqlc.arg(to_day)::date
+GROUP BY goods_id, currency
+ORDER BY CASE WHEN sqlc.arg(sort)::text = 'nums' THEN sum(nums) END DESC,
+         sum(revenue) DESC,
+         goods_id
+limit sqlc.arg(row_limit);
+
+-- name: GetConversionDaily :many
+SELECT *
+FROM "conversion_daily"
+WHERE day >= sqlc.arg(from_day)::date
+  and day <= sqlc.arg(to_day)::date
+ORDER BY day;
+
+-- 多个副本同时刷新时只有拿到锁的副本刷新，锁在事务结束时释放
+-- name: TryLockReportRefresh :one
+SELECT pg_try_advisory_xact_lock(sqlc.arg(key)::int8) AS locked;
@@ -0,0 +1,45 @@
+-- name: CreateShipment :one
+INSERT INTO "shipment"(order_id, carrier, tracking_no)
+VALUES ($1, $2, $3)
+returning *;
+
+-- name: GetShipmentByTrackingNo :one
+SELECT *
+FROM "shipment"
+WHERE carrier = $1
+  and tracking_no = $2
+  and deleted_at IS NULL;
+
+-- name: GetShipmentsByOrderID :many
+SELECT *
+FROM "shipment"
+WHERE order_id = $1
+  and deleted_at IS NULL
+order by id;
+
+-- name: UpdateShipmentStatus :one
+UPDATE "shipment"
+set updated_at   = $1,
+    status       = $2,
+    delivered_at = $3
+where id = $4
+  and deleted_at IS NULL
+returning *;
+
+-- name: CountUndeliveredShipments :one
+SELECT count(*)
+FROM "shipment"
+WHERE order_id = $1
+  and status < 4
+  and deleted_at IS NULL;
+
+-- name: CreateShipmentEvent :execrows
+INSERT INTO "shipment_event"(shipment_id, status, location, description, occurred_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT DO NOTHING;
+
+-- name: GetShipmentEvents :many
+SELECT *
+FROM "shipment_event"
+WHERE shipment_id = $1
+order by occurred_at, id;
@@ -0,0 +1,26 @@
+-- name: CreateWishlistItem :one
+INSERT INTO "wishlist"(user_id, goods_id)
+VALUES ($1, $2)
+returning *;
+
+-- name: GetWishlistItem :one
+SELECT *
+FROM "wishlist"
+WHERE user_id = $1
+  and goods_id = $2
+  and deleted_at IS NULL;
+
+-- name: GetWishlistByUid :many
+SELECT *
+FROM "wishlist"
+WHERE user_id = $1
+  and deleted_at IS NULL
+order by id desc;
+
+-- name: DeleteWishlistItem :one
+UPDATE "wishlist"
+set deleted_at = $1
+where user_id = $2
+  and goods_id = $3
+  and deleted_at IS NULL
+returning *;
@@ -6,6 +6,7 @@ import (
 	"go.uber.org/zap"
 
 	remoteConfig "github.com/jimyag/shop/app/order/rpc/config"
+	"github.com/jimyag/shop/app/order/rpc/tools/pricing"
 	"github.com/jimyag/shop/common/model"
 	"github.com/jimyag/shop/common/proto"
 )
@@ -17,4 +18,6 @@ var (
 	DB              *sql.DB                 // database
 	GoodsClient     proto.GoodsClient       // goods client
 	InventoryClient proto.InventoryClient   // inventory client
+	UserClient      proto.UserClient        // user client
+	Pricing         *pricing.Engine         // 运费和税费的规则
 )
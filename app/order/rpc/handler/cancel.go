@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/apache/rocketmq-client-go/v2/producer"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/order/rpc/global"
+	"github.com/jimyag/shop/app/order/rpc/model"
+	"github.com/jimyag/shop/common/proto"
+)
+
+// 取消原因的最大长度
+const maxCancelReasonLength = 255
+
+const (
+	orderRebackTopic         = "order_reback" // 归还库存的消息
+	cancelOrderProducerGroup = "order_cancel" // 取消订单的事务消息的生产者组
+)
+
+//
+// CancelOrder
+//  @Description: 用户取消未支付的订单，关闭订单后释放优惠券并归还库存，可以把订单中的商品放回购物车
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.OrderInfo 取消后的订单
+//  @return error
+//
+func (server *OrderServer) CancelOrder(ctx context.Context, req *proto.CancelOrderRequest) (*proto.OrderInfo, error) {
+	if req.OrderID <= 0 || req.UserID <= 0 {
+		return &proto.OrderInfo{}, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	if utf8.RuneCountInString(req.Reason) > maxCancelReasonLength {
+		return &proto.OrderInfo{}, status.Error(codes.InvalidArgument, "取消原因太长")
+	}
+
+	orderInfo, err := server.Store.GetOrderDetail(ctx, req.OrderID)
+	// 不是自己的订单也当作不存在
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && orderInfo.UserID != req.UserID) {
+		return &proto.OrderInfo{}, status.Error(codes.NotFound, "没有找到该订单")
+	} else if err != nil {
+		global.Logger.Error("获取订单失败", zap.Error(err))
+		return &proto.OrderInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+	if orderInfo.Status != 1 {
+		return &proto.OrderInfo{}, status.Error(codes.FailedPrecondition, "只能取消未支付的订单")
+	}
+	orderGoods, err := server.Store.GetOrderListByOrderID(ctx, req.OrderID)
+	if err != nil {
+		global.Logger.Error("获取订单商品失败", zap.Error(err))
+		return &proto.OrderInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+
+	// 放回购物车的商品使用当前基准货币的价格，已经下架的商品不放回
+	var goods map[int32]*proto.GoodsInfo
+	if req.RestoreCart && len(orderGoods) > 0 {
+		goodsIDS := make([]*proto.GoodID, 0, len(orderGoods))
+		for _, good := range orderGoods {
+			goodsIDS = append(goodsIDS, &proto.GoodID{Id: good.GoodsID})
+		}
+		goodsInfos, err := global.GoodsClient.GetGoodsBatchInfo(ctx, &proto.ManyGoodsID{
+			GoodsIDs:    goodsIDS,
+			WithDeleted: true,
+		})
+		if err != nil {
+			global.Logger.Error("获取商品信息失败", zap.Error(err))
+			return &proto.OrderInfo{}, status.Error(codes.Internal, "获取商品信息失败")
+		}
+		goods = goodsInfoMap(goodsInfos.Data)
+	}
+
+	// 取消订单的本地事务成功后才会提交归还库存的消息，库存服务按照订单号归还，同一个订单只会归还一次
+	listener := &cancelOrderListener{server: server}
+	listener.cancel = func() error {
+		return server.Store.ExecTx(ctx, func(queries *model.Queries) error {
+			// 只有未支付的订单才会被更新，同时支付时不会重复归还库存
+			orderInfo, err = queries.CancelOrder(ctx, model.CancelOrderParams{
+				CancelledAt:  time.Now(),
+				CancelReason: req.Reason,
+				OrderID:      req.OrderID,
+				UserID:       req.UserID,
+			})
+			if errors.Is(err, sql.ErrNoRows) {
+				return status.Error(codes.FailedPrecondition, "只能取消未支付的订单")
+			} else if err != nil {
+				return err
+			}
+
+			// 释放订单锁定的优惠券
+			if orderInfo.CouponID.Valid {
+				_, err = queries.ReleaseUserCouponByOrderID(ctx, model.ReleaseUserCouponByOrderIDParams{
+					UpdatedAt: time.Now(),
+					OrderID:   sql.NullInt64{Int64: orderInfo.OrderID, Valid: true},
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, good := range orderGoods {
+				if info, ok := goods[good.GoodsID]; ok && !info.Deleted {
+					_, err = addCartItem(ctx, queries, model.ShoppingCart{
+						UserID:  orderInfo.UserID,
+						GoodsID: good.GoodsID,
+						Nums:    good.Nums,
+						Checked: true,
+						Price:   info.PriceCents,
+					})
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+	}
+
+	p, err := rocketmq.NewTransactionProducer(
+		listener,
+		producer.WithNameServer([]string{"192.168.0.2:9876"}),
+		// 和新建订单的生产者分开，回查时使用取消订单的规则
+		producer.WithGroupName(cancelOrderProducerGroup),
+	)
+	if err != nil {
+		global.Logger.Error("创建生产者失败", zap.Error(err))
+		return &proto.OrderInfo{}, status.Error(codes.Internal, "创建生产者失败")
+	}
+	if err = p.Start(); err != nil {
+		global.Logger.Error("启动生产者失败", zap.Error(err))
+		return &proto.OrderInfo{}, status.Error(codes.Internal, "启动生产者失败")
+	}
+	defer func() {
+		_ = p.Shutdown()
+	}()
+
+	body, err := json.Marshal(orderReback{OrderID: req.OrderID})
+	if err != nil {
+		global.Logger.Error("序列化失败", zap.Error(err))
+		return &proto.OrderInfo{}, status.Error(codes.Internal, "序列化失败")
+	}
+	_, err = p.SendMessageInTransaction(ctx, primitive.NewMessage(orderRebackTopic, body))
+	if listener.err != nil {
+		// 已经转换过的错误直接返回
+		if _, ok := status.FromError(listener.err); ok {
+			return &proto.OrderInfo{}, listener.err
+		}
+		global.Logger.Error("取消订单失败", zap.Error(listener.err))
+		return &proto.OrderInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+	if err != nil {
+		// 消息没有发送出去时不会执行本地事务，订单没有被取消
+		global.Logger.Error("发送归还库存的消息失败", zap.Int64("orderID", req.OrderID), zap.Error(err))
+		return &proto.OrderInfo{}, status.Error(codes.Internal, "取消订单失败")
+	}
+	return orderModel2Info(orderInfo), nil
+}
+
+//
+// orderReback
+//  @Description: 归还库存的消息，库存服务按照订单号归还扣减的库存
+//
+type orderReback struct {
+	OrderID int64 `json:"order_id"`
+}
+
+//
+// cancelOrderListener
+//  @Description: 取消订单时的事务消息，本地事务成功后才会提交归还库存的消息
+//
+type cancelOrderListener struct {
+	server *OrderServer
+	cancel func() error // 取消订单的本地事务
+	err    error        // 本地事务的错误
+}
+
+func (dl *cancelOrderListener) ExecuteLocalTransaction(msg *primitive.Message) primitive.LocalTransactionState {
+	dl.err = dl.cancel()
+	if dl.err != nil {
+		return primitive.RollbackMessageState
+	}
+	return primitive.CommitMessageState
+}
+
+//
+// CheckLocalTransaction
+//  @Description: 没有收到本地事务的结果时回查，订单已经被取消就提交消息
+//  回查可能由同一个生产者组中的其他实例处理，所以只能使用数据库中的状态
+//  @receiver dl
+//  @param msg
+//  @return primitive.LocalTransactionState
+//
+func (dl *cancelOrderListener) CheckLocalTransaction(msg *primitive.MessageExt) primitive.LocalTransactionState {
+	reback := orderReback{}
+	if err := json.Unmarshal(msg.Body, &reback); err != nil {
+		global.Logger.Error("解析消息失败", zap.Error(err))
+		return primitive.RollbackMessageState
+	}
+	orderInfo, err := dl.server.Store.GetOrderDetail(context.Background(), reback.OrderID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return primitive.RollbackMessageState
+	} else if err != nil {
+		global.Logger.Error("回查订单失败", zap.Int64("orderID", reback.OrderID), zap.Error(err))
+		return primitive.UnknowState
+	}
+	if orderInfo.Status == orderStatusClosed && orderInfo.CancelledAt.Valid {
+		return primitive.CommitMessageState
+	}
+	return primitive.RollbackMessageState
+}
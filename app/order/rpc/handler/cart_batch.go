@@ -0,0 +1,264 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/order/rpc/global"
+	"github.com/jimyag/shop/app/order/rpc/model"
+	"github.com/jimyag/shop/common/proto"
+)
+
+//
+// CheckCartItems
+//  @Description: 批量选中或取消选中购物车中的商品，没有传商品时修改所有的商品
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.CartItemListResponse 修改后的购物车
+//  @return error
+//
+func (server *OrderServer) CheckCartItems(ctx context.Context, req *proto.CheckCartItemsRequest) (*proto.CartItemListResponse, error) {
+	userID, cartToken, err := cartOwner(req.UserID, req.CartToken)
+	if err != nil {
+		return &proto.CartItemListResponse{}, err
+	}
+	goodsIDs := uniqueGoodsIDs(req.GoodsIDs)
+
+	var cartList []model.ShoppingCart
+	err = server.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		if len(goodsIDs) == 0 {
+			_, err = queries.UpdateCartCheckedAll(ctx, model.UpdateCartCheckedAllParams{
+				UpdatedAt: time.Now(),
+				Checked:   req.Checked,
+				UserID:    userID,
+				CartToken: cartToken,
+			})
+		} else {
+			var rows int64
+			rows, err = queries.UpdateCartCheckedByGoodsIDs(ctx, model.UpdateCartCheckedByGoodsIDsParams{
+				UpdatedAt: time.Now(),
+				Checked:   req.Checked,
+				UserID:    userID,
+				CartToken: cartToken,
+				GoodsIds:  goodsIDs,
+			})
+			if err == nil && rows != int64(len(goodsIDs)) {
+				return status.Error(codes.NotFound, "购物车中没有该商品")
+			}
+		}
+		if err != nil {
+			return err
+		}
+		cartList, err = queries.GetCartListByUid(ctx, model.GetCartListByUidParams{
+			UserID:    userID,
+			CartToken: cartToken,
+		})
+		return err
+	})
+	if err != nil {
+		// 已经转换过的错误直接返回
+		if _, ok := status.FromError(err); ok {
+			return &proto.CartItemListResponse{}, err
+		}
+		global.Logger.Error("批量选中购物车商品失败", zap.Error(err))
+		return &proto.CartItemListResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+	return cartModel2ListResponse(cartList), nil
+}
+
+//
+// BatchDeleteCartItems
+//  @Description: 批量删除购物车中的商品，有一件商品不在购物车中时都不会删除
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.CartItemListResponse 删除后的购物车
+//  @return error
+//
+func (server *OrderServer) BatchDeleteCartItems(ctx context.Context, req *proto.BatchDeleteCartItemsRequest) (*proto.CartItemListResponse, error) {
+	userID, cartToken, err := cartOwner(req.UserID, req.CartToken)
+	if err != nil {
+		return &proto.CartItemListResponse{}, err
+	}
+	goodsIDs := uniqueGoodsIDs(req.GoodsIDs)
+	if len(goodsIDs) == 0 {
+		return &proto.CartItemListResponse{}, status.Error(codes.InvalidArgument, "没有需要删除的商品")
+	}
+
+	var cartList []model.ShoppingCart
+	err = server.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		rows, err := queries.DeleteCartItemsByGoodsIDs(ctx, model.DeleteCartItemsByGoodsIDsParams{
+			DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			UserID:    userID,
+			CartToken: cartToken,
+			GoodsIds:  goodsIDs,
+		})
+		if err != nil {
+			return err
+		}
+		if rows != int64(len(goodsIDs)) {
+			return status.Error(codes.NotFound, "购物车中没有该商品")
+		}
+		cartList, err = queries.GetCartListByUid(ctx, model.GetCartListByUidParams{
+			UserID:    userID,
+			CartToken: cartToken,
+		})
+		return err
+	})
+	if err != nil {
+		// 已经转换过的错误直接返回
+		if _, ok := status.FromError(err); ok {
+			return &proto.CartItemListResponse{}, err
+		}
+		global.Logger.Error("批量删除购物车商品失败", zap.Error(err))
+		return &proto.CartItemListResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+	return cartModel2ListResponse(cartList), nil
+}
+
+//
+// BatchUpdateCartNums
+//  @Description: 批量修改购物车中商品的数量，数量不能超过商品的限购数量
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.CartItemListResponse 修改后的购物车
+//  @return error
+//
+func (server *OrderServer) BatchUpdateCartNums(ctx context.Context, req *proto.BatchUpdateCartNumsRequest) (*proto.CartItemListResponse, error) {
+	userID, cartToken, err := cartOwner(req.UserID, req.CartToken)
+	if err != nil {
+		return &proto.CartItemListResponse{}, err
+	}
+	if len(req.Items) == 0 {
+		return &proto.CartItemListResponse{}, status.Error(codes.InvalidArgument, "没有需要修改的商品")
+	}
+	goodsNums := make(map[int32]int32, len(req.Items))
+	for _, item := range req.Items {
+		if item.Nums <= 0 {
+			return &proto.CartItemListResponse{}, status.Error(codes.InvalidArgument, "商品数量必须大于 0")
+		}
+		if _, ok := goodsNums[item.GoodsID]; ok {
+			return &proto.CartItemListResponse{}, status.Error(codes.InvalidArgument, "商品重复")
+		}
+		goodsNums[item.GoodsID] = item.Nums
+	}
+	if err = checkPurchaseLimits(ctx, goodsNums); err != nil {
+		return &proto.CartItemListResponse{}, err
+	}
+
+	var cartList []model.ShoppingCart
+	err = server.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		for _, item := range req.Items {
+			_, err := queries.UpdateCartItemNums(ctx, model.UpdateCartItemNumsParams{
+				UpdatedAt: time.Now(),
+				Nums:      item.Nums,
+				UserID:    userID,
+				CartToken: cartToken,
+				GoodsID:   item.GoodsID,
+			})
+			if errors.Is(err, sql.ErrNoRows) {
+				return status.Error(codes.NotFound, "购物车中没有该商品")
+			} else if err != nil {
+				return err
+			}
+		}
+		cartList, err = queries.GetCartListByUid(ctx, model.GetCartListByUidParams{
+			UserID:    userID,
+			CartToken: cartToken,
+		})
+		return err
+	})
+	if err != nil {
+		// 已经转换过的错误直接返回
+		if _, ok := status.FromError(err); ok {
+			return &proto.CartItemListResponse{}, err
+		}
+		global.Logger.Error("批量修改购物车商品数量失败", zap.Error(err))
+		return &proto.CartItemListResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+	return cartModel2ListResponse(cartList), nil
+}
+
+//
+// checkPurchaseLimits
+//  @Description: 检查每件商品的数量是否超过限购数量，已经下架的商品不检查
+//  @param ctx
+//  @param goodsNums 商品的数量
+//  @return error grpc 的错误
+//
+func checkPurchaseLimits(ctx context.Context, goodsNums map[int32]int32) error {
+	goodsIDs := make([]int32, 0, len(goodsNums))
+	for goodsID := range goodsNums {
+		goodsIDs = append(goodsIDs, goodsID)
+	}
+	goodsInfos, err := purchaseLimitGoods(ctx, goodsIDs)
+	if err != nil {
+		return err
+	}
+	for _, info := range goodsInfos {
+		if err = checkPurchaseLimit(info, goodsNums[info.Id]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// purchaseLimitGoods
+//  @Description: 获得检查限购数量需要的商品信息，包括已经下架的商品
+//  @param ctx
+//  @param goodsIDs
+//  @return []*proto.GoodsInfo
+//  @return error grpc 的错误
+//
+func purchaseLimitGoods(ctx context.Context, goodsIDs []int32) ([]*proto.GoodsInfo, error) {
+	ids := make([]*proto.GoodID, 0, len(goodsIDs))
+	for _, goodsID := range goodsIDs {
+		ids = append(ids, &proto.GoodID{Id: goodsID})
+	}
+	goodsInfos, err := global.GoodsClient.GetGoodsBatchInfo(ctx, &proto.ManyGoodsID{
+		GoodsIDs:    ids,
+		WithDeleted: true,
+	})
+	if err != nil {
+		global.Logger.Error("获取商品信息失败", zap.Error(err))
+		return nil, status.Error(codes.Internal, "获取商品信息失败")
+	}
+	return goodsInfos.Data, nil
+}
+
+//
+// checkPurchaseLimit
+//  @Description: 检查商品的数量是否超过限购数量
+//  @param info
+//  @param nums
+//  @return error grpc 的错误
+//
+func checkPurchaseLimit(info *proto.GoodsInfo, nums int32) error {
+	if info.Deleted || info.PurchaseLimit <= 0 || nums <= info.PurchaseLimit {
+		return nil
+	}
+	return status.Error(codes.InvalidArgument, fmt.Sprintf("%s 每次最多购买 %d 件", info.Name, info.PurchaseLimit))
+}
+
+// uniqueGoodsIDs 去掉重复的商品
+func uniqueGoodsIDs(goodsIDs []int32) []int32 {
+	result := make([]int32, 0, len(goodsIDs))
+	seen := make(map[int32]bool, len(goodsIDs))
+	for _, goodsID := range goodsIDs {
+		if !seen[goodsID] {
+			seen[goodsID] = true
+			result = append(result, goodsID)
+		}
+	}
+	return result
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/order/rpc/global"
+	"github.com/jimyag/shop/app/order/rpc/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/money"
+)
+
+//
+// CartItemDetailList
+//  @Description: 获取购物车中每件商品当前的名称、价格和库存，标记已下架和库存不足的商品
+//  只有选中并且可以购买的商品才会计算到小计中
+//  @receiver server
+//  @param ctx
+//  @param req 登录用户使用 uid，游客使用 cartToken
+//  @return *proto.CartDetailResponse
+//  @return error
+//
+func (server *OrderServer) CartItemDetailList(ctx context.Context, req *proto.CartItemListRequest) (*proto.CartDetailResponse, error) {
+	userID, cartToken, err := cartOwner(req.GetUid(), req.GetCartToken())
+	if err != nil {
+		return &proto.CartDetailResponse{}, err
+	}
+	cartList, err := server.Store.GetCartListByUid(ctx, model.GetCartListByUidParams{
+		UserID:    userID,
+		CartToken: cartToken,
+	})
+	if err != nil {
+		global.Logger.Error("获取购物车失败", zap.Error(err))
+		return &proto.CartDetailResponse{}, status.Error(codes.Internal, "获取购物车失败")
+	}
+	response := proto.CartDetailResponse{
+		Total: int32(len(cartList)),
+		Data:  make([]*proto.CartDetailLine, 0, len(cartList)),
+	}
+	if len(cartList) == 0 {
+		return &response, nil
+	}
+
+	goodsIDS := make([]*proto.GoodID, 0, len(cartList))
+	invGoodsIDS := make([]int32, 0, len(cartList))
+	for _, cart := range cartList {
+		goodsIDS = append(goodsIDS, &proto.GoodID{Id: cart.GoodsID})
+		invGoodsIDS = append(invGoodsIDS, cart.GoodsID)
+	}
+	goodsInfos, err := global.GoodsClient.GetGoodsBatchInfo(ctx, &proto.ManyGoodsID{
+		GoodsIDs:    goodsIDS,
+		WithDeleted: true,
+		Currency:    req.Currency,
+	})
+	if status.Code(err) == codes.InvalidArgument {
+		return &proto.CartDetailResponse{}, err
+	} else if err != nil {
+		global.Logger.Error("获取商品信息失败", zap.Error(err))
+		return &proto.CartDetailResponse{}, status.Error(codes.Internal, "获取商品信息失败")
+	}
+	inventories, err := global.InventoryClient.BatchInvDetail(ctx, &proto.BatchInvRequest{GoodsIds: invGoodsIDS})
+	if err != nil {
+		global.Logger.Error("获取库存失败", zap.Error(err))
+		return &proto.CartDetailResponse{}, status.Error(codes.Internal, "获取库存失败")
+	}
+	goods := goodsInfoMap(goodsInfos.Data)
+	// 没有库存记录的商品库存为 0
+	stocks := make(map[int32]int32, len(inventories.GoodsInfo))
+	for _, inventory := range inventories.GoodsInfo {
+		stocks[inventory.GoodsId] = inventory.Num
+	}
+
+	response.Currency = goodsInfos.Currency
+	response.ExchangeRate = goodsInfos.ExchangeRate
+	for _, cart := range cartList {
+		line := &proto.CartDetailLine{
+			Id:      int32(cart.ID),
+			GoodsID: cart.GoodsID,
+			Nums:    cart.Nums,
+			Checked: cart.Checked,
+			Stock:   stocks[cart.GoodsID],
+		}
+		response.Data = append(response.Data, line)
+
+		info, ok := goods[cart.GoodsID]
+		if !ok || info.Deleted {
+			line.Deleted = true
+			if ok {
+				line.GoodsName = info.Name
+			}
+			continue
+		}
+		line.GoodsName = info.Name
+		line.PriceCents = info.PriceCents
+		line.AmountCents = money.Mul(info.PriceCents, cart.Nums)
+		line.OutOfStock = line.Stock < cart.Nums
+
+		if cart.Checked && !line.OutOfStock {
+			response.CheckedNums += cart.Nums
+			response.CheckedTotalCents += line.AmountCents
+		}
+	}
+	return &response, nil
+}
@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/order/rpc/global"
+	"github.com/jimyag/shop/app/order/rpc/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/money"
+)
+
+// 结算前提示的类型
+const (
+	warningGoodsRemoved = "goods_removed" // 商品已下架
+	warningOutOfStock   = "out_of_stock"  // 库存不足
+	warningPriceChanged = "price_changed" // 价格变化
+)
+
+//
+// PreviewCheckout
+//  @Description: 结算前检查购物车中选中的商品是否下架、库存是否充足、价格是否变化，只读不会扣减库存
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.CheckoutPreview
+//  @return error
+//
+func (server *OrderServer) PreviewCheckout(ctx context.Context, req *proto.PreviewCheckoutRequest) (*proto.CheckoutPreview, error) {
+	shoppingCart, err := server.Store.GetCartListChecked(ctx, model.GetCartListCheckedParams{
+		UserID:  req.UserID,
+		Checked: true,
+	})
+	if err != nil {
+		global.Logger.Error("获取购物车失败", zap.Error(err))
+		return &proto.CheckoutPreview{}, status.Error(codes.Internal, "获取购物车失败")
+	} else if len(shoppingCart) == 0 {
+		return &proto.CheckoutPreview{}, status.Error(codes.InvalidArgument, "购物车为空")
+	}
+
+	goodsIDS := make([]*proto.GoodID, 0, len(shoppingCart))
+	for _, cart := range shoppingCart {
+		goodsIDS = append(goodsIDS, &proto.GoodID{Id: cart.GoodsID})
+	}
+	// 购物车中的价格是基准货币的，使用基准货币比较价格
+	baseInfos, err := global.GoodsClient.GetGoodsBatchInfo(ctx, &proto.ManyGoodsID{
+		GoodsIDs:    goodsIDS,
+		WithDeleted: true,
+	})
+	if err != nil {
+		global.Logger.Error("获取商品信息失败", zap.Error(err))
+		return &proto.CheckoutPreview{}, status.Error(codes.Internal, "获取商品信息失败")
+	}
+	displayInfos := baseInfos
+	if req.Currency != "" && money.NormalizeCurrency(req.Currency) != baseInfos.Currency {
+		displayInfos, err = global.GoodsClient.GetGoodsBatchInfo(ctx, &proto.ManyGoodsID{
+			GoodsIDs:    goodsIDS,
+			WithDeleted: true,
+			Currency:    req.Currency,
+		})
+		if status.Code(err) == codes.InvalidArgument {
+			return &proto.CheckoutPreview{}, err
+		} else if err != nil {
+			global.Logger.Error("获取商品信息失败", zap.Error(err))
+			return &proto.CheckoutPreview{}, status.Error(codes.Internal, "获取商品信息失败")
+		}
+	}
+	baseGoods := goodsInfoMap(baseInfos.Data)
+	displayGoods := goodsInfoMap(displayInfos.Data)
+	currency := displayInfos.Currency
+
+	response := proto.CheckoutPreview{
+		Currency:     currency,
+		ExchangeRate: displayInfos.ExchangeRate,
+		Lines:        make([]*proto.CheckoutLine, 0, len(shoppingCart)),
+		Ready:        true,
+	}
+	for _, cart := range shoppingCart {
+		line := &proto.CheckoutLine{
+			GoodsID:  cart.GoodsID,
+			Nums:     cart.Nums,
+			Warnings: make([]*proto.CheckoutWarning, 0),
+		}
+		response.Lines = append(response.Lines, line)
+
+		base, ok := baseGoods[cart.GoodsID]
+		if !ok || base.Deleted {
+			line.Warnings = append(line.Warnings, &proto.CheckoutWarning{Code: warningGoodsRemoved, Message: "商品已下架"})
+			response.Ready = false
+			continue
+		}
+		display := displayGoods[cart.GoodsID]
+		line.GoodsName = display.Name
+		line.PriceCents = display.PriceCents
+		line.AmountCents = money.Mul(display.PriceCents, cart.Nums)
+
+		if cart.Price > 0 {
+			line.CartPriceCents = money.ConvertByRate(cart.Price, displayInfos.ExchangeRate, baseInfos.Currency, currency)
+			if cart.Price != base.PriceCents {
+				line.Warnings = append(line.Warnings, &proto.CheckoutWarning{
+					Code: warningPriceChanged,
+					Message: fmt.Sprintf("商品价格已从 %s 变为 %s",
+						money.FormatCurrency(line.CartPriceCents, currency),
+						money.FormatCurrency(line.PriceCents, currency),
+					),
+				})
+			}
+		}
+
+		inventory, err := global.InventoryClient.InvDetail(ctx, &proto.GoodInvInfo{GoodsId: cart.GoodsID})
+		if status.Code(err) == codes.NotFound {
+			inventory = &proto.GoodInvInfo{GoodsId: cart.GoodsID}
+		} else if err != nil {
+			global.Logger.Error("获取库存失败", zap.Int32("goodsID", cart.GoodsID), zap.Error(err))
+			return &proto.CheckoutPreview{}, status.Error(codes.Internal, "获取库存失败")
+		}
+		line.Stock = inventory.Num
+		if inventory.Num < cart.Nums {
+			message := fmt.Sprintf("库存不足 仅剩 %d 件", inventory.Num)
+			if inventory.Num <= 0 {
+				message = "商品已售罄"
+			}
+			line.Warnings = append(line.Warnings, &proto.CheckoutWarning{Code: warningOutOfStock, Message: message})
+			response.Ready = false
+			continue
+		}
+
+		response.TotalNums += cart.Nums
+		response.GoodsTotalCents += line.AmountCents
+	}
+	return &response, nil
+}
+
+//
+// currentGoods
+//  @Description: 获取商品当前的信息，价格是基准货币的
+//  @param ctx
+//  @param goodsID
+//  @return *proto.GoodsInfo
+//  @return error grpc 的错误
+//
+func currentGoods(ctx context.Context, goodsID int32) (*proto.GoodsInfo, error) {
+	goodsInfos, err := global.GoodsClient.GetGoodsBatchInfo(ctx, &proto.ManyGoodsID{
+		GoodsIDs:    []*proto.GoodID{{Id: goodsID}},
+		WithDeleted: true,
+	})
+	if err != nil {
+		global.Logger.Error("获取商品信息失败", zap.Error(err))
+		return nil, status.Error(codes.Internal, "获取商品信息失败")
+	}
+	if len(goodsInfos.Data) == 0 || goodsInfos.Data[0].Deleted {
+		return nil, status.Error(codes.NotFound, "商品不存在")
+	}
+	return goodsInfos.Data[0], nil
+}
+
+func goodsInfoMap(infos []*proto.GoodsInfo) map[int32]*proto.GoodsInfo {
+	result := make(map[int32]*proto.GoodsInfo, len(infos))
+	for _, info := range infos {
+		result[info.Id] = info
+	}
+	return result
+}
@@ -0,0 +1,297 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/order/rpc/global"
+	"github.com/jimyag/shop/app/order/rpc/model"
+	"github.com/jimyag/shop/app/order/rpc/tools/promotion"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
+	"github.com/jimyag/shop/common/utils/money"
+)
+
+// 未使用的优惠券，2 下单锁定 3 已使用
+const couponStatusUnused int16 = 1
+
+//
+// CreateCouponTemplate
+//  @Description: 新建优惠券模板
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.CouponTemplateInfo
+//  @return error
+//
+func (server *OrderServer) CreateCouponTemplate(ctx context.Context, req *proto.CouponTemplateInfo) (*proto.CouponTemplateInfo, error) {
+	amount := requestCents(req.AmountCents, req.Amount)
+	threshold := requestCents(req.ThresholdCents, req.Threshold)
+	rule := couponTemplate2Rule(model.CouponTemplate{
+		Type:      int16(req.Type),
+		Amount:    amount,
+		Rate:      float64(req.Rate),
+		Threshold: threshold,
+	})
+	// 用一件足够贵的商品检查配置是否合法
+	_, err := promotion.Evaluate(rule, []promotion.Line{{Price: threshold + 1, Nums: 1}})
+	if errors.Is(err, promotion.ErrUnknownType) || errors.Is(err, promotion.ErrInvalidRuleSetting) {
+		return &proto.CouponTemplateInfo{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.ValidTo <= req.ValidFrom {
+		return &proto.CouponTemplateInfo{}, status.Error(codes.InvalidArgument, "优惠券的有效期不合法")
+	}
+	perUserLimit := req.PerUserLimit
+	if perUserLimit <= 0 {
+		perUserLimit = 1
+	}
+
+	template, err := server.Store.CreateCouponTemplate(ctx, model.CreateCouponTemplateParams{
+		Name:         req.Name,
+		Type:         int16(req.Type),
+		Amount:       amount,
+		Rate:         float64(req.Rate),
+		Threshold:    threshold,
+		GoodsID:      req.GoodsID,
+		Total:        req.Total,
+		PerUserLimit: perUserLimit,
+		ValidFrom:    time.Unix(req.ValidFrom, 0),
+		ValidTo:      time.Unix(req.ValidTo, 0),
+	})
+	if err != nil {
+		global.Logger.Error("创建优惠券模板失败", zap.Error(err))
+		return &proto.CouponTemplateInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+	return couponTemplateModel2Info(template), nil
+}
+
+//
+// IssueCoupon
+//  @Description: 用户领取优惠券，检查有效期、发放总量和每人限领的数量
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.UserCouponInfo
+//  @return error
+//
+func (server *OrderServer) IssueCoupon(ctx context.Context, req *proto.IssueCouponRequest) (*proto.UserCouponInfo, error) {
+	if err := auth.CheckCaller(ctx, req.UserID, auth.PermOrderWriteAll); err != nil {
+		return &proto.UserCouponInfo{}, err
+	}
+	template, err := server.Store.GetCouponTemplate(ctx, req.TemplateID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.UserCouponInfo{}, status.Error(codes.NotFound, "优惠券不存在")
+	} else if err != nil {
+		global.Logger.Error("获取优惠券模板失败", zap.Error(err))
+		return &proto.UserCouponInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+	now := time.Now()
+	if now.Before(template.ValidFrom) {
+		return &proto.UserCouponInfo{}, status.Error(codes.FailedPrecondition, "优惠券还不能领取")
+	}
+	if now.After(template.ValidTo) {
+		return &proto.UserCouponInfo{}, status.Error(codes.FailedPrecondition, "优惠券已过期")
+	}
+
+	var coupon model.UserCoupon
+	err = server.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		// 发放数量加一，已经发完了就不会更新
+		// 更新会锁住模板直到事务结束，同一个模板的领取是串行的，之后统计的数量不会少算并发领取的
+		var err error
+		template, err = queries.IssueCouponTemplate(ctx, model.IssueCouponTemplateParams{
+			UpdatedAt: time.Now(),
+			ID:        req.TemplateID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return status.Error(codes.ResourceExhausted, "优惠券已经领完了")
+		} else if err != nil {
+			return err
+		}
+		// 超过限领数量时回滚事务，发放数量也会恢复
+		count, err := queries.CountUserCouponByTemplate(ctx, model.CountUserCouponByTemplateParams{
+			UserID:     req.UserID,
+			TemplateID: req.TemplateID,
+		})
+		if err != nil {
+			return err
+		}
+		if count >= int64(template.PerUserLimit) {
+			return status.Error(codes.FailedPrecondition, "已达到领取上限")
+		}
+		coupon, err = queries.CreateUserCoupon(ctx, model.CreateUserCouponParams{
+			UserID:     req.UserID,
+			TemplateID: req.TemplateID,
+		})
+		return err
+	})
+	if err != nil {
+		// 已经转换过的错误直接返回
+		if _, ok := status.FromError(err); ok {
+			return &proto.UserCouponInfo{}, err
+		}
+		global.Logger.Error("领取优惠券失败", zap.Error(err))
+		return &proto.UserCouponInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+	return userCouponModel2Info(coupon, template), nil
+}
+
+//
+// UserCouponList
+//  @Description: 获取用户领取的所有优惠券
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.UserCouponListResponse
+//  @return error
+//
+func (server *OrderServer) UserCouponList(ctx context.Context, req *proto.UserCouponListRequest) (*proto.UserCouponListResponse, error) {
+	if err := auth.CheckCaller(ctx, req.UserID, auth.PermOrderReadAll); err != nil {
+		return &proto.UserCouponListResponse{}, err
+	}
+	coupons, err := server.Store.GetUserCouponList(ctx, req.UserID)
+	if err != nil {
+		global.Logger.Error("获取用户优惠券失败", zap.Error(err))
+		return &proto.UserCouponListResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+	response := proto.UserCouponListResponse{
+		Total: int32(len(coupons)),
+		Data:  make([]*proto.UserCouponInfo, 0),
+	}
+	templates := make(map[int64]model.CouponTemplate)
+	for _, coupon := range coupons {
+		template, ok := templates[coupon.TemplateID]
+		if !ok {
+			template, err = server.Store.GetCouponTemplate(ctx, coupon.TemplateID)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				global.Logger.Error("获取优惠券模板失败", zap.Error(err))
+				return &proto.UserCouponListResponse{}, status.Error(codes.Internal, "内部错误")
+			}
+			templates[coupon.TemplateID] = template
+		}
+		response.Data = append(response.Data, userCouponModel2Info(coupon, template))
+	}
+	return &response, nil
+}
+
+//
+// getCouponRule
+//  @Description: 下单时检查用户的优惠券是否可以使用，返回对应的优惠规则
+//  @receiver server
+//  @param ctx
+//  @param userID
+//  @param couponID
+//  @return *promotion.Rule
+//  @return error
+//
+func (server *OrderServer) getCouponRule(ctx context.Context, userID int32, couponID int64) (*promotion.Rule, error) {
+	coupon, err := server.Store.GetUserCoupon(ctx, model.GetUserCouponParams{
+		ID:     couponID,
+		UserID: userID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "优惠券不存在")
+	} else if err != nil {
+		global.Logger.Error("获取用户优惠券失败", zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	if coupon.Status != couponStatusUnused {
+		return nil, status.Error(codes.FailedPrecondition, "优惠券已被使用")
+	}
+
+	template, err := server.Store.GetCouponTemplate(ctx, coupon.TemplateID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "优惠券不存在")
+	} else if err != nil {
+		global.Logger.Error("获取优惠券模板失败", zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	now := time.Now()
+	if now.Before(template.ValidFrom) || now.After(template.ValidTo) {
+		return nil, status.Error(codes.FailedPrecondition, "优惠券不在有效期内")
+	}
+	return couponTemplate2Rule(template), nil
+}
+
+//
+// couponTemplate2Rule
+//  @Description: 优惠券模板转换为优惠规则
+//  @param template
+//  @return *promotion.Rule
+//
+func couponTemplate2Rule(template model.CouponTemplate) *promotion.Rule {
+	return &promotion.Rule{
+		Type:      template.Type,
+		Amount:    template.Amount,
+		Rate:      template.Rate,
+		Threshold: template.Threshold,
+		GoodsID:   template.GoodsID,
+	}
+}
+
+//
+// couponTemplateModel2Info
+//  @Description: 优惠券模板的 model 转换为 proto，金额是基准货币的
+//  @param template
+//  @return *proto.CouponTemplateInfo
+//
+func couponTemplateModel2Info(template model.CouponTemplate) *proto.CouponTemplateInfo {
+	base := baseCurrency()
+	return &proto.CouponTemplateInfo{
+		Id:             template.ID,
+		Name:           template.Name,
+		Type:           int32(template.Type),
+		Amount:         float32(money.ToMajor(template.Amount, base)),
+		Rate:           float32(template.Rate),
+		Threshold:      float32(money.ToMajor(template.Threshold, base)),
+		GoodsID:        template.GoodsID,
+		Total:          template.Total,
+		Issued:         template.Issued,
+		PerUserLimit:   template.PerUserLimit,
+		ValidFrom:      template.ValidFrom.Unix(),
+		ValidTo:        template.ValidTo.Unix(),
+		AmountCents:    template.Amount,
+		ThresholdCents: template.Threshold,
+	}
+}
+
+//
+// requestCents
+//  @Description: 优先使用以最小单位表示的金额，旧的客户端只传了 float 的金额时再按照基准货币转换
+//  @param cents
+//  @param major
+//  @return int64
+//
+func requestCents(cents int64, major float32) int64 {
+	if cents != 0 {
+		return cents
+	}
+	return money.FromMajor(float64(major), baseCurrency())
+}
+
+//
+// baseCurrency
+//  @Description: 优惠券和运费规则使用的基准货币，没有配置时使用默认的币种
+//  @return string
+//
+func baseCurrency() string {
+	if base := money.NormalizeCurrency(global.RemoteConfig.BaseCurrency); base != "" {
+		return base
+	}
+	return money.DefaultCurrency
+}
+
+func userCouponModel2Info(coupon model.UserCoupon, template model.CouponTemplate) *proto.UserCouponInfo {
+	return &proto.UserCouponInfo{
+		Id:       coupon.ID,
+		UserID:   coupon.UserID,
+		Status:   int32(coupon.Status),
+		OrderID:  coupon.OrderID.Int64,
+		Template: couponTemplateModel2Info(template),
+	}
+}
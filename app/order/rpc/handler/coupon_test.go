@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
+)
+
+func createCouponTemplate(t *testing.T, total int32) *proto.CouponTemplateInfo {
+	template, err := orderClient.CreateCouponTemplate(context.Background(), &proto.CouponTemplateInfo{
+		Name:           "满100减20",
+		Type:           3,
+		AmountCents:    2000,
+		ThresholdCents: 10000,
+		Total:          total,
+		PerUserLimit:   1,
+		ValidFrom:      time.Now().Add(-time.Hour).Unix(),
+		ValidTo:        time.Now().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	require.NotZero(t, template.Id)
+	return template
+}
+
+func TestOrderServer_CreateCouponTemplate(t *testing.T) {
+	createCouponTemplate(t, 10)
+
+	// 折扣率不合法
+	_, err := orderClient.CreateCouponTemplate(context.Background(), &proto.CouponTemplateInfo{
+		Name:      "打折",
+		Type:      2,
+		Rate:      1.5,
+		ValidFrom: time.Now().Unix(),
+		ValidTo:   time.Now().Add(time.Hour).Unix(),
+	})
+	require.Error(t, err)
+}
+
+func TestOrderServer_IssueCoupon(t *testing.T) {
+	template := createCouponTemplate(t, 1)
+
+	coupon, err := orderClient.IssueCoupon(context.Background(), &proto.IssueCouponRequest{
+		UserID:     116,
+		TemplateID: template.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), coupon.Status)
+	require.Equal(t, template.Id, coupon.Template.Id)
+
+	// 超过每人限领的数量
+	_, err = orderClient.IssueCoupon(context.Background(), &proto.IssueCouponRequest{
+		UserID:     116,
+		TemplateID: template.Id,
+	})
+	require.Error(t, err)
+
+	// 已经领完了
+	_, err = orderClient.IssueCoupon(context.Background(), &proto.IssueCouponRequest{
+		UserID:     117,
+		TemplateID: template.Id,
+	})
+	require.Error(t, err)
+
+	list, err := orderClient.UserCouponList(context.Background(), &proto.UserCouponListRequest{UserID: 116})
+	require.NoError(t, err)
+	require.True(t, len(list.Data) > 0)
+
+	// 不能替其他用户领取和查看优惠券
+	otherCtx := auth.NewOutgoingContext(context.Background(), auth.Caller{UID: 117, Role: auth.RoleUser})
+	_, err = orderClient.IssueCoupon(otherCtx, &proto.IssueCouponRequest{
+		UserID:     116,
+		TemplateID: template.Id,
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = orderClient.UserCouponList(otherCtx, &proto.UserCouponListRequest{UserID: 116})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestOrderServer_IssueCouponBeforeValidFrom(t *testing.T) {
+	template, err := orderClient.CreateCouponTemplate(context.Background(), &proto.CouponTemplateInfo{
+		Name:        "明天开始领取",
+		Type:        1,
+		AmountCents: 100,
+		ValidFrom:   time.Now().Add(24 * time.Hour).Unix(),
+		ValidTo:     time.Now().Add(48 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	_, err = orderClient.IssueCoupon(context.Background(), &proto.IssueCouponRequest{
+		UserID:     116,
+		TemplateID: template.Id,
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestOrderServer_IssueCouponConcurrently(t *testing.T) {
+	// 不限制发放总量 每人限领一张
+	template := createCouponTemplate(t, 0)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	issued := 0
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := orderClient.IssueCoupon(context.Background(), &proto.IssueCouponRequest{
+				UserID:     118,
+				TemplateID: template.Id,
+			})
+			if err == nil {
+				mu.Lock()
+				issued++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 1, issued)
+
+	list, err := orderClient.UserCouponList(context.Background(), &proto.UserCouponListRequest{UserID: 118})
+	require.NoError(t, err)
+	count := 0
+	for _, coupon := range list.Data {
+		if coupon.Template.Id == template.Id {
+			count++
+		}
+	}
+	require.Equal(t, 1, count)
+}
+
+func TestOrderServer_CreateOrderWithUsedCoupon(t *testing.T) {
+	template, err := orderClient.CreateCouponTemplate(context.Background(), &proto.CouponTemplateInfo{
+		Name:         "立减0.01",
+		Type:         1,
+		AmountCents:  1,
+		PerUserLimit: 1,
+		ValidFrom:    time.Now().Add(-time.Hour).Unix(),
+		ValidTo:      time.Now().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	coupon, err := orderClient.IssueCoupon(context.Background(), &proto.IssueCouponRequest{
+		UserID:     116,
+		TemplateID: template.Id,
+	})
+	require.NoError(t, err)
+
+	createOrder := func() (*proto.OrderInfo, error) {
+		_, err := orderClient.CreateCartItem(context.Background(), &proto.CreateCartItemRequest{
+			UserID:  116,
+			GoodsID: 5,
+			Nums:    1,
+			Checked: true,
+		})
+		require.NoError(t, err)
+		return orderClient.CreateOrder(context.Background(), &proto.CreateOrderRequest{
+			UserID:    116,
+			AddressID: 1,
+			CouponID:  coupon.Id,
+		})
+	}
+	order, err := createOrder()
+	require.NoError(t, err)
+	require.Equal(t, coupon.Id, order.CouponID)
+
+	// 优惠券已经被上一个订单锁定
+	_, err = createOrder()
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Equal(t, "优惠券已被使用", status.Convert(err).Message())
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/order/rpc/global"
+	"github.com/jimyag/shop/app/order/rpc/model"
+	"github.com/jimyag/shop/common/proto"
+)
+
+// 游客购物车标识的最大长度
+const maxCartTokenLength = 64
+
+//
+// MergeGuestCart
+//  @Description: 登录后把游客购物车中的商品合并到用户的购物车，合并的规则和添加商品到购物车相同
+//  合并后游客的购物车会被清空，重复合并不会重复添加，超过限购数量的商品减少到限购数量
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.CartItemListResponse 合并后用户的购物车
+//  @return error
+//
+func (server *OrderServer) MergeGuestCart(ctx context.Context, req *proto.MergeGuestCartRequest) (*proto.CartItemListResponse, error) {
+	if req.UserID <= 0 {
+		return &proto.CartItemListResponse{}, status.Error(codes.InvalidArgument, "用户不存在")
+	}
+	_, cartToken, err := cartOwner(0, req.CartToken)
+	if err != nil {
+		return &proto.CartItemListResponse{}, err
+	}
+
+	var cartList []model.ShoppingCart
+	err = server.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		guestCart, err := queries.GetCartListByUid(ctx, model.GetCartListByUidParams{CartToken: cartToken})
+		if err != nil {
+			return err
+		}
+		// 游客加入购物车时的价格也一起合并，结算前同样可以提示价格变化
+		mergedNums := make(map[int32]int32, len(guestCart))
+		goodsIDs := make([]int32, 0, len(guestCart))
+		for _, item := range guestCart {
+			item.UserID = req.UserID
+			item.CartToken = ""
+			merged, err := addCartItem(ctx, queries, item)
+			if err != nil {
+				return err
+			}
+			mergedNums[merged.GoodsID] = merged.Nums
+			goodsIDs = append(goodsIDs, merged.GoodsID)
+		}
+		// 合并之后超过限购数量的商品减少到限购数量，不能因为合并导致登录失败
+		if len(goodsIDs) > 0 {
+			goodsInfos, err := purchaseLimitGoods(ctx, goodsIDs)
+			if err != nil {
+				return err
+			}
+			for _, info := range goodsInfos {
+				if checkPurchaseLimit(info, mergedNums[info.Id]) == nil {
+					continue
+				}
+				if _, err = queries.UpdateCartItemNums(ctx, model.UpdateCartItemNumsParams{
+					UpdatedAt: time.Now(),
+					Nums:      info.PurchaseLimit,
+					UserID:    req.UserID,
+					GoodsID:   info.Id,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+		if _, err = queries.DeleteGuestCart(ctx, model.DeleteGuestCartParams{
+			DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			CartToken: cartToken,
+		}); err != nil {
+			return err
+		}
+		cartList, err = queries.GetCartListByUid(ctx, model.GetCartListByUidParams{UserID: req.UserID})
+		return err
+	})
+	if err != nil {
+		// 已经转换过的错误直接返回
+		if _, ok := status.FromError(err); ok {
+			return &proto.CartItemListResponse{}, err
+		}
+		global.Logger.Error("合并购物车失败", zap.Error(err))
+		return &proto.CartItemListResponse{}, status.Error(codes.Internal, "合并购物车失败")
+	}
+	return cartModel2ListResponse(cartList), nil
+}
+
+//
+// cartOwner
+//  @Description: 购物车的所有者，登录用户使用 userID，游客使用 cartToken
+//  @param userID
+//  @param cartToken
+//  @return int32 游客为 0
+//  @return string 登录用户为空
+//  @return error
+//
+func cartOwner(userID int32, cartToken string) (int32, string, error) {
+	if userID > 0 {
+		return userID, "", nil
+	}
+	if cartToken == "" || len(cartToken) > maxCartTokenLength {
+		return 0, "", status.Error(codes.InvalidArgument, "购物车标识不合法")
+	}
+	return 0, cartToken, nil
+}
+
+func cartModel2Info(cart model.ShoppingCart) *proto.ShopCartInfoResponse {
+	return &proto.ShopCartInfoResponse{
+		Id:      int32(cart.ID),
+		UserID:  cart.UserID,
+		GoodsID: cart.GoodsID,
+		Nums:    cart.Nums,
+		Checked: cart.Checked,
+	}
+}
+
+func cartModel2ListResponse(cartList []model.ShoppingCart) *proto.CartItemListResponse {
+	response := proto.CartItemListResponse{
+		Total: int32(len(cartList)),
+		Data:  make([]*proto.ShopCartInfoResponse, 0, len(cartList)),
+	}
+	for _, cart := range cartList {
+		response.Data = append(response.Data, cartModel2Info(cart))
+	}
+	return &response
+}
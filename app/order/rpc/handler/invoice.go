@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/order/rpc/global"
+	"github.com/jimyag/shop/app/order/rpc/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/money"
+)
+
+const (
+	maxBuyerCompanyLength = 128
+	maxBuyerTaxIDLength   = 32
+)
+
+//
+// IssueInvoice
+//  @Description: 为已支付的订单开具发票，一个订单只有一张发票，已经开具过时返回原来的发票
+//  发票号按年连续递增，和发票在同一个事务中生成，不会跳号
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.InvoiceInfo
+//  @return error
+//
+func (server *OrderServer) IssueInvoice(ctx context.Context, req *proto.IssueInvoiceRequest) (*proto.InvoiceInfo, error) {
+	if req.OrderID <= 0 || req.UserID <= 0 {
+		return &proto.InvoiceInfo{}, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	if utf8.RuneCountInString(req.BuyerCompany) > maxBuyerCompanyLength || len(req.BuyerTaxID) > maxBuyerTaxIDLength {
+		return &proto.InvoiceInfo{}, status.Error(codes.InvalidArgument, "发票抬头或税号太长")
+	}
+	if req.BuyerTaxID != "" && req.BuyerCompany == "" {
+		return &proto.InvoiceInfo{}, status.Error(codes.InvalidArgument, "填写税号时需要填写发票抬头")
+	}
+
+	orderInfo, err := server.Store.GetOrderDetail(ctx, req.OrderID)
+	// 不是自己的订单也当作不存在
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && orderInfo.UserID != req.UserID) {
+		return &proto.InvoiceInfo{}, status.Error(codes.NotFound, "没有找到该订单")
+	} else if err != nil {
+		global.Logger.Error("获取订单失败", zap.Error(err))
+		return &proto.InvoiceInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+	// 2 已支付 4 已发货 5 已签收
+	if orderInfo.Status != 2 && orderInfo.Status != 4 && orderInfo.Status != 5 {
+		return &proto.InvoiceInfo{}, status.Error(codes.FailedPrecondition, "只有已支付的订单可以开具发票")
+	}
+
+	invoice, err := server.Store.GetInvoiceByOrderID(ctx, req.OrderID)
+	if err == nil {
+		return server.invoiceModel2Info(ctx, invoice)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		global.Logger.Error("获取发票失败", zap.Error(err))
+		return &proto.InvoiceInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+
+	orderGoods, err := server.Store.GetOrderListByOrderID(ctx, req.OrderID)
+	if err != nil {
+		global.Logger.Error("获取订单商品失败", zap.Error(err))
+		return &proto.InvoiceInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+	err = server.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		year := int32(time.Now().Year())
+		number, err := queries.NextInvoiceNumber(ctx, year)
+		if err != nil {
+			return err
+		}
+		invoice, err = queries.CreateInvoice(ctx, model.CreateInvoiceParams{
+			InvoiceNo:      fmt.Sprintf("INV%d%06d", year, number),
+			Year:           year,
+			Number:         number,
+			OrderID:        orderInfo.OrderID,
+			UserID:         orderInfo.UserID,
+			BuyerName:      orderInfo.SignerName,
+			BuyerMobile:    orderInfo.SignerMobile,
+			BuyerAddress:   orderInfo.Address,
+			BuyerPost:      orderInfo.Post,
+			BuyerCompany:   req.BuyerCompany,
+			BuyerTaxID:     req.BuyerTaxID,
+			Currency:       orderInfo.Currency,
+			GoodsAmount:    orderInfo.GoodsAmount,
+			DiscountAmount: orderInfo.DiscountAmount,
+			ShippingFee:    orderInfo.ShippingFee,
+			TaxAmount:      orderInfo.TaxAmount,
+			TotalAmount:    orderInfo.OrderMount.Int64,
+		})
+		if err != nil {
+			return err
+		}
+		for _, good := range orderGoods {
+			_, err = queries.CreateInvoiceLine(ctx, model.CreateInvoiceLineParams{
+				InvoiceID:      invoice.ID,
+				GoodsID:        good.GoodsID,
+				GoodsName:      good.GoodsName,
+				Price:          good.GoodsPrice,
+				Nums:           good.Nums,
+				DiscountAmount: good.DiscountAmount,
+				Amount:         money.Mul(good.GoodsPrice, good.Nums) - good.DiscountAmount,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// 同时开具发票时只有一个会成功，返回已经开具的发票
+		if existing, getErr := server.Store.GetInvoiceByOrderID(ctx, req.OrderID); getErr == nil {
+			return server.invoiceModel2Info(ctx, existing)
+		}
+		global.Logger.Error("开具发票失败", zap.Error(err))
+		return &proto.InvoiceInfo{}, status.Error(codes.Internal, "开具发票失败")
+	}
+	return server.invoiceModel2Info(ctx, invoice)
+}
+
+//
+// GetInvoice
+//  @Description: 获取已经开具的发票，不会开具新的发票
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.InvoiceInfo
+//  @return error 没有开具发票时返回 NotFound
+//
+func (server *OrderServer) GetInvoice(ctx context.Context, req *proto.GetInvoiceRequest) (*proto.InvoiceInfo, error) {
+	if req.OrderID <= 0 || req.UserID <= 0 {
+		return &proto.InvoiceInfo{}, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	invoice, err := server.Store.GetInvoiceByOrderID(ctx, req.OrderID)
+	// 不是自己订单的发票也当作不存在
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && invoice.UserID != req.UserID) {
+		return &proto.InvoiceInfo{}, status.Error(codes.NotFound, "该订单还没有开具发票")
+	} else if err != nil {
+		global.Logger.Error("获取发票失败", zap.Error(err))
+		return &proto.InvoiceInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+	return server.invoiceModel2Info(ctx, invoice)
+}
+
+//
+// invoiceModel2Info
+//  @Description: 发票的 model 转换为 proto，同时获取发票的商品和订单的税费
+//  @receiver server
+//  @param ctx
+//  @param invoice
+//  @return *proto.InvoiceInfo
+//  @return error grpc 的错误
+//
+func (server *OrderServer) invoiceModel2Info(ctx context.Context, invoice model.Invoice) (*proto.InvoiceInfo, error) {
+	lines, err := server.Store.GetInvoiceLines(ctx, invoice.ID)
+	if err != nil {
+		global.Logger.Error("获取发票商品失败", zap.Error(err))
+		return &proto.InvoiceInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+	taxes, err := server.Store.GetOrderTaxes(ctx, invoice.OrderID)
+	if err != nil {
+		global.Logger.Error("获取订单税费失败", zap.Error(err))
+		return &proto.InvoiceInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+
+	info := proto.InvoiceInfo{
+		InvoiceNo:       invoice.InvoiceNo,
+		OrderID:         invoice.OrderID,
+		UserID:          invoice.UserID,
+		BuyerName:       invoice.BuyerName,
+		BuyerMobile:     invoice.BuyerMobile,
+		BuyerAddress:    invoice.BuyerAddress,
+		BuyerPost:       invoice.BuyerPost,
+		BuyerCompany:    invoice.BuyerCompany,
+		BuyerTaxID:      invoice.BuyerTaxID,
+		Currency:        invoice.Currency,
+		Lines:           make([]*proto.InvoiceLine, 0, len(lines)),
+		Taxes:           make([]*proto.TaxLine, 0, len(taxes)),
+		GoodsTotalCents: invoice.GoodsAmount,
+		DiscountCents:   invoice.DiscountAmount,
+		ShippingCents:   invoice.ShippingFee,
+		TaxCents:        invoice.TaxAmount,
+		TotalCents:      invoice.TotalAmount,
+		IssuedAt:        invoice.CreatedAt.Unix(),
+	}
+	for _, line := range lines {
+		info.Lines = append(info.Lines, &proto.InvoiceLine{
+			GoodsID:       line.GoodsID,
+			GoodsName:     line.GoodsName,
+			PriceCents:    line.Price,
+			Nums:          line.Nums,
+			DiscountCents: line.DiscountAmount,
+			AmountCents:   line.Amount,
+		})
+	}
+	for _, tax := range taxes {
+		info.Taxes = append(info.Taxes, &proto.TaxLine{
+			Name:        tax.Name,
+			Rate:        tax.Rate,
+			AmountCents: tax.Amount,
+		})
+	}
+	return &info, nil
+}
@@ -11,11 +11,13 @@ import (
 )
 
 var (
-	orderClient proto.OrderClient
+	orderClient     proto.OrderClient
+	inventoryClient proto.InventoryClient
 )
 
 const (
-	target = "192.168.0.2:50054"
+	target          = "192.168.0.2:50054"
+	inventoryTarget = "192.168.0.2:50053"
 )
 
 func TestMain(m *testing.M) {
@@ -24,6 +26,11 @@ func TestMain(m *testing.M) {
 		log.Fatalf("cannot dial %s :%v\n", target, err)
 	}
 	orderClient = proto.NewOrderClient(conn)
+	inventoryConn, err := grpc.Dial(inventoryTarget, grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("cannot dial %s :%v\n", inventoryTarget, err)
+	}
+	inventoryClient = proto.NewInventoryClient(inventoryConn)
 
 	log.Printf("dial %s success....\n", target)
 	os.Exit(m.Run())
@@ -17,7 +17,10 @@ import (
 	"github.com/jimyag/shop/app/order/rpc/global"
 	"github.com/jimyag/shop/app/order/rpc/model"
 	"github.com/jimyag/shop/app/order/rpc/tools/generate"
+	"github.com/jimyag/shop/app/order/rpc/tools/pricing"
 	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
+	"github.com/jimyag/shop/common/utils/money"
 )
 
 //
@@ -25,17 +28,19 @@ import (
 //  @Description: order 的server
 //
 type OrderServer struct {
-	Store model.Store
+	Store   model.Store
+	Pricing *pricing.Engine
 }
 
 //
 // NewOrderServer
 //  @Description: 创建 order server
 //  @param store
+//  @param pricingEngine 运费和税费的规则
 //  @return *OrderServer
 //
-func NewOrderServer(store model.Store) *OrderServer {
-	return &OrderServer{Store: store}
+func NewOrderServer(store model.Store, pricingEngine *pricing.Engine) *OrderServer {
+	return &OrderServer{Store: store, Pricing: pricingEngine}
 }
 
 //
@@ -48,7 +53,14 @@ func NewOrderServer(store model.Store) *OrderServer {
 //  @return error
 //
 func (server *OrderServer) CartItemList(ctx context.Context, req *proto.CartItemListRequest) (*proto.CartItemListResponse, error) {
-	cartList, err := server.Store.GetCartListByUid(ctx, req.GetUid())
+	userID, cartToken, err := cartOwner(req.GetUid(), req.GetCartToken())
+	if err != nil {
+		return &proto.CartItemListResponse{}, err
+	}
+	cartList, err := server.Store.GetCartListByUid(ctx, model.GetCartListByUidParams{
+		UserID:    userID,
+		CartToken: cartToken,
+	})
 	// 没数据不用关心
 	if cartList == nil {
 		return &proto.CartItemListResponse{}, status.Error(codes.NotFound, "没有记录")
@@ -56,21 +68,7 @@ func (server *OrderServer) CartItemList(ctx context.Context, req *proto.CartItem
 		global.Logger.Error(err.Error())
 		return &proto.CartItemListResponse{}, status.Error(codes.Internal, "未知错误")
 	}
-	response := proto.CartItemListResponse{}
-	response.Total = int32(len(cartList))
-	cartResponse := make([]*proto.ShopCartInfoResponse, 0)
-	for _, v := range cartList {
-		infoResponse := proto.ShopCartInfoResponse{
-			Id:      int32(v.ID),
-			UserID:  v.UserID,
-			GoodsID: v.GoodsID,
-			Nums:    v.Nums,
-			Checked: v.Checked,
-		}
-		cartResponse = append(cartResponse, &infoResponse)
-	}
-	response.Data = cartResponse
-	return &response, nil
+	return cartModel2ListResponse(cartList), nil
 }
 
 //
@@ -83,52 +81,92 @@ func (server *OrderServer) CartItemList(ctx context.Context, req *proto.CartItem
 //  @return error
 //
 func (server *OrderServer) CreateCartItem(ctx context.Context, req *proto.CreateCartItemRequest) (*proto.ShopCartInfoResponse, error) {
-	// 查询是否有了
-	getCartDetailByUIDAndGoodsIDParams := model.GetCartDetailByUIDAndGoodsIDParams{
-		GoodsID: req.GoodsID,
-		UserID:  req.UserID,
+	userID, cartToken, err := cartOwner(req.UserID, req.CartToken)
+	if err != nil {
+		return &proto.ShopCartInfoResponse{}, err
 	}
-	shoppingCart, err := server.Store.GetCartDetailByUIDAndGoodsID(ctx, getCartDetailByUIDAndGoodsIDParams)
+	// 记录加入购物车时的价格，结算前用来提示价格变化
+	goods, err := currentGoods(ctx, req.GoodsID)
 	if err != nil {
-		if !errors.Is(err, sql.ErrNoRows) {
-			global.Logger.Error("查询购物车失败", zap.Error(err))
-			return &proto.ShopCartInfoResponse{}, status.Error(codes.Internal, "内部错误")
-		}
-		// 没有的话就新建
-		cartInfo, err := server.Store.CreateCart(ctx, model.CreateCartParams{UserID: req.UserID, GoodsID: req.GoodsID, Nums: req.Nums})
+		return &proto.ShopCartInfoResponse{}, err
+	}
+
+	var cartInfo model.ShoppingCart
+	err = server.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		cartInfo, err = addCartItem(ctx, queries, model.ShoppingCart{
+			UserID:    userID,
+			CartToken: cartToken,
+			GoodsID:   req.GoodsID,
+			Nums:      req.Nums,
+			Checked:   req.Checked,
+			Price:     goods.PriceCents,
+		})
 		if err != nil {
-			global.Logger.Error("创建购物车记录失败", zap.Error(err))
-			return &proto.ShopCartInfoResponse{}, status.Error(codes.Internal, "未知错误")
+			return err
 		}
-		resp := proto.ShopCartInfoResponse{
-			Id:      int32(cartInfo.ID),
-			UserID:  cartInfo.UserID,
-			GoodsID: cartInfo.GoodsID,
-			Nums:    cartInfo.Nums,
+		// 合并之后的数量也不能超过限购数量
+		return checkPurchaseLimit(goods, cartInfo.Nums)
+	})
+	if err != nil {
+		// 已经转换过的错误直接返回
+		if _, ok := status.FromError(err); ok {
+			return &proto.ShopCartInfoResponse{}, err
 		}
-		return &resp, nil
+		global.Logger.Error("添加购物车记录失败", zap.Error(err))
+		return &proto.ShopCartInfoResponse{}, status.Error(codes.Internal, "未知错误")
+	}
+	return cartModel2Info(cartInfo), nil
+}
+
+//
+// addCartItem
+//  @Description: 原本没有这件商品就新建，已经有了就把数量加上去，价格使用最新的价格
+//  @param ctx
+//  @param queries
+//  @param item 需要添加的商品
+//  @return model.ShoppingCart
+//  @return error
+//
+func addCartItem(ctx context.Context, queries *model.Queries, item model.ShoppingCart) (model.ShoppingCart, error) {
+	// 查询是否有了
+	shoppingCart, err := queries.GetCartDetailByUIDAndGoodsID(ctx, model.GetCartDetailByUIDAndGoodsIDParams{
+		UserID:    item.UserID,
+		CartToken: item.CartToken,
+		GoodsID:   item.GoodsID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// 没有的话就新建
+		return queries.CreateCart(ctx, model.CreateCartParams{
+			UserID:    item.UserID,
+			CartToken: item.CartToken,
+			GoodsID:   item.GoodsID,
+			Nums:      item.Nums,
+			Checked:   item.Checked,
+			Price:     item.Price,
+		})
+	} else if err != nil {
+		return shoppingCart, err
 	}
 
 	// 如果已经有了就把数量加上去
-	cartInfo, err := server.Store.UpdateCartItem(ctx, model.UpdateCartItemParams{
+	_, err = queries.UpdateCartItem(ctx, model.UpdateCartItemParams{
 		UpdatedAt: time.Now(),
-		Nums:      shoppingCart.Nums + req.Nums,
-		Checked:   req.Checked,
-		UserID:    req.UserID,
-		GoodsID:   req.GoodsID,
+		Nums:      shoppingCart.Nums + item.Nums,
+		Checked:   item.Checked,
+		UserID:    item.UserID,
+		CartToken: item.CartToken,
+		GoodsID:   item.GoodsID,
 	})
 	if err != nil {
-		global.Logger.Error(err.Error())
-		return &proto.ShopCartInfoResponse{}, status.Error(codes.Internal, "未知错误")
-	}
-	resp := proto.ShopCartInfoResponse{
-		Id:      int32(cartInfo.ID),
-		UserID:  cartInfo.UserID,
-		GoodsID: cartInfo.GoodsID,
-		Nums:    cartInfo.Nums,
-		Checked: cartInfo.Checked,
+		return shoppingCart, err
 	}
-	return &resp, nil
+	return queries.UpdateCartItemPrice(ctx, model.UpdateCartItemPriceParams{
+		UpdatedAt: time.Now(),
+		Price:     item.Price,
+		UserID:    item.UserID,
+		CartToken: item.CartToken,
+		GoodsID:   item.GoodsID,
+	})
 }
 
 //
@@ -141,12 +179,17 @@ func (server *OrderServer) CreateCartItem(ctx context.Context, req *proto.Create
 //  @return error
 //
 func (server *OrderServer) DeleteCartItems(ctx context.Context, req *proto.DeleteCartItemsRequest) (*proto.Empty, error) {
+	userID, cartToken, err := cartOwner(req.UserID, req.CartToken)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
 	// 查询是否存在
 	getCartDetailByUIDAndGoodsIDParams := model.GetCartDetailByUIDAndGoodsIDParams{
-		GoodsID: req.GoodsID,
-		UserID:  req.UserID,
+		GoodsID:   req.GoodsID,
+		UserID:    userID,
+		CartToken: cartToken,
 	}
-	_, err := server.Store.GetCartDetailByUIDAndGoodsID(ctx, getCartDetailByUIDAndGoodsIDParams)
+	_, err = server.Store.GetCartDetailByUIDAndGoodsID(ctx, getCartDetailByUIDAndGoodsIDParams)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return &proto.Empty{}, status.Error(codes.NotFound, "此条记录不存在")
@@ -158,7 +201,8 @@ func (server *OrderServer) DeleteCartItems(ctx context.Context, req *proto.Delet
 	}
 	arg := model.DeleteCartItemParams{
 		DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
-		UserID:    req.UserID,
+		UserID:    userID,
+		CartToken: cartToken,
 		GoodsID:   req.GoodsID,
 	}
 	_, err = server.Store.DeleteCartItem(ctx, arg)
@@ -181,12 +225,17 @@ func (server *OrderServer) DeleteCartItems(ctx context.Context, req *proto.Delet
 //  @return error
 //
 func (server *OrderServer) UpdateCartItem(ctx context.Context, req *proto.UpdateCartItemRequest) (*proto.Empty, error) {
+	userID, cartToken, err := cartOwner(req.UserID, req.CartToken)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
 	cartDetail := model.GetCartDetailByUIDAndGoodsIDParams{
-		UserID:  req.UserID,
-		GoodsID: req.GoodsID,
+		UserID:    userID,
+		CartToken: cartToken,
+		GoodsID:   req.GoodsID,
 	}
 	// 拿到之前的条目信息
-	_, err := server.Store.GetCartDetailByUIDAndGoodsID(ctx, cartDetail)
+	_, err = server.Store.GetCartDetailByUIDAndGoodsID(ctx, cartDetail)
 	if err == sql.ErrNoRows {
 		return &proto.Empty{}, status.Error(codes.NotFound, "没有找到该条目")
 	} else if err != nil {
@@ -195,7 +244,8 @@ func (server *OrderServer) UpdateCartItem(ctx context.Context, req *proto.Update
 	}
 
 	updateArg := model.UpdateCartItemParams{
-		UserID:    req.UserID,
+		UserID:    userID,
+		CartToken: cartToken,
 		GoodsID:   req.GoodsID,
 		UpdatedAt: time.Now(),
 	}
@@ -204,6 +254,9 @@ func (server *OrderServer) UpdateCartItem(ctx context.Context, req *proto.Update
 	updateArg.Checked = req.Checked
 	// 只有当传过来的值大于0的时候才能更新
 	if req.Nums > 0 {
+		if err = checkPurchaseLimits(ctx, map[int32]int32{req.GoodsID: req.Nums}); err != nil {
+			return &proto.Empty{}, err
+		}
 		updateArg.Nums = req.Nums
 	}
 
@@ -216,12 +269,20 @@ func (server *OrderServer) UpdateCartItem(ctx context.Context, req *proto.Update
 }
 
 type OrderListener struct {
-	Code        codes.Code
-	Detail      string
-	OrderID     int64
-	OrderAmount float32
-	server      *OrderServer
-	ctx         context.Context
+	Code           codes.Code
+	Detail         string
+	OrderID        int64
+	OrderAmount    int64
+	GoodsAmount    int64
+	DiscountAmount int64
+	ShippingFee    int64
+	ShippingRule   string
+	TaxAmount      int64
+	Taxes          []pricing.TaxLine
+	Currency       string
+	ExchangeRate   float64
+	server         *OrderServer
+	ctx            context.Context
 }
 
 func NewOrderListener(server *OrderServer, ctx context.Context) *OrderListener {
@@ -249,7 +310,6 @@ func (dl *OrderListener) ExecuteLocalTransaction(msg *primitive.Message) primiti
 		UserID:  createOrderParams.UserID,
 		Checked: true,
 	}
-	goodsIDS := make([]*proto.GoodID, 0)
 	shoppingCart, err := dl.server.Store.GetCartListChecked(dl.ctx, getCheckedCart)
 	if shoppingCart == nil {
 		dl.Code = codes.InvalidArgument
@@ -261,39 +321,32 @@ func (dl *OrderListener) ExecuteLocalTransaction(msg *primitive.Message) primiti
 		return primitive.RollbackMessageState
 	}
 
-	// 保存 商品的数量
-	goodsNumMap := make(map[int32]int32)
-	for _, cart := range shoppingCart {
-		goodsIDS = append(goodsIDS, &proto.GoodID{Id: cart.GoodsID})
-		goodsNumMap[cart.GoodsID] = cart.Nums
-	}
-	goodsInfos, err := global.GoodsClient.GetGoodsBatchInfo(dl.ctx, &proto.ManyGoodsID{GoodsIDs: goodsIDS})
+	// 计算商品的金额、优惠、运费和税费，和下单前的报价一致
+	quote, err := dl.server.quoteOrder(dl.ctx, &createOrderParams, shoppingCart)
 	if err != nil {
-		dl.Code = codes.Internal
-		dl.Detail = "获取商品信息失败"
+		dl.Code = status.Code(err)
+		dl.Detail = status.Convert(err).Message()
 		return primitive.RollbackMessageState
 	}
 
-	// 订单的总金额
-	var orderAmount float32
 	// 订单中商品的参数
 	createOrderGoodsParams := make([]*model.CreateOrderGoodsParams, 0)
 	// 扣减库存 的参数
-	sellInfo := proto.SellInfo{GoodsInfo: make([]*proto.GoodInvInfo, 0)}
-	for _, datum := range goodsInfos.Data {
-		// 求总金额
-		orderAmount += datum.Price * float32(goodsNumMap[datum.Id])
+	// 库存服务按照订单号记录扣减的库存，取消订单时按照订单号归还
+	sellInfo := proto.SellInfo{OrderId: createOrderParams.OrderID, GoodsInfo: make([]*proto.GoodInvInfo, 0)}
+	for i, datum := range quote.goodsInfos.Data {
 		// 订单中的参数
 		createOrderGoodsParams = append(createOrderGoodsParams, &model.CreateOrderGoodsParams{
-			GoodsID:    datum.Id,
-			GoodsName:  datum.Name,
-			GoodsPrice: float64(datum.Price),
-			Nums:       goodsNumMap[datum.Id],
+			GoodsID:        datum.Id,
+			GoodsName:      datum.Name,
+			GoodsPrice:     datum.PriceCents,
+			Nums:           quote.goodsNums[datum.Id],
+			DiscountAmount: quote.promotion.Lines[i].Discount,
 		})
 		// 扣减库存的参数
 		sellInfo.GoodsInfo = append(sellInfo.GoodsInfo, &proto.GoodInvInfo{
 			GoodsId: datum.Id,
-			Num:     goodsNumMap[datum.Id],
+			Num:     quote.goodsNums[datum.Id],
 		})
 	}
 
@@ -311,57 +364,83 @@ func (dl *OrderListener) ExecuteLocalTransaction(msg *primitive.Message) primiti
 
 	// 本地服务的事务
 	err = dl.server.Store.ExecTx(dl.ctx, func(queries *model.Queries) error {
-		createOrderParams.OrderMount = sql.NullFloat64{
-			Float64: float64(orderAmount),
-			Valid:   true,
-		}
 		// 保存order
-		_, err = dl.server.Store.CreateOrder(dl.ctx, createOrderParams)
+		_, err = queries.CreateOrder(dl.ctx, createOrderParams)
 		if err != nil {
 			dl.Code = codes.Internal
 			dl.Detail = "保存订单失败"
 			return err
 		}
-		dl.OrderAmount = orderAmount
+		dl.OrderAmount = quote.total
+		dl.GoodsAmount = quote.promotion.GoodsAmount
+		dl.DiscountAmount = quote.promotion.Discount
+		dl.ShippingFee = quote.pricing.ShippingFee
+		dl.ShippingRule = quote.pricing.ShippingRule
+		dl.TaxAmount = quote.pricing.TaxAmount
+		dl.Taxes = quote.pricing.Taxes
+		dl.Currency = createOrderParams.Currency
+		dl.ExchangeRate = createOrderParams.ExchangeRate
+
+		// 保存每一项税费
+		for _, tax := range quote.pricing.Taxes {
+			_, err = queries.CreateOrderTax(dl.ctx, model.CreateOrderTaxParams{
+				OrderID: createOrderParams.OrderID,
+				Name:    tax.Name,
+				Rate:    tax.Rate,
+				Amount:  tax.Amount,
+			})
+			if err != nil {
+				dl.Code = codes.Internal
+				dl.Detail = "保存订单税费失败"
+				return err
+			}
+		}
+
+		// 锁定优惠券，订单支付成功后核销，订单关闭后释放
+		if createOrderParams.CouponID.Valid {
+			var rows int64
+			rows, err = queries.LockUserCoupon(dl.ctx, model.LockUserCouponParams{
+				UpdatedAt: time.Now(),
+				OrderID:   sql.NullInt64{Int64: createOrderParams.OrderID, Valid: true},
+				ID:        createOrderParams.CouponID.Int64,
+				UserID:    createOrderParams.UserID,
+			})
+			if err == nil && rows == 0 {
+				err = errors.New("优惠券已被使用")
+			}
+			if err != nil {
+				dl.Code = codes.FailedPrecondition
+				dl.Detail = "优惠券已被使用"
+				return err
+			}
+		}
 
 		// 将订单id更新
 		for _, good := range createOrderGoodsParams {
 			good.OrderID = createOrderParams.OrderID
 		}
 		// 批量插入订单中的商品
-		err = dl.server.Store.ExecTx(dl.ctx, func(queries *model.Queries) error {
-			for _, good := range createOrderGoodsParams {
-				_, err = queries.CreateOrderGoods(dl.ctx, *good)
-				if err != nil {
-					dl.Code = codes.Internal
-					dl.Detail = "保存订单商品失败"
-					return err
-				}
+		for _, good := range createOrderGoodsParams {
+			_, err = queries.CreateOrderGoods(dl.ctx, *good)
+			if err != nil {
+				dl.Code = codes.Internal
+				dl.Detail = "保存订单商品失败"
+				return err
 			}
-			return nil
-		})
-		if err != nil {
-			return err
 		}
 
 		// 批量删除购物车中记录
-		err = dl.server.Store.ExecTx(dl.ctx, func(queries *model.Queries) error {
-			for _, cart := range shoppingCart {
-				_, err = queries.DeleteCartItem(dl.ctx, model.DeleteCartItemParams{
-					DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
-					UserID:    cart.UserID,
-					GoodsID:   cart.GoodsID,
-				})
-				if err != nil {
-					dl.Code = codes.Internal
-					dl.Detail = "删除购物车中商品失败"
-					return err
-				}
+		for _, cart := range shoppingCart {
+			_, err = queries.DeleteCartItem(dl.ctx, model.DeleteCartItemParams{
+				DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+				UserID:    cart.UserID,
+				GoodsID:   cart.GoodsID,
+			})
+			if err != nil {
+				dl.Code = codes.Internal
+				dl.Detail = "删除购物车中商品失败"
+				return err
 			}
-			return nil
-		})
-		if err != nil {
-			return err
 		}
 		return nil
 	})
@@ -398,6 +477,10 @@ func (dl *OrderListener) CheckLocalTransaction(msg *primitive.MessageExt) primit
 //  @return error
 //
 func (server *OrderServer) CreateOrder(ctx context.Context, req *proto.CreateOrderRequest) (*proto.OrderInfo, error) {
+	// 有权限时才能替其他用户下单
+	if err := auth.CheckCaller(ctx, req.UserID, auth.PermOrderWriteAll); err != nil {
+		return &proto.OrderInfo{}, err
+	}
 	orderlistener := NewOrderListener(server, ctx)
 	p, err := rocketmq.NewTransactionProducer(
 		orderlistener,
@@ -415,16 +498,12 @@ func (server *OrderServer) CreateOrder(ctx context.Context, req *proto.CreateOrd
 	}
 	topic := "order_reback"
 
-	// 一定要在这边生成订单号
-	createOrderParams := model.CreateOrderParams{
-		UserID:       req.UserID,
-		OrderID:      generate.GenerateOrderID(req.UserID),
-		Status:       1, // 1 待支付 2 成功 3 超时关闭
-		Address:      req.Address,
-		SignerName:   req.Name,
-		SignerMobile: req.Mobile,
-		Post:         req.Post,
+	createOrderParams, err := newCreateOrderParams(ctx, req)
+	if err != nil {
+		return &proto.OrderInfo{}, err
 	}
+	// 一定要在这边生成订单号
+	createOrderParams.OrderID = generate.GenerateOrderID(req.UserID)
 	jsonString, err := json.Marshal(createOrderParams)
 	if err != nil {
 		global.Logger.Error("序列化失败", zap.Error(err))
@@ -438,18 +517,34 @@ func (server *OrderServer) CreateOrder(ctx context.Context, req *proto.CreateOrd
 		),
 	)
 
-	if res.State == primitive.CommitMessageState {
-		return &proto.OrderInfo{}, status.Error(codes.Internal, "创建订单失败")
+	// 本地事务中失败的原因，比如优惠券不可用
+	// 本地事务失败时都会提交归还库存的消息，所以要在检查消息状态之前返回
+	if orderlistener.Code != codes.OK {
+		return &proto.OrderInfo{}, status.Error(orderlistener.Code, orderlistener.Detail)
 	}
-
 	if err != nil {
 		global.Logger.Error("发送消息失败", zap.Error(err))
 		return &proto.OrderInfo{}, status.Error(codes.Internal, "发送消息失败")
 	}
+	if res.State == primitive.CommitMessageState {
+		return &proto.OrderInfo{}, status.Error(codes.Internal, "创建订单失败")
+	}
 
 	return &proto.OrderInfo{
-		OrderID: createOrderParams.OrderID,
-		Total:   orderlistener.OrderAmount,
+		OrderID:         createOrderParams.OrderID,
+		Total:           float32(money.ToMajor(orderlistener.OrderAmount, orderlistener.Currency)),
+		GoodsTotal:      float32(money.ToMajor(orderlistener.GoodsAmount, orderlistener.Currency)),
+		Discount:        float32(money.ToMajor(orderlistener.DiscountAmount, orderlistener.Currency)),
+		CouponID:        createOrderParams.CouponID.Int64,
+		TotalCents:      orderlistener.OrderAmount,
+		GoodsTotalCents: orderlistener.GoodsAmount,
+		DiscountCents:   orderlistener.DiscountAmount,
+		Currency:        orderlistener.Currency,
+		ExchangeRate:    orderlistener.ExchangeRate,
+		ShippingCents:   orderlistener.ShippingFee,
+		ShippingRule:    orderlistener.ShippingRule,
+		TaxCents:        orderlistener.TaxAmount,
+		Taxes:           taxLines2Info(orderlistener.Taxes),
 	}, nil
 }
 
@@ -461,9 +556,11 @@ func (server *OrderServer) CreateOrder(ctx context.Context, req *proto.CreateOrd
 //  @param req
 //  @return *proto.GetOrderListResponse
 //  @return error
-//  todo 分页有问题，但是不影响由于没有后台管理可以不用管
 //
 func (server *OrderServer) GetOrderList(ctx context.Context, req *proto.GetOrderListRequest) (*proto.GetOrderListResponse, error) {
+	if err := auth.CheckCaller(ctx, req.UserID, auth.PermOrderReadAll); err != nil {
+		return &proto.GetOrderListResponse{}, err
+	}
 	arg := model.GetOrderListParams{}
 	arg.UserID = req.UserID
 	arg.Limit = req.PageSize
@@ -473,23 +570,16 @@ func (server *OrderServer) GetOrderList(ctx context.Context, req *proto.GetOrder
 		global.Logger.Error(err.Error())
 		return &proto.GetOrderListResponse{}, status.Error(codes.Internal, "未知错误")
 	}
+	total, err := server.Store.CountOrderList(ctx, req.UserID)
+	if err != nil {
+		global.Logger.Error(err.Error())
+		return &proto.GetOrderListResponse{}, status.Error(codes.Internal, "未知错误")
+	}
 	response := proto.GetOrderListResponse{}
-	response.Total = int32(len(orderList))
+	response.Total = int32(total)
 	responseDatas := make([]*proto.OrderInfo, 0)
 	for _, v := range orderList {
-		orderInfo := proto.OrderInfo{
-			Id:      int32(v.ID),
-			UserID:  v.UserID,
-			OrderID: v.OrderID,
-			PayType: v.PayType.String,
-			Status:  int32(v.Status),
-			Total:   float32(v.OrderMount.Float64),
-			Post:    v.Post,
-			Address: v.Post,
-			Name:    v.SignerName,
-			Mobile:  v.SignerMobile,
-		}
-		responseDatas = append(responseDatas, &orderInfo)
+		responseDatas = append(responseDatas, orderModel2Info(v))
 	}
 	response.Data = responseDatas
 
@@ -514,20 +604,25 @@ func (server *OrderServer) GetOrderDetail(ctx context.Context, req *proto.GetOrd
 		global.Logger.Error(err.Error())
 		return &proto.OrderDetailResponse{}, status.Error(codes.Internal, "内部错误")
 	}
-	OrderInfo := proto.OrderInfo{
-		Id:      int32(orderInfo.ID),
-		UserID:  orderInfo.UserID,
-		OrderID: orderInfo.OrderID,
-		PayType: orderInfo.PayType.String,
-		Status:  int32(orderInfo.Status),
-		Total:   float32(orderInfo.OrderMount.Float64),
-		Post:    orderInfo.Post,
-		Address: orderInfo.Post,
-		Name:    orderInfo.SignerName,
-		Mobile:  orderInfo.SignerMobile,
+	if err = auth.CheckCaller(ctx, orderInfo.UserID, auth.PermOrderReadAll); err != nil {
+		return &proto.OrderDetailResponse{}, err
 	}
 	response := proto.OrderDetailResponse{
-		OrderInfo: &OrderInfo,
+		OrderInfo: orderModel2Info(orderInfo),
+	}
+	// 获得订单的每一项税费
+	taxes, err := server.Store.GetOrderTaxes(ctx, orderInfo.OrderID)
+	if err != nil {
+		global.Logger.Error(err.Error())
+		return &proto.OrderDetailResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+	response.OrderInfo.Taxes = make([]*proto.TaxLine, 0, len(taxes))
+	for _, tax := range taxes {
+		response.OrderInfo.Taxes = append(response.OrderInfo.Taxes, &proto.TaxLine{
+			Name:        tax.Name,
+			Rate:        tax.Rate,
+			AmountCents: tax.Amount,
+		})
 	}
 	// 获得订单中包含的商品信息
 	orderGoods, err := server.Store.GetOrderListByOrderID(ctx, orderInfo.OrderID)
@@ -540,12 +635,15 @@ func (server *OrderServer) GetOrderDetail(ctx context.Context, req *proto.GetOrd
 	rspOrderGoods := make([]*proto.OrderGoods, 0)
 	for _, good := range orderGoods {
 		OrderGoods := proto.OrderGoods{
-			Id:         int32(good.ID),
-			OrderID:    good.OrderID,
-			GoodsID:    good.GoodsID,
-			GoodsName:  good.GoodsName,
-			GoodsPrice: float32(good.GoodsPrice),
-			GoodsNum:   good.Nums,
+			Id:              int32(good.ID),
+			OrderID:         good.OrderID,
+			GoodsID:         good.GoodsID,
+			GoodsName:       good.GoodsName,
+			GoodsPrice:      float32(money.ToMajor(good.GoodsPrice, orderInfo.Currency)),
+			GoodsNum:        good.Nums,
+			Discount:        float32(money.ToMajor(good.DiscountAmount, orderInfo.Currency)),
+			GoodsPriceCents: good.GoodsPrice,
+			DiscountCents:   good.DiscountAmount,
 		}
 		rspOrderGoods = append(rspOrderGoods, &OrderGoods)
 	}
@@ -554,7 +652,7 @@ func (server *OrderServer) GetOrderDetail(ctx context.Context, req *proto.GetOrd
 }
 
 // UpdateOrderStatus
-//  @Description: 更新订单状态
+//  @Description: 更新订单状态，需要 PermOrderWriteAll 权限
 //  @receiver server
 //  @param ctx
 //  @param req
@@ -562,6 +660,10 @@ func (server *OrderServer) GetOrderDetail(ctx context.Context, req *proto.GetOrd
 //  @return error
 //
 func (server *OrderServer) UpdateOrderStatus(ctx context.Context, req *proto.OrderInfo) (*proto.OrderInfo, error) {
+	// 修改订单的状态会核销优惠券、计入销售额，下单的用户自己也不能修改
+	if err := auth.CheckCallerPermission(ctx, auth.PermOrderWriteAll); err != nil {
+		return nil, err
+	}
 	_, err := server.Store.GetOrderDetail(ctx, req.OrderID)
 	if err == sql.ErrNoRows {
 		return nil, status.Error(codes.NotFound, "没有订单")
@@ -584,22 +686,69 @@ func (server *OrderServer) UpdateOrderStatus(ctx context.Context, req *proto.Ord
 		arg.OrderID = req.OrderID
 	}
 
-	orderInfo, err := server.Store.UpdateOrder(ctx, arg)
+	var orderInfo model.OrderInfo
+	err = server.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		orderInfo, err = queries.UpdateOrder(ctx, arg)
+		if err != nil {
+			return err
+		}
+		// 订单中没有优惠券就不用处理
+		if !orderInfo.CouponID.Valid {
+			return nil
+		}
+		switch orderInfo.Status {
+		case 2:
+			// 支付成功 核销优惠券
+			_, err = queries.UseUserCouponByOrderID(ctx, model.UseUserCouponByOrderIDParams{
+				UpdatedAt: time.Now(),
+				OrderID:   sql.NullInt64{Int64: orderInfo.OrderID, Valid: true},
+			})
+		case 3:
+			// 订单关闭 释放优惠券
+			_, err = queries.ReleaseUserCouponByOrderID(ctx, model.ReleaseUserCouponByOrderIDParams{
+				UpdatedAt: time.Now(),
+				OrderID:   sql.NullInt64{Int64: orderInfo.OrderID, Valid: true},
+			})
+		}
+		return err
+	})
 	if err != nil {
 		global.Logger.Error(err.Error())
 		return &proto.OrderInfo{}, status.Error(codes.Internal, "未知错误")
 	}
-	rsp := proto.OrderInfo{
-		Id:      int32(orderInfo.ID),
-		UserID:  orderInfo.UserID,
-		OrderID: orderInfo.OrderID,
-		PayType: orderInfo.PayType.String,
-		Status:  int32(orderInfo.Status),
-		Total:   float32(orderInfo.OrderMount.Float64),
-		Post:    orderInfo.Post,
-		Address: orderInfo.Post,
-		Name:    orderInfo.SignerName,
-		Mobile:  orderInfo.SignerMobile,
-	}
-	return &rsp, nil
+	return orderModel2Info(orderInfo), nil
+}
+
+//
+// orderModel2Info
+//  @Description: 订单的 model 转换为 proto，同时填充旧的 float 金额
+//  @param orderInfo
+//  @return *proto.OrderInfo
+//
+func orderModel2Info(orderInfo model.OrderInfo) *proto.OrderInfo {
+	return &proto.OrderInfo{
+		Id:              int32(orderInfo.ID),
+		UserID:          orderInfo.UserID,
+		OrderID:         orderInfo.OrderID,
+		PayType:         orderInfo.PayType.String,
+		Status:          int32(orderInfo.Status),
+		Total:           float32(money.ToMajor(orderInfo.OrderMount.Int64, orderInfo.Currency)),
+		Post:            orderInfo.Post,
+		Address:         orderInfo.Address,
+		Name:            orderInfo.SignerName,
+		Mobile:          orderInfo.SignerMobile,
+		GoodsTotal:      float32(money.ToMajor(orderInfo.GoodsAmount, orderInfo.Currency)),
+		Discount:        float32(money.ToMajor(orderInfo.DiscountAmount, orderInfo.Currency)),
+		CouponID:        orderInfo.CouponID.Int64,
+		TotalCents:      orderInfo.OrderMount.Int64,
+		GoodsTotalCents: orderInfo.GoodsAmount,
+		DiscountCents:   orderInfo.DiscountAmount,
+		Currency:        orderInfo.Currency,
+		ExchangeRate:    orderInfo.ExchangeRate,
+		ShippingCents:   orderInfo.ShippingFee,
+		ShippingRule:    orderInfo.ShippingRule,
+		TaxCents:        orderInfo.TaxAmount,
+		CancelReason:    orderInfo.CancelReason,
+		CreatedAt:       orderInfo.CreatedAt.Unix(),
+	}
 }
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/order/rpc/global"
+	"github.com/jimyag/shop/app/order/rpc/model"
+	"github.com/jimyag/shop/common/proto"
+)
+
+// 搜索订单的排序方式
+var orderSorts = map[string]bool{
+	"created_at_desc": true,
+	"created_at_asc":  true,
+	"amount_desc":     true,
+	"amount_asc":      true,
+}
+
+const maxSearchPageSize = 1000
+
+//
+// SearchOrders
+//  @Description: 后台按照条件搜索所有用户的订单，返回满足条件的订单总数
+//  @receiver server
+//  @param ctx
+//  @param req 条件为 0 或者空时不过滤
+//  @return *proto.GetOrderListResponse
+//  @return error
+//
+func (server *OrderServer) SearchOrders(ctx context.Context, req *proto.SearchOrdersRequest) (*proto.GetOrderListResponse, error) {
+	if req.PageNum <= 0 || req.PageSize <= 0 || req.PageSize > maxSearchPageSize {
+		return &proto.GetOrderListResponse{}, status.Error(codes.InvalidArgument, "分页参数错误")
+	}
+	if req.Sort == "" {
+		req.Sort = "created_at_desc"
+	} else if !orderSorts[req.Sort] {
+		return &proto.GetOrderListResponse{}, status.Error(codes.InvalidArgument, "不支持的排序方式")
+	}
+	if strings.Trim(req.OrderIDPrefix, "0123456789") != "" {
+		return &proto.GetOrderListResponse{}, status.Error(codes.InvalidArgument, "订单号只能是数字")
+	}
+	if req.MaxAmountCents > 0 && req.MinAmountCents > req.MaxAmountCents {
+		return &proto.GetOrderListResponse{}, status.Error(codes.InvalidArgument, "金额范围错误")
+	}
+
+	countArg := model.CountSearchOrdersParams{
+		Status:        int16(req.Status),
+		UserID:        req.UserID,
+		CreatedFrom:   time.Unix(req.CreatedFrom, 0),
+		CreatedTo:     time.Unix(req.CreatedTo, 0),
+		MinAmount:     req.MinAmountCents,
+		MaxAmount:     req.MaxAmountCents,
+		OrderIDPrefix: req.OrderIDPrefix,
+		GoodsID:       req.GoodsID,
+	}
+	// 没有结束时间就不限制
+	if req.CreatedTo <= 0 {
+		countArg.CreatedTo = time.Now().Add(time.Hour)
+	}
+	total, err := server.Store.CountSearchOrders(ctx, countArg)
+	if err != nil {
+		global.Logger.Error("统计订单数量失败", zap.Error(err))
+		return &proto.GetOrderListResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+
+	orderList, err := server.Store.SearchOrders(ctx, model.SearchOrdersParams{
+		Status:        countArg.Status,
+		UserID:        countArg.UserID,
+		CreatedFrom:   countArg.CreatedFrom,
+		CreatedTo:     countArg.CreatedTo,
+		MinAmount:     countArg.MinAmount,
+		MaxAmount:     countArg.MaxAmount,
+		OrderIDPrefix: countArg.OrderIDPrefix,
+		GoodsID:       countArg.GoodsID,
+		Sort:          req.Sort,
+		PageOffset:    (req.PageNum - 1) * req.PageSize,
+		PageSize:      req.PageSize,
+	})
+	if err != nil {
+		global.Logger.Error("搜索订单失败", zap.Error(err))
+		return &proto.GetOrderListResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+	response := proto.GetOrderListResponse{
+		Total: int32(total),
+		Data:  make([]*proto.OrderInfo, 0, len(orderList)),
+	}
+	for _, orderInfo := range orderList {
+		response.Data = append(response.Data, orderModel2Info(orderInfo))
+	}
+	return &response, nil
+}
@@ -3,10 +3,15 @@ package handler
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
+	"github.com/jimyag/shop/common/utils/uuid"
 )
 
 //
@@ -55,6 +60,139 @@ func TestOrderServer_UpdateCartItem(t *testing.T) {
 	require.Error(t, err)
 }
 
+func cartGoodsNums(rsp *proto.CartItemListResponse, goodsID int32) int32 {
+	for _, item := range rsp.GetData() {
+		if item.GoodsID == goodsID {
+			return item.Nums
+		}
+	}
+	return 0
+}
+
+func TestOrderServer_MergeGuestCart(t *testing.T) {
+	cartToken := uuid.GetUUid().String()
+	_, err := orderClient.CreateCartItem(context.Background(), &proto.CreateCartItemRequest{
+		CartToken: cartToken,
+		GoodsID:   5,
+		Nums:      2,
+		Checked:   true,
+	})
+	require.NoError(t, err)
+	guestCart, err := orderClient.CartItemList(context.Background(), &proto.CartItemListRequest{CartToken: cartToken})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), cartGoodsNums(guestCart, 5))
+
+	// 用户原来的购物车可能是空的
+	before, _ := orderClient.CartItemList(context.Background(), &proto.CartItemListRequest{Uid: 116})
+	merged, err := orderClient.MergeGuestCart(context.Background(), &proto.MergeGuestCartRequest{
+		UserID:    116,
+		CartToken: cartToken,
+	})
+	require.NoError(t, err)
+	require.Equal(t, cartGoodsNums(before, 5)+2, cartGoodsNums(merged, 5))
+
+	// 合并后游客的购物车被清空，重复合并不会重复添加
+	_, err = orderClient.CartItemList(context.Background(), &proto.CartItemListRequest{CartToken: cartToken})
+	require.Error(t, err)
+	again, err := orderClient.MergeGuestCart(context.Background(), &proto.MergeGuestCartRequest{
+		UserID:    116,
+		CartToken: cartToken,
+	})
+	require.NoError(t, err)
+	require.Equal(t, cartGoodsNums(merged, 5), cartGoodsNums(again, 5))
+
+	// 没有用户和购物车标识
+	_, err = orderClient.CartItemList(context.Background(), &proto.CartItemListRequest{})
+	require.Error(t, err)
+}
+
+func TestOrderServer_CartItemDetailList(t *testing.T) {
+	cartToken := uuid.GetUUid().String()
+	_, err := orderClient.CreateCartItem(context.Background(), &proto.CreateCartItemRequest{
+		CartToken: cartToken,
+		GoodsID:   5,
+		Nums:      2,
+		Checked:   true,
+	})
+	require.NoError(t, err)
+	detail, err := orderClient.CartItemDetailList(context.Background(), &proto.CartItemListRequest{CartToken: cartToken})
+	require.NoError(t, err)
+	require.Len(t, detail.Data, 1)
+	line := detail.Data[0]
+	require.Equal(t, int32(5), line.GoodsID)
+	require.NotEmpty(t, line.GoodsName)
+	require.Equal(t, line.PriceCents*2, line.AmountCents)
+	if !line.Deleted && !line.OutOfStock {
+		require.Equal(t, int32(2), detail.CheckedNums)
+		require.Equal(t, line.AmountCents, detail.CheckedTotalCents)
+	}
+
+	// 取消选中后不计算到小计中
+	_, err = orderClient.UpdateCartItem(context.Background(), &proto.UpdateCartItemRequest{
+		CartToken: cartToken,
+		GoodsID:   5,
+		Nums:      2,
+		Checked:   false,
+	})
+	require.NoError(t, err)
+	detail, err = orderClient.CartItemDetailList(context.Background(), &proto.CartItemListRequest{CartToken: cartToken})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), detail.CheckedNums)
+	require.Equal(t, int64(0), detail.CheckedTotalCents)
+
+	// 空的购物车
+	empty, err := orderClient.CartItemDetailList(context.Background(), &proto.CartItemListRequest{CartToken: uuid.GetUUid().String()})
+	require.NoError(t, err)
+	require.Empty(t, empty.Data)
+}
+
+func TestOrderServer_BatchCartItems(t *testing.T) {
+	cartToken := uuid.GetUUid().String()
+	_, err := orderClient.CreateCartItem(context.Background(), &proto.CreateCartItemRequest{
+		CartToken: cartToken,
+		GoodsID:   5,
+		Nums:      1,
+		Checked:   true,
+	})
+	require.NoError(t, err)
+
+	// 全不选
+	cart, err := orderClient.CheckCartItems(context.Background(), &proto.CheckCartItemsRequest{CartToken: cartToken})
+	require.NoError(t, err)
+	for _, item := range cart.Data {
+		require.False(t, item.Checked)
+	}
+	// 不在购物车中的商品整个操作都不会生效
+	_, err = orderClient.CheckCartItems(context.Background(), &proto.CheckCartItemsRequest{
+		CartToken: cartToken,
+		GoodsIDs:  []int32{5, 100000},
+		Checked:   true,
+	})
+	require.Error(t, err)
+	cart, err = orderClient.CartItemList(context.Background(), &proto.CartItemListRequest{CartToken: cartToken})
+	require.NoError(t, err)
+	require.False(t, cart.Data[0].Checked)
+
+	cart, err = orderClient.BatchUpdateCartNums(context.Background(), &proto.BatchUpdateCartNumsRequest{
+		CartToken: cartToken,
+		Items:     []*proto.CartItemNums{{GoodsID: 5, Nums: 3}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), cartGoodsNums(cart, 5))
+	_, err = orderClient.BatchUpdateCartNums(context.Background(), &proto.BatchUpdateCartNumsRequest{
+		CartToken: cartToken,
+		Items:     []*proto.CartItemNums{{GoodsID: 5, Nums: 0}},
+	})
+	require.Error(t, err)
+
+	cart, err = orderClient.BatchDeleteCartItems(context.Background(), &proto.BatchDeleteCartItemsRequest{
+		CartToken: cartToken,
+		GoodsIDs:  []int32{5, 5},
+	})
+	require.NoError(t, err)
+	require.Empty(t, cart.Data)
+}
+
 func TestOrderServer_CreateOrder(t *testing.T) {
 	order, err := orderClient.CreateOrder(context.Background(), &proto.CreateOrderRequest{
 		UserID:  116,
@@ -68,6 +206,346 @@ func TestOrderServer_CreateOrder(t *testing.T) {
 	t.Logf("%v", order)
 }
 
+func TestOrderServer_SearchOrders(t *testing.T) {
+	rsp, err := orderClient.SearchOrders(context.Background(), &proto.SearchOrdersRequest{
+		UserID:   116,
+		Sort:     "amount_desc",
+		PageNum:  1,
+		PageSize: 2,
+	})
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(rsp.Data), 2)
+	// total 是满足条件的订单总数，不是这一页的数量
+	require.GreaterOrEqual(t, int(rsp.Total), len(rsp.Data))
+	for i, order := range rsp.Data {
+		require.Equal(t, int32(116), order.UserID)
+		if i > 0 {
+			require.GreaterOrEqual(t, rsp.Data[i-1].TotalCents, order.TotalCents)
+		}
+	}
+
+	_, err = orderClient.SearchOrders(context.Background(), &proto.SearchOrdersRequest{
+		Sort:     "user_id",
+		PageNum:  1,
+		PageSize: 10,
+	})
+	require.Error(t, err)
+	_, err = orderClient.SearchOrders(context.Background(), &proto.SearchOrdersRequest{
+		OrderIDPrefix: "1' or '1'='1",
+		PageNum:       1,
+		PageSize:      10,
+	})
+	require.Error(t, err)
+}
+
+func TestOrderServer_SalesReport(t *testing.T) {
+	rsp, err := orderClient.SalesReport(context.Background(), &proto.SalesReportRequest{
+		FromDay: "2022-01-01",
+		ToDay:   "2022-12-31",
+	})
+	require.NoError(t, err)
+	for _, summary := range rsp.Summaries {
+		require.GreaterOrEqual(t, summary.Orders, summary.PaidOrders)
+	}
+
+	goods, err := orderClient.GoodsSalesReport(context.Background(), &proto.GoodsSalesRequest{
+		FromDay: "2022-01-01",
+		ToDay:   "2022-12-31",
+		Limit:   3,
+	})
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(goods.Data), 3)
+
+	// 结束日期早于开始日期
+	_, err = orderClient.SalesReport(context.Background(), &proto.SalesReportRequest{
+		FromDay: "2022-12-31",
+		ToDay:   "2022-01-01",
+	})
+	require.Error(t, err)
+}
+
+func TestOrderServer_CancelOrder(t *testing.T) {
+	_, err := orderClient.CreateCartItem(context.Background(), &proto.CreateCartItemRequest{
+		UserID:  116,
+		GoodsID: 5,
+		Nums:    1,
+		Checked: true,
+	})
+	require.NoError(t, err)
+	order, err := orderClient.CreateOrder(context.Background(), &proto.CreateOrderRequest{
+		UserID:    116,
+		AddressID: 1,
+	})
+	require.NoError(t, err)
+
+	// 只有下单的用户可以取消
+	_, err = orderClient.CancelOrder(context.Background(), &proto.CancelOrderRequest{
+		OrderID: order.OrderID,
+		UserID:  117,
+	})
+	require.Error(t, err)
+
+	cancelled, err := orderClient.CancelOrder(context.Background(), &proto.CancelOrderRequest{
+		OrderID:     order.OrderID,
+		UserID:      116,
+		Reason:      "不想要了",
+		RestoreCart: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), cancelled.Status)
+	require.Equal(t, "不想要了", cancelled.CancelReason)
+	cart, err := orderClient.CartItemList(context.Background(), &proto.CartItemListRequest{Uid: 116})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, cartGoodsNums(cart, 5), int32(1))
+
+	// 已经关闭的订单不能再取消
+	_, err = orderClient.CancelOrder(context.Background(), &proto.CancelOrderRequest{
+		OrderID: order.OrderID,
+		UserID:  116,
+	})
+	require.Error(t, err)
+}
+
+func TestOrderServer_CancelOrderReturnsStock(t *testing.T) {
+	before, err := inventoryClient.InvDetail(context.Background(), &proto.GoodInvInfo{GoodsId: 5})
+	require.NoError(t, err)
+	_, err = orderClient.CreateCartItem(context.Background(), &proto.CreateCartItemRequest{
+		UserID:  116,
+		GoodsID: 5,
+		Nums:    2,
+		Checked: true,
+	})
+	require.NoError(t, err)
+	order, err := orderClient.CreateOrder(context.Background(), &proto.CreateOrderRequest{
+		UserID:    116,
+		AddressID: 1,
+	})
+	require.NoError(t, err)
+	sold, err := inventoryClient.InvDetail(context.Background(), &proto.GoodInvInfo{GoodsId: 5})
+	require.NoError(t, err)
+	require.Less(t, sold.Num, before.Num)
+
+	_, err = orderClient.CancelOrder(context.Background(), &proto.CancelOrderRequest{
+		OrderID: order.OrderID,
+		UserID:  116,
+	})
+	require.NoError(t, err)
+	// 库存通过消息异步归还
+	require.Eventually(t, func() bool {
+		after, err := inventoryClient.InvDetail(context.Background(), &proto.GoodInvInfo{GoodsId: 5})
+		return err == nil && after.Num == before.Num
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestOrderServer_IssueInvoice(t *testing.T) {
+	_, err := orderClient.CreateCartItem(context.Background(), &proto.CreateCartItemRequest{
+		UserID:  116,
+		GoodsID: 5,
+		Nums:    1,
+		Checked: true,
+	})
+	require.NoError(t, err)
+	order, err := orderClient.CreateOrder(context.Background(), &proto.CreateOrderRequest{
+		UserID:    116,
+		AddressID: 1,
+	})
+	require.NoError(t, err)
+
+	// 未支付的订单不能开具发票
+	_, err = orderClient.IssueInvoice(context.Background(), &proto.IssueInvoiceRequest{
+		OrderID: order.OrderID,
+		UserID:  116,
+	})
+	require.Error(t, err)
+
+	_, err = orderClient.UpdateOrderStatus(context.Background(), &proto.OrderInfo{
+		UserID:  116,
+		OrderID: order.OrderID,
+		PayType: "支付宝",
+		Status:  2,
+	})
+	require.NoError(t, err)
+
+	// 还没有开具发票
+	_, err = orderClient.GetInvoice(context.Background(), &proto.GetInvoiceRequest{
+		OrderID: order.OrderID,
+		UserID:  116,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// 不是自己的订单
+	_, err = orderClient.IssueInvoice(context.Background(), &proto.IssueInvoiceRequest{
+		OrderID: order.OrderID,
+		UserID:  117,
+	})
+	require.Error(t, err)
+
+	invoice, err := orderClient.IssueInvoice(context.Background(), &proto.IssueInvoiceRequest{
+		OrderID:      order.OrderID,
+		UserID:       116,
+		BuyerCompany: "测试公司",
+		BuyerTaxID:   "91310000X",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, invoice.InvoiceNo)
+	require.NotEmpty(t, invoice.Lines)
+	require.Equal(t, "测试公司", invoice.BuyerCompany)
+
+	// 再次开具返回同一张发票
+	again, err := orderClient.IssueInvoice(context.Background(), &proto.IssueInvoiceRequest{
+		OrderID: order.OrderID,
+		UserID:  116,
+	})
+	require.NoError(t, err)
+	require.Equal(t, invoice.InvoiceNo, again.InvoiceNo)
+	require.Equal(t, "测试公司", again.BuyerCompany)
+
+	got, err := orderClient.GetInvoice(context.Background(), &proto.GetInvoiceRequest{
+		OrderID: order.OrderID,
+		UserID:  116,
+	})
+	require.NoError(t, err)
+	require.Equal(t, invoice.InvoiceNo, got.InvoiceNo)
+
+	// 不能获取其他用户的发票
+	_, err = orderClient.GetInvoice(context.Background(), &proto.GetInvoiceRequest{
+		OrderID: order.OrderID,
+		UserID:  117,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestOrderServer_CallerOwnership(t *testing.T) {
+	order, err := orderClient.CreateOrder(context.Background(), &proto.CreateOrderRequest{
+		UserID:    116,
+		AddressID: 1,
+	})
+	require.NoError(t, err)
+	otherCtx := auth.NewOutgoingContext(context.Background(), auth.Caller{UID: 117, Role: auth.RoleUser})
+	denied := func(err error) {
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	}
+
+	// 不能替其他用户下单，不能查看其他用户的订单
+	_, err = orderClient.CreateOrder(otherCtx, &proto.CreateOrderRequest{UserID: 116, AddressID: 1})
+	denied(err)
+	_, err = orderClient.GetOrderList(otherCtx, &proto.GetOrderListRequest{UserID: 116, PageNum: 1, PageSize: 10})
+	denied(err)
+	_, err = orderClient.GetOrderDetail(otherCtx, &proto.GetOrderDetailRequest{OrderID: order.OrderID})
+	denied(err)
+	// 不能查看其他用户的购物车和地址计算出的报价
+	_, err = orderClient.QuoteOrder(otherCtx, &proto.CreateOrderRequest{UserID: 116, AddressID: 1})
+	denied(err)
+	// 用户不能修改订单的状态
+	_, err = orderClient.UpdateOrderStatus(otherCtx, &proto.OrderInfo{OrderID: order.OrderID, PayType: "支付宝", Status: 2})
+	denied(err)
+
+	ownerCtx := auth.NewOutgoingContext(context.Background(), auth.Caller{UID: 116, Role: auth.RoleUser})
+	_, err = orderClient.GetOrderDetail(ownerCtx, &proto.GetOrderDetailRequest{OrderID: order.OrderID})
+	require.NoError(t, err)
+	_, err = orderClient.UpdateOrderStatus(ownerCtx, &proto.OrderInfo{OrderID: order.OrderID, PayType: "支付宝", Status: 2})
+	denied(err)
+	adminCtx := auth.NewOutgoingContext(context.Background(), auth.Caller{UID: 117, Role: auth.RoleAdmin, Permissions: []string{auth.PermOrderReadAll}})
+	_, err = orderClient.GetOrderList(adminCtx, &proto.GetOrderListRequest{UserID: 116, PageNum: 1, PageSize: 10})
+	require.NoError(t, err)
+}
+
+func TestOrderServer_CreateOrderWithAddress(t *testing.T) {
+	order, err := orderClient.CreateOrder(context.Background(), &proto.CreateOrderRequest{
+		UserID:    116,
+		AddressID: 1,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, order)
+
+	// 不存在的收货地址
+	_, err = orderClient.CreateOrder(context.Background(), &proto.CreateOrderRequest{
+		UserID:    116,
+		AddressID: 99999999,
+	})
+	require.Error(t, err)
+}
+
+func TestOrderServer_CreateOrderWithCoupon(t *testing.T) {
+	_, err := orderClient.CreateCartItem(context.Background(), &proto.CreateCartItemRequest{
+		UserID:  116,
+		GoodsID: 5,
+		Nums:    1,
+		Checked: true,
+	})
+	require.NoError(t, err)
+
+	// 不存在的优惠券不能下单
+	_, err = orderClient.CreateOrder(context.Background(), &proto.CreateOrderRequest{
+		UserID:    116,
+		AddressID: 1,
+		CouponID:  99999999,
+	})
+	require.Error(t, err)
+}
+
+func TestOrderServer_QuoteOrder(t *testing.T) {
+	_, err := orderClient.CreateCartItem(context.Background(), &proto.CreateCartItemRequest{
+		UserID:  116,
+		GoodsID: 5,
+		Nums:    2,
+		Checked: true,
+	})
+	require.NoError(t, err)
+
+	quote, err := orderClient.QuoteOrder(context.Background(), &proto.CreateOrderRequest{
+		UserID:  116,
+		Address: "上海市浦东新区",
+		Mobile:  "13800000000",
+		Name:    "jimyag",
+		Post:    "200120",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, quote.Goods)
+	require.NotEmpty(t, quote.ShippingRule)
+	require.Equal(t,
+		quote.GoodsTotalCents-quote.DiscountCents+quote.ShippingCents+quote.TaxCents,
+		quote.TotalCents,
+	)
+	var taxes int64
+	for _, tax := range quote.Taxes {
+		taxes += tax.AmountCents
+	}
+	require.Equal(t, quote.TaxCents, taxes)
+}
+
+func TestOrderServer_PreviewCheckout(t *testing.T) {
+	_, err := orderClient.CreateCartItem(context.Background(), &proto.CreateCartItemRequest{
+		UserID:  116,
+		GoodsID: 5,
+		Nums:    1,
+		Checked: true,
+	})
+	require.NoError(t, err)
+
+	preview, err := orderClient.PreviewCheckout(context.Background(), &proto.PreviewCheckoutRequest{UserID: 116})
+	require.NoError(t, err)
+	require.NotEmpty(t, preview.Lines)
+	var total int64
+	for _, line := range preview.Lines {
+		if line.GoodsID == 5 {
+			require.Equal(t, line.PriceCents, line.CartPriceCents)
+		}
+		if len(line.Warnings) == 0 {
+			total += line.AmountCents
+		}
+	}
+	require.Equal(t, total, preview.GoodsTotalCents)
+
+	// 不存在的商品不能加入购物车
+	_, err = orderClient.CreateCartItem(context.Background(), &proto.CreateCartItemRequest{
+		UserID:  116,
+		GoodsID: 99999999,
+		Nums:    1,
+	})
+	require.Error(t, err)
+}
+
 func TestOrderServer_GetOrderDetail(t *testing.T) {
 	rsp, err := orderClient.GetOrderDetail(context.Background(),
 		&proto.GetOrderDetailRequest{
@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/order/rpc/global"
+	"github.com/jimyag/shop/app/order/rpc/model"
+	"github.com/jimyag/shop/app/order/rpc/tools/pricing"
+	"github.com/jimyag/shop/app/order/rpc/tools/promotion"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
+	"github.com/jimyag/shop/common/utils/money"
+)
+
+//
+// orderQuote
+//  @Description: 订单的报价，报价和下单使用同样的计算过程
+//
+type orderQuote struct {
+	goodsInfos *proto.ManyGoodsInfos // 已经转换为订单币种的商品信息
+	goodsNums  map[int32]int32       // 每件商品的数量
+	promotion  *promotion.Result     // 商品金额和优惠
+	pricing    *pricing.Quote        // 运费和税费
+	total      int64                 // 需要支付的金额
+}
+
+//
+// QuoteOrder
+//  @Description: 下单前计算购物车中选中商品的金额、优惠、运费和税费，不会修改任何数据
+//  @receiver server
+//  @param ctx
+//  @param req 和新建订单的参数相同
+//  @return *proto.OrderQuote
+//  @return error
+//
+func (server *OrderServer) QuoteOrder(ctx context.Context, req *proto.CreateOrderRequest) (*proto.OrderQuote, error) {
+	if err := auth.CheckCaller(ctx, req.UserID, auth.PermOrderReadAll); err != nil {
+		return &proto.OrderQuote{}, err
+	}
+	createOrderParams, err := newCreateOrderParams(ctx, req)
+	if err != nil {
+		return &proto.OrderQuote{}, err
+	}
+
+	shoppingCart, err := server.Store.GetCartListChecked(ctx, model.GetCartListCheckedParams{
+		UserID:  req.UserID,
+		Checked: true,
+	})
+	if err != nil {
+		global.Logger.Error("获取购物车失败", zap.Error(err))
+		return &proto.OrderQuote{}, status.Error(codes.Internal, "获取购物车失败")
+	} else if len(shoppingCart) == 0 {
+		return &proto.OrderQuote{}, status.Error(codes.InvalidArgument, "购物车为空")
+	}
+
+	quote, err := server.quoteOrder(ctx, &createOrderParams, shoppingCart)
+	if err != nil {
+		return &proto.OrderQuote{}, err
+	}
+
+	response := proto.OrderQuote{
+		Currency:        quote.goodsInfos.Currency,
+		ExchangeRate:    quote.goodsInfos.ExchangeRate,
+		Goods:           make([]*proto.OrderGoods, 0, len(quote.goodsInfos.Data)),
+		GoodsTotalCents: quote.promotion.GoodsAmount,
+		DiscountCents:   quote.promotion.Discount,
+		ShippingCents:   quote.pricing.ShippingFee,
+		ShippingRule:    quote.pricing.ShippingRule,
+		TaxCents:        quote.pricing.TaxAmount,
+		Taxes:           taxLines2Info(quote.pricing.Taxes),
+		TotalCents:      quote.total,
+	}
+	for i, datum := range quote.goodsInfos.Data {
+		response.Goods = append(response.Goods, &proto.OrderGoods{
+			GoodsID:         datum.Id,
+			GoodsName:       datum.Name,
+			GoodsPrice:      float32(money.ToMajor(datum.PriceCents, quote.goodsInfos.Currency)),
+			GoodsNum:        quote.goodsNums[datum.Id],
+			Discount:        float32(money.ToMajor(quote.promotion.Lines[i].Discount, quote.goodsInfos.Currency)),
+			GoodsPriceCents: datum.PriceCents,
+			DiscountCents:   quote.promotion.Lines[i].Discount,
+		})
+	}
+	return &response, nil
+}
+
+//
+// newCreateOrderParams
+//  @Description: 根据请求生成订单的参数，使用用户服务中的收货地址时保存地址的快照，之后修改地址不会影响订单
+//  @param ctx
+//  @param req
+//  @return model.CreateOrderParams 没有订单号和金额
+//  @return error
+//
+func newCreateOrderParams(ctx context.Context, req *proto.CreateOrderRequest) (model.CreateOrderParams, error) {
+	createOrderParams := model.CreateOrderParams{
+		UserID:       req.UserID,
+		Status:       1, // 1 待支付 2 成功 3 超时关闭 4 已发货 5 已签收
+		Address:      req.Address,
+		SignerName:   req.Name,
+		SignerMobile: req.Mobile,
+		Post:         req.Post,
+		Currency:     money.NormalizeCurrency(req.Currency),
+	}
+	if req.CouponID != 0 {
+		createOrderParams.CouponID = sql.NullInt64{Int64: req.CouponID, Valid: true}
+	}
+	if req.AddressID != 0 {
+		address, err := global.UserClient.GetAddress(ctx, &proto.AddressRequest{
+			Id:     req.AddressID,
+			UserID: req.UserID,
+		})
+		if status.Code(err) == codes.NotFound {
+			return createOrderParams, status.Error(codes.InvalidArgument, "收货地址不存在")
+		} else if err != nil {
+			// 用户服务的错误原样返回 不能当作地址不存在
+			global.Logger.Error("获取收货地址失败", zap.Error(err))
+			return createOrderParams, err
+		}
+		createOrderParams.Address = address.Address
+		createOrderParams.SignerName = address.Name
+		createOrderParams.SignerMobile = address.Mobile
+		createOrderParams.Post = address.Post
+	}
+	return createOrderParams, nil
+}
+
+//
+// quoteOrder
+//  @Description: 计算订单的金额，同时把订单的币种、汇率和各项金额写入 createOrderParams
+//  @receiver server
+//  @param ctx
+//  @param createOrderParams
+//  @param shoppingCart 购物车中选中的商品
+//  @return *orderQuote
+//  @return error grpc 的错误
+//
+func (server *OrderServer) quoteOrder(ctx context.Context, createOrderParams *model.CreateOrderParams, shoppingCart []model.ShoppingCart) (*orderQuote, error) {
+	goodsIDS := make([]*proto.GoodID, 0, len(shoppingCart))
+	goodsNumMap := make(map[int32]int32)
+	for _, cart := range shoppingCart {
+		goodsIDS = append(goodsIDS, &proto.GoodID{Id: cart.GoodsID})
+		goodsNumMap[cart.GoodsID] = cart.Nums
+	}
+	// 商品的价格统一转换为订单的币种
+	goodsInfos, err := global.GoodsClient.GetGoodsBatchInfo(ctx, &proto.ManyGoodsID{
+		GoodsIDs: goodsIDS,
+		Currency: createOrderParams.Currency,
+	})
+	if status.Code(err) == codes.InvalidArgument {
+		return nil, err
+	} else if err != nil {
+		global.Logger.Error("获取商品信息失败", zap.Error(err))
+		return nil, status.Error(codes.Internal, "获取商品信息失败")
+	}
+	// 下单时再检查一次限购数量，不能通过合并购物车等方式绕过
+	for _, datum := range goodsInfos.Data {
+		if err = checkPurchaseLimit(datum, goodsNumMap[datum.Id]); err != nil {
+			return nil, err
+		}
+	}
+	// 保存下单时的汇率，之后汇率变化不会影响这个订单
+	createOrderParams.Currency = goodsInfos.Currency
+	createOrderParams.ExchangeRate = goodsInfos.ExchangeRate
+	// 优惠券和运费规则的金额是基准货币的
+	toOrderCurrency := func(amount int64) int64 {
+		return money.ConvertByRate(amount, goodsInfos.ExchangeRate, goodsInfos.BaseCurrency, goodsInfos.Currency)
+	}
+
+	// 使用了优惠券就要先检查优惠券是否可以使用
+	var rule *promotion.Rule
+	if createOrderParams.CouponID.Valid {
+		rule, err = server.getCouponRule(ctx, createOrderParams.UserID, createOrderParams.CouponID.Int64)
+		if err != nil {
+			return nil, err
+		}
+		rule.Amount = toOrderCurrency(rule.Amount)
+		rule.Threshold = toOrderCurrency(rule.Threshold)
+	}
+
+	// 计算订单的金额和每件商品分摊到的优惠
+	lines := make([]promotion.Line, 0, len(goodsInfos.Data))
+	input := pricing.Input{
+		Post:    createOrderParams.Post,
+		Address: createOrderParams.Address,
+	}
+	for _, datum := range goodsInfos.Data {
+		lines = append(lines, promotion.Line{
+			GoodsID: datum.Id,
+			Price:   datum.PriceCents,
+			Nums:    goodsNumMap[datum.Id],
+		})
+		input.Nums += int64(goodsNumMap[datum.Id])
+		input.Weight += int64(datum.Weight) * int64(goodsNumMap[datum.Id])
+	}
+	result, err := promotion.Evaluate(rule, lines)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	// 运费和税费按照优惠后的商品金额计算
+	input.GoodsAmount = result.PayAmount
+	quote, err := server.Pricing.Config().Convert(toOrderCurrency).Calculate(input)
+	if errors.Is(err, pricing.ErrNoShippingRule) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	} else if err != nil {
+		global.Logger.Error("计算运费失败", zap.Error(err))
+		return nil, status.Error(codes.Internal, "计算运费失败")
+	}
+
+	total := result.PayAmount + quote.ShippingFee + quote.TaxAmount
+	createOrderParams.OrderMount = sql.NullInt64{Int64: total, Valid: true}
+	createOrderParams.GoodsAmount = result.GoodsAmount
+	createOrderParams.DiscountAmount = result.Discount
+	createOrderParams.ShippingFee = quote.ShippingFee
+	createOrderParams.ShippingRule = quote.ShippingRule
+	createOrderParams.TaxAmount = quote.TaxAmount
+	return &orderQuote{
+		goodsInfos: goodsInfos,
+		goodsNums:  goodsNumMap,
+		promotion:  result,
+		pricing:    quote,
+		total:      total,
+	}, nil
+}
+
+func taxLines2Info(taxes []pricing.TaxLine) []*proto.TaxLine {
+	infos := make([]*proto.TaxLine, 0, len(taxes))
+	for _, tax := range taxes {
+		infos = append(infos, &proto.TaxLine{
+			Name:        tax.Name,
+			Rate:        tax.Rate,
+			AmountCents: tax.Amount,
+		})
+	}
+	return infos
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/order/rpc/global"
+	"github.com/jimyag/shop/app/order/rpc/model"
+	"github.com/jimyag/shop/app/order/rpc/tools/report"
+	"github.com/jimyag/shop/common/proto"
+)
+
+const (
+	reportDayLayout    = "2006-01-02"
+	maxReportDays      = 366 // 一次最多查询一年的数据
+	defaultReportLimit = 10
+	maxReportLimit     = 100
+)
+
+//
+// SalesReport
+//  @Description: 一段时间内每天的销售数据、每个币种的汇总和转化率
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.SalesReportResponse
+//  @return error
+//
+func (server *OrderServer) SalesReport(ctx context.Context, req *proto.SalesReportRequest) (*proto.SalesReportResponse, error) {
+	fromDay, toDay, err := parseReportDays(req.FromDay, req.ToDay)
+	if err != nil {
+		return &proto.SalesReportResponse{}, err
+	}
+	days, err := server.Store.GetSalesDaily(ctx, model.GetSalesDailyParams{FromDay: fromDay, ToDay: toDay})
+	if err != nil {
+		global.Logger.Error("获取销售报表失败", zap.Error(err))
+		return &proto.SalesReportResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+	conversions, err := server.Store.GetConversionDaily(ctx, model.GetConversionDailyParams{FromDay: fromDay, ToDay: toDay})
+	if err != nil {
+		global.Logger.Error("获取转化率失败", zap.Error(err))
+		return &proto.SalesReportResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+
+	response := proto.SalesReportResponse{
+		Days:      make([]*proto.SalesDay, 0, len(days)),
+		Summaries: make([]*proto.SalesSummary, 0),
+	}
+	for _, day := range days {
+		response.Days = append(response.Days, &proto.SalesDay{
+			Day:           day.Day.Format(reportDayLayout),
+			Currency:      day.Currency,
+			Orders:        day.OrderCount,
+			PaidOrders:    day.PaidOrderCount,
+			RevenueCents:  day.Revenue,
+			DiscountCents: day.Discount,
+		})
+	}
+	for _, summary := range report.Summarize(days) {
+		response.Summaries = append(response.Summaries, &proto.SalesSummary{
+			Currency:               summary.Currency,
+			Orders:                 summary.Orders,
+			PaidOrders:             summary.PaidOrders,
+			RevenueCents:           summary.Revenue,
+			DiscountCents:          summary.Discount,
+			AverageOrderValueCents: summary.AverageOrderValue,
+		})
+	}
+	response.CartUsers, response.OrderUsers, response.ConversionRate = report.Conversion(conversions)
+	return &response, nil
+}
+
+//
+// GoodsSalesReport
+//  @Description: 一段时间内每件商品的销量和销售额，按照销量或者销售额排序
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.GoodsSalesResponse
+//  @return error
+//
+func (server *OrderServer) GoodsSalesReport(ctx context.Context, req *proto.GoodsSalesRequest) (*proto.GoodsSalesResponse, error) {
+	fromDay, toDay, err := parseReportDays(req.FromDay, req.ToDay)
+	if err != nil {
+		return &proto.GoodsSalesResponse{}, err
+	}
+	if req.Sort == "" {
+		req.Sort = "nums"
+	} else if req.Sort != "nums" && req.Sort != "revenue" {
+		return &proto.GoodsSalesResponse{}, status.Error(codes.InvalidArgument, "不支持的排序方式")
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultReportLimit
+	} else if req.Limit > maxReportLimit {
+		req.Limit = maxReportLimit
+	}
+
+	goodsSales, err := server.Store.GetGoodsSales(ctx, model.GetGoodsSalesParams{
+		FromDay:  fromDay,
+		ToDay:    toDay,
+		Sort:     req.Sort,
+		RowLimit: req.Limit,
+	})
+	if err != nil {
+		global.Logger.Error("获取商品销售报表失败", zap.Error(err))
+		return &proto.GoodsSalesResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+	response := proto.GoodsSalesResponse{Data: make([]*proto.GoodsSales, 0, len(goodsSales))}
+	for _, goods := range goodsSales {
+		response.Data = append(response.Data, &proto.GoodsSales{
+			GoodsID:      goods.GoodsID,
+			GoodsName:    goods.GoodsName,
+			Currency:     goods.Currency,
+			Nums:         goods.Nums,
+			RevenueCents: goods.Revenue,
+		})
+	}
+	return &response, nil
+}
+
+//
+// parseReportDays
+//  @Description: 解析报表的日期范围
+//  @param from
+//  @param to
+//  @return time.Time
+//  @return time.Time
+//  @return error grpc 的错误
+//
+func parseReportDays(from, to string) (time.Time, time.Time, error) {
+	fromDay, err := time.Parse(reportDayLayout, from)
+	if err != nil {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "开始日期格式错误")
+	}
+	toDay, err := time.Parse(reportDayLayout, to)
+	if err != nil {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "结束日期格式错误")
+	}
+	if toDay.Before(fromDay) {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "结束日期不能早于开始日期")
+	}
+	if toDay.Sub(fromDay) >= maxReportDays*24*time.Hour {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "日期范围不能超过一年")
+	}
+	return fromDay, toDay, nil
+}
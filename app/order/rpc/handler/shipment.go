@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/order/rpc/global"
+	"github.com/jimyag/shop/app/order/rpc/model"
+	"github.com/jimyag/shop/common/proto"
+)
+
+// 订单的状态 1 待支付 2 成功 3 超时关闭 4 已发货 5 已签收
+const (
+	orderStatusPaid      int16 = 2
+	orderStatusClosed    int16 = 3
+	orderStatusShipped   int16 = 4
+	orderStatusDelivered int16 = 5
+)
+
+// 包裹的状态 1 待揽收 2 已揽收 3 运输中 4 已签收
+const (
+	shipmentStatusPickedUp  int16 = 2
+	shipmentStatusDelivered int16 = 4
+)
+
+//
+// CreateShipment
+//  @Description: 订单发货，已经支付的订单才能发货，一个订单可以分成多个包裹
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.ShipmentInfo
+//  @return error
+//
+func (server *OrderServer) CreateShipment(ctx context.Context, req *proto.CreateShipmentRequest) (*proto.ShipmentInfo, error) {
+	orderInfo, err := server.Store.GetOrderDetail(ctx, req.OrderID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.ShipmentInfo{}, status.Error(codes.NotFound, "没有找到该订单")
+	} else if err != nil {
+		global.Logger.Error("获取订单失败", zap.Error(err))
+		return &proto.ShipmentInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+	if orderInfo.Status != orderStatusPaid && orderInfo.Status != orderStatusShipped {
+		return &proto.ShipmentInfo{}, status.Error(codes.FailedPrecondition, "订单当前的状态不能发货")
+	}
+	_, err = server.Store.GetShipmentByTrackingNo(ctx, model.GetShipmentByTrackingNoParams{
+		Carrier:    req.Carrier,
+		TrackingNo: req.TrackingNo,
+	})
+	if err == nil {
+		return &proto.ShipmentInfo{}, status.Error(codes.AlreadyExists, "运单号已存在")
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		global.Logger.Error("获取物流信息失败", zap.Error(err))
+		return &proto.ShipmentInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+
+	var shipment model.Shipment
+	err = server.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		shipment, err = queries.CreateShipment(ctx, model.CreateShipmentParams{
+			OrderID:    req.OrderID,
+			Carrier:    req.Carrier,
+			TrackingNo: req.TrackingNo,
+		})
+		if err != nil {
+			return err
+		}
+		// 在事务中再检查一次订单的状态，检查之后订单可能被关闭了
+		_, err = queries.SetOrderStatus(ctx, model.SetOrderStatusParams{
+			UpdatedAt: time.Now(),
+			Status:    orderStatusShipped,
+			OrderID:   req.OrderID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return status.Error(codes.FailedPrecondition, "订单当前的状态不能发货")
+		}
+		return err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return &proto.ShipmentInfo{}, err
+		}
+		global.Logger.Error("创建物流信息失败", zap.Error(err))
+		return &proto.ShipmentInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+	return shipmentModel2Info(shipment, nil), nil
+}
+
+//
+// AddShipmentEvents
+//  @Description: 保存物流公司推送的物流事件，重复的事件会被忽略
+//  所有的包裹都签收之后订单的状态变为已签收
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.ShipmentInfo
+//  @return error
+//
+func (server *OrderServer) AddShipmentEvents(ctx context.Context, req *proto.ShipmentEventsRequest) (*proto.ShipmentInfo, error) {
+	shipment, err := server.Store.GetShipmentByTrackingNo(ctx, model.GetShipmentByTrackingNoParams{
+		Carrier:    req.Carrier,
+		TrackingNo: req.TrackingNo,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.ShipmentInfo{}, status.Error(codes.NotFound, "没有找到该运单")
+	} else if err != nil {
+		global.Logger.Error("获取物流信息失败", zap.Error(err))
+		return &proto.ShipmentInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+
+	// 包裹的状态只能前进，签收时间使用签收事件的时间
+	newStatus := shipment.Status
+	deliveredAt := shipment.DeliveredAt
+	for _, event := range req.Events {
+		eventStatus := int16(event.Status)
+		if eventStatus < shipmentStatusPickedUp || eventStatus > shipmentStatusDelivered {
+			return &proto.ShipmentInfo{}, status.Error(codes.InvalidArgument, "物流事件的状态不合法")
+		}
+		if eventStatus > newStatus {
+			newStatus = eventStatus
+		}
+		if eventStatus == shipmentStatusDelivered && !deliveredAt.Valid {
+			deliveredAt = sql.NullTime{Time: time.Unix(event.OccurredAt, 0), Valid: true}
+		}
+	}
+
+	err = server.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		for _, event := range req.Events {
+			_, err = queries.CreateShipmentEvent(ctx, model.CreateShipmentEventParams{
+				ShipmentID:  shipment.ID,
+				Status:      int16(event.Status),
+				Location:    event.Location,
+				Description: event.Description,
+				OccurredAt:  time.Unix(event.OccurredAt, 0),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if newStatus == shipment.Status {
+			return nil
+		}
+
+		shipment, err = queries.UpdateShipmentStatus(ctx, model.UpdateShipmentStatusParams{
+			UpdatedAt:   time.Now(),
+			Status:      newStatus,
+			DeliveredAt: deliveredAt,
+			ID:          shipment.ID,
+		})
+		if err != nil || newStatus != shipmentStatusDelivered {
+			return err
+		}
+
+		// 所有的包裹都签收了
+		undelivered, err := queries.CountUndeliveredShipments(ctx, shipment.OrderID)
+		if err != nil || undelivered > 0 {
+			return err
+		}
+		_, err = queries.SetOrderStatus(ctx, model.SetOrderStatusParams{
+			UpdatedAt: time.Now(),
+			Status:    orderStatusDelivered,
+			OrderID:   shipment.OrderID,
+		})
+		return err
+	})
+	if err != nil {
+		global.Logger.Error("保存物流事件失败", zap.Error(err))
+		return &proto.ShipmentInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+
+	events, err := server.Store.GetShipmentEvents(ctx, shipment.ID)
+	if err != nil {
+		global.Logger.Error("获取物流事件失败", zap.Error(err))
+		return &proto.ShipmentInfo{}, status.Error(codes.Internal, "内部错误")
+	}
+	return shipmentModel2Info(shipment, events), nil
+}
+
+//
+// GetOrderTracking
+//  @Description: 获取用户订单的所有包裹和物流事件
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.OrderTrackingResponse
+//  @return error
+//
+func (server *OrderServer) GetOrderTracking(ctx context.Context, req *proto.OrderTrackingRequest) (*proto.OrderTrackingResponse, error) {
+	orderInfo, err := server.Store.GetOrderDetail(ctx, req.OrderID)
+	// 不是自己的订单也当作不存在
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && orderInfo.UserID != req.UserID) {
+		return &proto.OrderTrackingResponse{}, status.Error(codes.NotFound, "没有找到该订单")
+	} else if err != nil {
+		global.Logger.Error("获取订单失败", zap.Error(err))
+		return &proto.OrderTrackingResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+
+	shipments, err := server.Store.GetShipmentsByOrderID(ctx, req.OrderID)
+	if err != nil {
+		global.Logger.Error("获取物流信息失败", zap.Error(err))
+		return &proto.OrderTrackingResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+	response := proto.OrderTrackingResponse{
+		OrderID:   orderInfo.OrderID,
+		Status:    int32(orderInfo.Status),
+		Shipments: make([]*proto.ShipmentInfo, 0, len(shipments)),
+	}
+	for _, shipment := range shipments {
+		events, err := server.Store.GetShipmentEvents(ctx, shipment.ID)
+		if err != nil {
+			global.Logger.Error("获取物流事件失败", zap.Error(err))
+			return &proto.OrderTrackingResponse{}, status.Error(codes.Internal, "内部错误")
+		}
+		response.Shipments = append(response.Shipments, shipmentModel2Info(shipment, events))
+	}
+	return &response, nil
+}
+
+func shipmentModel2Info(shipment model.Shipment, events []model.ShipmentEvent) *proto.ShipmentInfo {
+	info := proto.ShipmentInfo{
+		Id:         shipment.ID,
+		OrderID:    shipment.OrderID,
+		Carrier:    shipment.Carrier,
+		TrackingNo: shipment.TrackingNo,
+		Status:     int32(shipment.Status),
+		CreatedAt:  shipment.CreatedAt.Unix(),
+		Events:     make([]*proto.ShipmentEvent, 0, len(events)),
+	}
+	if shipment.DeliveredAt.Valid {
+		info.DeliveredAt = shipment.DeliveredAt.Time.Unix()
+	}
+	for _, event := range events {
+		info.Events = append(info.Events, &proto.ShipmentEvent{
+			Status:      int32(event.Status),
+			Location:    event.Location,
+			Description: event.Description,
+			OccurredAt:  event.OccurredAt.Unix(),
+		})
+	}
+	return &info
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/test_util"
+)
+
+func TestOrderServer_Shipment(t *testing.T) {
+	order, err := orderClient.CreateOrder(context.Background(), &proto.CreateOrderRequest{
+		UserID:    116,
+		AddressID: 1,
+	})
+	require.NoError(t, err)
+	_, err = orderClient.UpdateOrderStatus(context.Background(), &proto.OrderInfo{
+		OrderID: order.OrderID,
+		PayType: "已支付",
+		Status:  2,
+	})
+	require.NoError(t, err)
+
+	trackingNo := test_util.RandomString(12)
+	shipment, err := orderClient.CreateShipment(context.Background(), &proto.CreateShipmentRequest{
+		OrderID:    order.OrderID,
+		Carrier:    "mock",
+		TrackingNo: trackingNo,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), shipment.Status)
+
+	// 运单号不能重复
+	_, err = orderClient.CreateShipment(context.Background(), &proto.CreateShipmentRequest{
+		OrderID:    order.OrderID,
+		Carrier:    "mock",
+		TrackingNo: trackingNo,
+	})
+	require.Error(t, err)
+
+	now := time.Now().Unix()
+	events := &proto.ShipmentEventsRequest{
+		Carrier:    "mock",
+		TrackingNo: trackingNo,
+		Events: []*proto.ShipmentEvent{
+			{Status: 2, Location: "上海", OccurredAt: now - 7200},
+			{Status: 3, Location: "杭州", OccurredAt: now - 3600},
+			{Status: 4, Location: "杭州", OccurredAt: now},
+		},
+	}
+	shipment, err = orderClient.AddShipmentEvents(context.Background(), events)
+	require.NoError(t, err)
+	require.Equal(t, int32(4), shipment.Status)
+	require.Len(t, shipment.Events, 3)
+
+	// 重复推送的事件会被忽略
+	shipment, err = orderClient.AddShipmentEvents(context.Background(), events)
+	require.NoError(t, err)
+	require.Len(t, shipment.Events, 3)
+
+	tracking, err := orderClient.GetOrderTracking(context.Background(), &proto.OrderTrackingRequest{
+		UserID:  116,
+		OrderID: order.OrderID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(5), tracking.Status)
+	require.Len(t, tracking.Shipments, 1)
+
+	// 不能查看别人的订单
+	_, err = orderClient.GetOrderTracking(context.Background(), &proto.OrderTrackingRequest{
+		UserID:  117,
+		OrderID: order.OrderID,
+	})
+	require.Error(t, err)
+}
+
+func TestOrderServer_ShipClosedOrder(t *testing.T) {
+	order, err := orderClient.CreateOrder(context.Background(), &proto.CreateOrderRequest{
+		UserID:    116,
+		AddressID: 1,
+	})
+	require.NoError(t, err)
+	_, err = orderClient.CancelOrder(context.Background(), &proto.CancelOrderRequest{
+		OrderID: order.OrderID,
+		UserID:  116,
+	})
+	require.NoError(t, err)
+
+	// 关闭的订单不能发货
+	_, err = orderClient.CreateShipment(context.Background(), &proto.CreateShipmentRequest{
+		OrderID:    order.OrderID,
+		Carrier:    "mock",
+		TrackingNo: test_util.RandomString(12),
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
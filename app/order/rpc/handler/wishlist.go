@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/order/rpc/global"
+	"github.com/jimyag/shop/app/order/rpc/model"
+	"github.com/jimyag/shop/common/proto"
+)
+
+//
+// WishlistItemList
+//  @Description: 获取用户收藏的商品，已删除或不存在的商品会被标记而不是被过滤掉
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.WishlistItemListResponse
+//  @return error
+//
+func (server *OrderServer) WishlistItemList(ctx context.Context, req *proto.WishlistItemListRequest) (*proto.WishlistItemListResponse, error) {
+	wishlist, err := server.Store.GetWishlistByUid(ctx, req.GetUserID())
+	if err != nil {
+		global.Logger.Error("获取收藏列表失败", zap.Error(err))
+		return &proto.WishlistItemListResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+	response := proto.WishlistItemListResponse{
+		Total: int32(len(wishlist)),
+		Data:  make([]*proto.WishlistItemInfo, 0),
+	}
+	if len(wishlist) == 0 {
+		return &response, nil
+	}
+
+	// 批量获取商品信息
+	goodsIDs := make([]*proto.GoodID, 0)
+	for _, item := range wishlist {
+		goodsIDs = append(goodsIDs, &proto.GoodID{Id: item.GoodsID})
+	}
+	goodsInfos, err := global.GoodsClient.GetGoodsBatchInfo(ctx, &proto.ManyGoodsID{
+		GoodsIDs:    goodsIDs,
+		WithDeleted: true,
+	})
+	if err != nil {
+		global.Logger.Error("获取商品信息失败", zap.Error(err))
+		return &proto.WishlistItemListResponse{}, status.Error(codes.Internal, "获取商品信息失败")
+	}
+	goodsMap := make(map[int32]*proto.GoodsInfo)
+	for _, datum := range goodsInfos.Data {
+		goodsMap[datum.Id] = datum
+	}
+
+	for _, item := range wishlist {
+		info := proto.WishlistItemInfo{
+			Id:        int32(item.ID),
+			UserID:    item.UserID,
+			GoodsID:   item.GoodsID,
+			CreatedAt: item.CreatedAt.Unix(),
+		}
+		goods, ok := goodsMap[item.GoodsID]
+		if ok {
+			info.GoodsName = goods.Name
+			info.GoodsPrice = goods.Price
+			info.GoodsPriceCents = goods.PriceCents
+		}
+		// 商品被删除或者已经不存在了
+		info.GoodsInvalid = !ok || goods.Deleted
+		response.Data = append(response.Data, &info)
+	}
+	return &response, nil
+}
+
+//
+// CreateWishlistItem
+//  @Description: 收藏商品，重复收藏直接返回之前的记录
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.WishlistItemInfo
+//  @return error
+//
+func (server *OrderServer) CreateWishlistItem(ctx context.Context, req *proto.WishlistItemRequest) (*proto.WishlistItemInfo, error) {
+	goods, err := global.GoodsClient.GetGoods(ctx, &proto.GoodID{Id: req.GoodsID})
+	if err != nil {
+		return &proto.WishlistItemInfo{}, status.Error(codes.NotFound, "商品不存在")
+	}
+
+	item, err := server.Store.GetWishlistItem(ctx, model.GetWishlistItemParams{
+		UserID:  req.UserID,
+		GoodsID: req.GoodsID,
+	})
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			global.Logger.Error("查询收藏失败", zap.Error(err))
+			return &proto.WishlistItemInfo{}, status.Error(codes.Internal, "内部错误")
+		}
+		// 没有的话就新建
+		item, err = server.Store.CreateWishlistItem(ctx, model.CreateWishlistItemParams{
+			UserID:  req.UserID,
+			GoodsID: req.GoodsID,
+		})
+		if err != nil {
+			global.Logger.Error("创建收藏记录失败", zap.Error(err))
+			return &proto.WishlistItemInfo{}, status.Error(codes.Internal, "内部错误")
+		}
+	}
+
+	return &proto.WishlistItemInfo{
+		Id:              int32(item.ID),
+		UserID:          item.UserID,
+		GoodsID:         item.GoodsID,
+		GoodsName:       goods.Name,
+		GoodsPrice:      goods.Price,
+		GoodsPriceCents: goods.PriceCents,
+		CreatedAt:       item.CreatedAt.Unix(),
+	}, nil
+}
+
+//
+// DeleteWishlistItem
+//  @Description: 取消收藏
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.Empty
+//  @return error
+//
+func (server *OrderServer) DeleteWishlistItem(ctx context.Context, req *proto.WishlistItemRequest) (*proto.Empty, error) {
+	_, err := server.Store.DeleteWishlistItem(ctx, model.DeleteWishlistItemParams{
+		DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		UserID:    req.UserID,
+		GoodsID:   req.GoodsID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.Empty{}, status.Error(codes.NotFound, "没有该条记录")
+	} else if err != nil {
+		global.Logger.Error("删除收藏记录失败", zap.Error(err))
+		return &proto.Empty{}, status.Error(codes.Internal, "内部错误")
+	}
+	return &proto.Empty{}, nil
+}
+
+//
+// MoveWishlistItemToCart
+//  @Description: 将收藏的商品加入购物车并取消收藏，已删除的商品不能加入购物车
+//  @receiver server
+//  @param ctx
+//  @param req
+//  @return *proto.ShopCartInfoResponse
+//  @return error
+//
+func (server *OrderServer) MoveWishlistItemToCart(ctx context.Context, req *proto.MoveWishlistItemToCartRequest) (*proto.ShopCartInfoResponse, error) {
+	_, err := server.Store.GetWishlistItem(ctx, model.GetWishlistItemParams{
+		UserID:  req.UserID,
+		GoodsID: req.GoodsID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.ShopCartInfoResponse{}, status.Error(codes.NotFound, "没有该条记录")
+	} else if err != nil {
+		global.Logger.Error("查询收藏失败", zap.Error(err))
+		return &proto.ShopCartInfoResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+
+	// 只有商品不存在时才是失效 商品服务的其他错误原样返回
+	goods, err := currentGoods(ctx, req.GoodsID)
+	if status.Code(err) == codes.NotFound {
+		return &proto.ShopCartInfoResponse{}, status.Error(codes.FailedPrecondition, "商品已失效")
+	} else if err != nil {
+		return &proto.ShopCartInfoResponse{}, err
+	}
+
+	nums := req.Nums
+	if nums <= 0 {
+		nums = 1
+	}
+	// 加入购物车和取消收藏在同一个事务中 不会只完成一半
+	var cartInfo model.ShoppingCart
+	err = server.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		cartInfo, err = addCartItem(ctx, queries, model.ShoppingCart{
+			UserID:  req.UserID,
+			GoodsID: req.GoodsID,
+			Nums:    nums,
+			Checked: true,
+			Price:   goods.PriceCents,
+		})
+		if err != nil {
+			return err
+		}
+		if err = checkPurchaseLimit(goods, cartInfo.Nums); err != nil {
+			return err
+		}
+		_, err = queries.DeleteWishlistItem(ctx, model.DeleteWishlistItemParams{
+			DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			UserID:    req.UserID,
+			GoodsID:   req.GoodsID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return status.Error(codes.NotFound, "没有该条记录")
+		}
+		return err
+	})
+	if err != nil {
+		// 已经转换过的错误直接返回
+		if _, ok := status.FromError(err); ok {
+			return &proto.ShopCartInfoResponse{}, err
+		}
+		global.Logger.Error("收藏的商品移入购物车失败", zap.Error(err))
+		return &proto.ShopCartInfoResponse{}, status.Error(codes.Internal, "内部错误")
+	}
+	return cartModel2Info(cartInfo), nil
+}
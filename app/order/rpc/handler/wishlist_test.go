@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jimyag/shop/common/proto"
+)
+
+func TestOrderServer_CreateWishlistItem(t *testing.T) {
+	item, err := orderClient.CreateWishlistItem(context.Background(), &proto.WishlistItemRequest{
+		UserID:  116,
+		GoodsID: 5,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, item)
+	require.Equal(t, int32(5), item.GoodsID)
+
+	// 重复收藏返回之前的记录
+	again, err := orderClient.CreateWishlistItem(context.Background(), &proto.WishlistItemRequest{
+		UserID:  116,
+		GoodsID: 5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, item.Id, again.Id)
+
+	_, err = orderClient.CreateWishlistItem(context.Background(), &proto.WishlistItemRequest{
+		UserID:  116,
+		GoodsID: 9999999,
+	})
+	require.Error(t, err)
+}
+
+func TestOrderServer_WishlistItemList(t *testing.T) {
+	list, err := orderClient.WishlistItemList(context.Background(), &proto.WishlistItemListRequest{UserID: 116})
+	require.NoError(t, err)
+	require.True(t, len(list.Data) > 0)
+	require.Equal(t, int(list.Total), len(list.Data))
+	t.Log(list)
+}
+
+func TestOrderServer_MoveWishlistItemToCart(t *testing.T) {
+	_, err := orderClient.CreateWishlistItem(context.Background(), &proto.WishlistItemRequest{
+		UserID:  116,
+		GoodsID: 5,
+	})
+	require.NoError(t, err)
+
+	cartItem, err := orderClient.MoveWishlistItemToCart(context.Background(), &proto.MoveWishlistItemToCartRequest{
+		UserID:  116,
+		GoodsID: 5,
+		Nums:    1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(5), cartItem.GoodsID)
+
+	// 移入购物车之后就不在收藏中了
+	_, err = orderClient.DeleteWishlistItem(context.Background(), &proto.WishlistItemRequest{
+		UserID:  116,
+		GoodsID: 5,
+	})
+	require.Error(t, err)
+}
@@ -38,6 +38,20 @@ func InitConfigCenter() {
 //  @Description: 拉取远程配置中心的配置
 //
 func InitRemoteConfig() {
+	err := readRemoteConfig(&global.RemoteConfig)
+	if err != nil {
+		global.Logger.Fatal("加载远程配置文件失败", zap.Error(err))
+	}
+	global.Logger.Info("成功加载远程配置文件......", zap.Any("content", global.RemoteConfig))
+}
+
+//
+// readRemoteConfig
+//  @Description: 读取远程配置中心的配置
+//  @param rawVal
+//  @return error
+//
+func readRemoteConfig(rawVal interface{}) error {
 	v := viper.New()
 
 	path := global.ConfigCenter.ReleasePath
@@ -54,17 +68,17 @@ func InitRemoteConfig() {
 		path,
 	)
 	if err != nil {
-		global.Logger.Fatal("拉取远程配置文件失败", zap.Error(err))
+		return fmt.Errorf("拉取远程配置文件失败: %w", err)
 	}
 
 	err = v.ReadRemoteConfig()
 	if err != nil {
-		global.Logger.Fatal("读取远程配置文件失败", zap.Error(err))
+		return fmt.Errorf("读取远程配置文件失败: %w", err)
 	}
 
-	err = v.Unmarshal(&global.RemoteConfig)
+	err = v.Unmarshal(rawVal)
 	if err != nil {
-		global.Logger.Fatal("解析远程配置文件失败", zap.Error(err))
+		return fmt.Errorf("解析远程配置文件失败: %w", err)
 	}
-	global.Logger.Info("成功加载远程配置文件......", zap.Any("content", global.RemoteConfig))
+	return nil
 }
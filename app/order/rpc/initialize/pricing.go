@@ -0,0 +1,69 @@
+package initialize
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/order/rpc/config"
+	"github.com/jimyag/shop/app/order/rpc/global"
+	"github.com/jimyag/shop/app/order/rpc/tools/pricing"
+)
+
+//
+// InitPricing
+//  @Description: 初始化运费和税费的规则，并定时从配置中心拉取最新的规则
+//
+func InitPricing() {
+	global.Pricing = pricing.NewEngine(pricingConfig(global.RemoteConfig.Pricing))
+	global.Logger.Info("初始化运费和税费的规则成功......",
+		zap.Int("shipping", len(global.RemoteConfig.Pricing.Shipping)),
+		zap.Int("taxes", len(global.RemoteConfig.Pricing.Taxes)),
+	)
+
+	interval := global.RemoteConfig.Pricing.RefreshInterval
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(time.Duration(interval) * time.Second)
+		defer ticker.Stop()
+		for range ticker.C {
+			remoteConfig := config.ALLConfig{}
+			if err := readRemoteConfig(&remoteConfig); err != nil {
+				// 拉取失败时继续使用原来的规则
+				global.Logger.Error("刷新运费和税费的规则失败", zap.Error(err))
+				continue
+			}
+			global.Pricing.Update(pricingConfig(remoteConfig.Pricing))
+		}
+	}()
+}
+
+func pricingConfig(cfg config.PricingConfig) *pricing.Config {
+	result := &pricing.Config{
+		Shipping: make([]pricing.ShippingRule, 0, len(cfg.Shipping)),
+		Taxes:    make([]pricing.TaxRule, 0, len(cfg.Taxes)),
+	}
+	for _, rule := range cfg.Shipping {
+		result.Shipping = append(result.Shipping, pricing.ShippingRule{
+			Name:          rule.Name,
+			Regions:       rule.Regions,
+			Mode:          rule.Mode,
+			FirstUnits:    rule.FirstUnits,
+			FirstFee:      rule.FirstFee,
+			ExtraUnits:    rule.ExtraUnits,
+			ExtraFee:      rule.ExtraFee,
+			FreeThreshold: rule.FreeThreshold,
+		})
+	}
+	for _, tax := range cfg.Taxes {
+		result.Taxes = append(result.Taxes, pricing.TaxRule{
+			Name:            tax.Name,
+			Rate:            tax.Rate,
+			Regions:         tax.Regions,
+			IncludeShipping: tax.IncludeShipping,
+		})
+	}
+	return result
+}
@@ -0,0 +1,39 @@
+package initialize
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/order/rpc/global"
+	"github.com/jimyag/shop/app/order/rpc/model"
+	"github.com/jimyag/shop/app/order/rpc/tools/report"
+)
+
+//
+// InitReport
+//  @Description: 启动后台任务定时增量刷新销售报表的汇总表，每个副本都会启动，同一时间只有一个副本刷新
+//  @param store
+//
+func InitReport(store model.Store) {
+	interval := global.RemoteConfig.Report.RefreshInterval
+	if interval <= 0 {
+		global.Logger.Info("没有配置销售报表的刷新间隔，不刷新销售报表")
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(time.Duration(interval) * time.Second)
+		defer ticker.Stop()
+		for {
+			days, err := report.Refresh(context.Background(), store)
+			if err != nil {
+				// 下一次刷新时会重新计算
+				global.Logger.Error("刷新销售报表失败", zap.Error(err))
+			} else if days > 0 {
+				global.Logger.Info("刷新销售报表成功", zap.Int("days", days))
+			}
+			<-ticker.C
+		}
+	}()
+}
@@ -20,6 +20,7 @@ import (
 func InitGrpcClient() {
 	initGoodsClient()
 	initInventoryClient()
+	initUserClient()
 }
 
 //
@@ -77,3 +78,31 @@ func initInventoryClient() {
 	global.InventoryClient = proto.NewInventoryClient(conn)
 	global.Logger.Info("发现Inventory服务......")
 }
+
+//
+// initUserClient
+//  @Description: 初始化 user client
+//
+func initUserClient() {
+	conn, err := grpc.Dial(
+		fmt.Sprintf("%s://%s:%d/%s?wait=14s",
+			global.ConfigCenter.Type,
+			global.ConfigCenter.Host,
+			global.ConfigCenter.Port,
+			global.RemoteConfig.ThirdServer.UserGrpcServer.Name,
+		),
+		grpc.WithInsecure(),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+		grpc.WithUnaryInterceptor(
+			otgrpc.OpenTracingClientInterceptor(
+				opentracing.GlobalTracer(),
+			),
+		),
+	)
+
+	if err != nil {
+		global.Logger.Fatal("User服务发现错误", zap.Error(err))
+	}
+	global.UserClient = proto.NewUserClient(conn)
+	global.Logger.Info("发现User服务......")
+}
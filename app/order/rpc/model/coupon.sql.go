@@ -0,0 +1,327 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: coupon.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const countUserCouponByTemplate = `-- name: CountUserCouponByTemplate :one
+SELECT count(*)
+FROM "user_coupon"
+WHERE user_id = $1
+  and template_id = $2
+  and deleted_at IS NULL
+`
+
+type CountUserCouponByTemplateParams struct {
+	UserID     int32 `json:"user_id"`
+	TemplateID int64 `json:"template_id"`
+}
+
+func (q *Queries) CountUserCouponByTemplate(ctx context.Context, arg CountUserCouponByTemplateParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUserCouponByTemplate, arg.UserID, arg.TemplateID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createCouponTemplate = `-- name: CreateCouponTemplate :one
+INSERT INTO "coupon_template"(name, type, amount, rate, threshold, goods_id, total, per_user_limit, valid_from, valid_to)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+returning id, created_at, updated_at, deleted_at, name, type, amount, rate, threshold, goods_id, total, issued, per_user_limit, valid_from, valid_to
+`
+
+type CreateCouponTemplateParams struct {
+	Name         string    `json:"name"`
+	Type         int16     `json:"type"`
+	Amount       int64     `json:"amount"`
+	Rate         float64   `json:"rate"`
+	Threshold    int64     `json:"threshold"`
+	GoodsID      int32     `json:"goods_id"`
+	Total        int32     `json:"total"`
+	PerUserLimit int32     `json:"per_user_limit"`
+	ValidFrom    time.Time `json:"valid_from"`
+	ValidTo      time.Time `json:"valid_to"`
+}
+
+func (q *Queries) CreateCouponTemplate(ctx context.Context, arg CreateCouponTemplateParams) (CouponTemplate, error) {
+	row := q.db.QueryRowContext(ctx, createCouponTemplate,
+		arg.Name,
+		arg.Type,
+		arg.Amount,
+		arg.Rate,
+		arg.Threshold,
+		arg.GoodsID,
+		arg.Total,
+		arg.PerUserLimit,
+		arg.ValidFrom,
+		arg.ValidTo,
+	)
+	var i CouponTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Name,
+		&i.Type,
+		&i.Amount,
+		&i.Rate,
+		&i.Threshold,
+		&i.GoodsID,
+		&i.Total,
+		&i.Issued,
+		&i.PerUserLimit,
+		&i.ValidFrom,
+		&i.ValidTo,
+	)
+	return i, err
+}
+
+const createUserCoupon = `-- name: CreateUserCoupon :one
+INSERT INTO "user_coupon"(user_id, template_id)
+VALUES ($1, $2)
+returning id, created_at, updated_at, deleted_at, user_id, template_id, status, order_id, used_at
+`
+
+type CreateUserCouponParams struct {
+	UserID     int32 `json:"user_id"`
+	TemplateID int64 `json:"template_id"`
+}
+
+func (q *Queries) CreateUserCoupon(ctx context.Context, arg CreateUserCouponParams) (UserCoupon, error) {
+	row := q.db.QueryRowContext(ctx, createUserCoupon, arg.UserID, arg.TemplateID)
+	var i UserCoupon
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.UserID,
+		&i.TemplateID,
+		&i.Status,
+		&i.OrderID,
+		&i.UsedAt,
+	)
+	return i, err
+}
+
+const getCouponTemplate = `-- name: GetCouponTemplate :one
+SELECT id, created_at, updated_at, deleted_at, name, type, amount, rate, threshold, goods_id, total, issued, per_user_limit, valid_from, valid_to
+FROM "coupon_template"
+WHERE id = $1
+  and deleted_at IS NULL
+`
+
+func (q *Queries) GetCouponTemplate(ctx context.Context, id int64) (CouponTemplate, error) {
+	row := q.db.QueryRowContext(ctx, getCouponTemplate, id)
+	var i CouponTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Name,
+		&i.Type,
+		&i.Amount,
+		&i.Rate,
+		&i.Threshold,
+		&i.GoodsID,
+		&i.Total,
+		&i.Issued,
+		&i.PerUserLimit,
+		&i.ValidFrom,
+		&i.ValidTo,
+	)
+	return i, err
+}
+
+const getUserCoupon = `-- name: GetUserCoupon :one
+SELECT id, created_at, updated_at, deleted_at, user_id, template_id, status, order_id, used_at
+FROM "user_coupon"
+WHERE id = $1
+  and user_id = $2
+  and deleted_at IS NULL
+`
+
+type GetUserCouponParams struct {
+	ID     int64 `json:"id"`
+	UserID int32 `json:"user_id"`
+}
+
+func (q *Queries) GetUserCoupon(ctx context.Context, arg GetUserCouponParams) (UserCoupon, error) {
+	row := q.db.QueryRowContext(ctx, getUserCoupon, arg.ID, arg.UserID)
+	var i UserCoupon
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.UserID,
+		&i.TemplateID,
+		&i.Status,
+		&i.OrderID,
+		&i.UsedAt,
+	)
+	return i, err
+}
+
+const getUserCouponList = `-- name: GetUserCouponList :many
+SELECT id, created_at, updated_at, deleted_at, user_id, template_id, status, order_id, used_at
+FROM "user_coupon"
+WHERE user_id = $1
+  and deleted_at IS NULL
+order by id desc
+`
+
+func (q *Queries) GetUserCouponList(ctx context.Context, userID int32) ([]UserCoupon, error) {
+	rows, err := q.db.QueryContext(ctx, getUserCouponList, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserCoupon
+	for rows.Next() {
+		var i UserCoupon
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.UserID,
+			&i.TemplateID,
+			&i.Status,
+			&i.OrderID,
+			&i.UsedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const issueCouponTemplate = `-- name: IssueCouponTemplate :one
+UPDATE "coupon_template"
+set updated_at = $1,
+    issued     = issued + 1
+where id = $2
+  and (total = 0 or issued < total)
+  and deleted_at IS NULL
+returning id, created_at, updated_at, deleted_at, name, type, amount, rate, threshold, goods_id, total, issued, per_user_limit, valid_from, valid_to
+`
+
+type IssueCouponTemplateParams struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	ID        int64     `json:"id"`
+}
+
+func (q *Queries) IssueCouponTemplate(ctx context.Context, arg IssueCouponTemplateParams) (CouponTemplate, error) {
+	row := q.db.QueryRowContext(ctx, issueCouponTemplate, arg.UpdatedAt, arg.ID)
+	var i CouponTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Name,
+		&i.Type,
+		&i.Amount,
+		&i.Rate,
+		&i.Threshold,
+		&i.GoodsID,
+		&i.Total,
+		&i.Issued,
+		&i.PerUserLimit,
+		&i.ValidFrom,
+		&i.ValidTo,
+	)
+	return i, err
+}
+
+const lockUserCoupon = `-- name: LockUserCoupon :execrows
+UPDATE "user_coupon"
+set updated_at = $1,
+    status     = 2,
+    order_id   = $2
+where id = $3
+  and user_id = $4
+  and status = 1
+  and deleted_at IS NULL
+`
+
+type LockUserCouponParams struct {
+	UpdatedAt time.Time     `json:"updated_at"`
+	OrderID   sql.NullInt64 `json:"order_id"`
+	ID        int64         `json:"id"`
+	UserID    int32         `json:"user_id"`
+}
+
+func (q *Queries) LockUserCoupon(ctx context.Context, arg LockUserCouponParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, lockUserCoupon,
+		arg.UpdatedAt,
+		arg.OrderID,
+		arg.ID,
+		arg.UserID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const releaseUserCouponByOrderID = `-- name: ReleaseUserCouponByOrderID :execrows
+UPDATE "user_coupon"
+set updated_at = $1,
+    status     = 1,
+    order_id   = null
+where order_id = $2
+  and status = 2
+  and deleted_at IS NULL
+`
+
+type ReleaseUserCouponByOrderIDParams struct {
+	UpdatedAt time.Time     `json:"updated_at"`
+	OrderID   sql.NullInt64 `json:"order_id"`
+}
+
+func (q *Queries) ReleaseUserCouponByOrderID(ctx context.Context, arg ReleaseUserCouponByOrderIDParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, releaseUserCouponByOrderID, arg.UpdatedAt, arg.OrderID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const useUserCouponByOrderID = `-- name: UseUserCouponByOrderID :execrows
+UPDATE "user_coupon"
+set updated_at = $1,
+    status     = 3,
+    used_at    = $1
+where order_id = $2
+  and status = 2
+  and deleted_at IS NULL
+`
+
+type UseUserCouponByOrderIDParams struct {
+	UpdatedAt time.Time     `json:"updated_at"`
+	OrderID   sql.NullInt64 `json:"order_id"`
+}
+
+func (q *Queries) UseUserCouponByOrderID(ctx context.Context, arg UseUserCouponByOrderIDParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, useUserCouponByOrderID, arg.UpdatedAt, arg.OrderID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,221 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: invoice.sql
+
+package model
+
+import (
+	"context"
+)
+
+const createInvoice = `-- name: CreateInvoice :one
+INSERT INTO "invoice"(invoice_no,
+                      year,
+                      number,
+                      order_id,
+                      user_id,
+                      buyer_name,
+                      buyer_mobile,
+                      buyer_address,
+                      buyer_post,
+                      buyer_company,
+                      buyer_tax_id,
+                      currency,
+                      goods_amount,
+                      discount_amount,
+                      shipping_fee,
+                      tax_amount,
+                      total_amount)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+returning id, created_at, invoice_no, year, number, order_id, user_id, buyer_name, buyer_mobile, buyer_address, buyer_post, buyer_company, buyer_tax_id, currency, goods_amount, discount_amount, shipping_fee, tax_amount, total_amount
+`
+
+type CreateInvoiceParams struct {
+	InvoiceNo      string `json:"invoice_no"`
+	Year           int32  `json:"year"`
+	Number         int32  `json:"number"`
+	OrderID        int64  `json:"order_id"`
+	UserID         int32  `json:"user_id"`
+	BuyerName      string `json:"buyer_name"`
+	BuyerMobile    string `json:"buyer_mobile"`
+	BuyerAddress   string `json:"buyer_address"`
+	BuyerPost      string `json:"buyer_post"`
+	BuyerCompany   string `json:"buyer_company"`
+	BuyerTaxID     string `json:"buyer_tax_id"`
+	Currency       string `json:"currency"`
+	GoodsAmount    int64  `json:"goods_amount"`
+	DiscountAmount int64  `json:"discount_amount"`
+	ShippingFee    int64  `json:"shipping_fee"`
+	TaxAmount      int64  `json:"tax_amount"`
+	TotalAmount    int64  `json:"total_amount"`
+}
+
+func (q *Queries) CreateInvoice(ctx context.Context, arg CreateInvoiceParams) (Invoice, error) {
+	row := q.db.QueryRowContext(ctx, createInvoice,
+		arg.InvoiceNo,
+		arg.Year,
+		arg.Number,
+		arg.OrderID,
+		arg.UserID,
+		arg.BuyerName,
+		arg.BuyerMobile,
+		arg.BuyerAddress,
+		arg.BuyerPost,
+		arg.BuyerCompany,
+		arg.BuyerTaxID,
+		arg.Currency,
+		arg.GoodsAmount,
+		arg.DiscountAmount,
+		arg.ShippingFee,
+		arg.TaxAmount,
+		arg.TotalAmount,
+	)
+	var i Invoice
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.InvoiceNo,
+		&i.Year,
+		&i.Number,
+		&i.OrderID,
+		&i.UserID,
+		&i.BuyerName,
+		&i.BuyerMobile,
+		&i.BuyerAddress,
+		&i.BuyerPost,
+		&i.BuyerCompany,
+		&i.BuyerTaxID,
+		&i.Currency,
+		&i.GoodsAmount,
+		&i.DiscountAmount,
+		&i.ShippingFee,
+		&i.TaxAmount,
+		&i.TotalAmount,
+	)
+	return i, err
+}
+
+const createInvoiceLine = `-- name: CreateInvoiceLine :one
+INSERT INTO "invoice_line"(invoice_id, goods_id, goods_name, price, nums, discount_amount, amount)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+returning id, invoice_id, goods_id, goods_name, price, nums, discount_amount, amount
+`
+
+type CreateInvoiceLineParams struct {
+	InvoiceID      int64  `json:"invoice_id"`
+	GoodsID        int32  `json:"goods_id"`
+	GoodsName      string `json:"goods_name"`
+	Price          int64  `json:"price"`
+	Nums           int32  `json:"nums"`
+	DiscountAmount int64  `json:"discount_amount"`
+	Amount         int64  `json:"amount"`
+}
+
+func (q *Queries) CreateInvoiceLine(ctx context.Context, arg CreateInvoiceLineParams) (InvoiceLine, error) {
+	row := q.db.QueryRowContext(ctx, createInvoiceLine,
+		arg.InvoiceID,
+		arg.GoodsID,
+		arg.GoodsName,
+		arg.Price,
+		arg.Nums,
+		arg.DiscountAmount,
+		arg.Amount,
+	)
+	var i InvoiceLine
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceID,
+		&i.GoodsID,
+		&i.GoodsName,
+		&i.Price,
+		&i.Nums,
+		&i.DiscountAmount,
+		&i.Amount,
+	)
+	return i, err
+}
+
+const getInvoiceByOrderID = `-- name: GetInvoiceByOrderID :one
+SELECT id, created_at, invoice_no, year, number, order_id, user_id, buyer_name, buyer_mobile, buyer_address, buyer_post, buyer_company, buyer_tax_id, currency, goods_amount, discount_amount, shipping_fee, tax_amount, total_amount
+FROM "invoice"
+WHERE order_id = $1
+`
+
+func (q *Queries) GetInvoiceByOrderID(ctx context.Context, orderID int64) (Invoice, error) {
+	row := q.db.QueryRowContext(ctx, getInvoiceByOrderID, orderID)
+	var i Invoice
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.InvoiceNo,
+		&i.Year,
+		&i.Number,
+		&i.OrderID,
+		&i.UserID,
+		&i.BuyerName,
+		&i.BuyerMobile,
+		&i.BuyerAddress,
+		&i.BuyerPost,
+		&i.BuyerCompany,
+		&i.BuyerTaxID,
+		&i.Currency,
+		&i.GoodsAmount,
+		&i.DiscountAmount,
+		&i.ShippingFee,
+		&i.TaxAmount,
+		&i.TotalAmount,
+	)
+	return i, err
+}
+
+const getInvoiceLines = `-- name: GetInvoiceLines :many
+SELECT id, invoice_id, goods_id, goods_name, price, nums, discount_amount, amount
+FROM "invoice_line"
+WHERE invoice_id = $1
+ORDER BY id
+`
+
+func (q *Queries) GetInvoiceLines(ctx context.Context, invoiceID int64) ([]InvoiceLine, error) {
+	rows, err := q.db.QueryContext(ctx, getInvoiceLines, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InvoiceLine
+	for rows.Next() {
+		var i InvoiceLine
+		if err := rows.Scan(
+			&i.ID,
+			&i.InvoiceID,
+			&i.GoodsID,
+			&i.GoodsName,
+			&i.Price,
+			&i.Nums,
+			&i.DiscountAmount,
+			&i.Amount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const nextInvoiceNumber = `-- name: NextInvoiceNumber :one
+INSERT INTO "invoice_sequence"(year, last_number)
+VALUES ($1, 1)
+ON CONFLICT (year) DO UPDATE SET last_number = invoice_sequence.last_number + 1
+returning last_number
+`
+
+func (q *Queries) NextInvoiceNumber(ctx context.Context, year int32) (int32, error) {
+	row := q.db.QueryRowContext(ctx, nextInvoiceNumber, year)
+	var last_number int32
+	err := row.Scan(&last_number)
+	return last_number, err
+}
@@ -7,34 +7,164 @@ import (
 	"time"
 )
 
+type ConversionDaily struct {
+	Day        time.Time `json:"day"`
+	CartUsers  int32     `json:"cart_users"`
+	OrderUsers int32     `json:"order_users"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type CouponTemplate struct {
+	ID           int64        `json:"id"`
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+	DeletedAt    sql.NullTime `json:"deleted_at"`
+	Name         string       `json:"name"`
+	Type         int16        `json:"type"`
+	Amount       int64        `json:"amount"`
+	Rate         float64      `json:"rate"`
+	Threshold    int64        `json:"threshold"`
+	GoodsID      int32        `json:"goods_id"`
+	Total        int32        `json:"total"`
+	Issued       int32        `json:"issued"`
+	PerUserLimit int32        `json:"per_user_limit"`
+	ValidFrom    time.Time    `json:"valid_from"`
+	ValidTo      time.Time    `json:"valid_to"`
+}
+
+type Invoice struct {
+	ID             int64     `json:"id"`
+	CreatedAt      time.Time `json:"created_at"`
+	InvoiceNo      string    `json:"invoice_no"`
+	Year           int32     `json:"year"`
+	Number         int32     `json:"number"`
+	OrderID        int64     `json:"order_id"`
+	UserID         int32     `json:"user_id"`
+	BuyerName      string    `json:"buyer_name"`
+	BuyerMobile    string    `json:"buyer_mobile"`
+	BuyerAddress   string    `json:"buyer_address"`
+	BuyerPost      string    `json:"buyer_post"`
+	BuyerCompany   string    `json:"buyer_company"`
+	BuyerTaxID     string    `json:"buyer_tax_id"`
+	Currency       string    `json:"currency"`
+	GoodsAmount    int64     `json:"goods_amount"`
+	DiscountAmount int64     `json:"discount_amount"`
+	ShippingFee    int64     `json:"shipping_fee"`
+	TaxAmount      int64     `json:"tax_amount"`
+	TotalAmount    int64     `json:"total_amount"`
+}
+
+type InvoiceLine struct {
+	ID             int64  `json:"id"`
+	InvoiceID      int64  `json:"invoice_id"`
+	GoodsID        int32  `json:"goods_id"`
+	GoodsName      string `json:"goods_name"`
+	Price          int64  `json:"price"`
+	Nums           int32  `json:"nums"`
+	DiscountAmount int64  `json:"discount_amount"`
+	Amount         int64  `json:"amount"`
+}
+
+type InvoiceSequence struct {
+	Year       int32 `json:"year"`
+	LastNumber int32 `json:"last_number"`
+}
+
 type OrderGood struct {
-	ID         int64        `json:"id"`
-	CreatedAt  time.Time    `json:"created_at"`
-	UpdatedAt  time.Time    `json:"updated_at"`
-	DeletedAt  sql.NullTime `json:"deleted_at"`
-	OrderID    int64        `json:"order_id"`
-	GoodsID    int32        `json:"goods_id"`
-	GoodsName  string       `json:"goods_name"`
-	GoodsPrice float64      `json:"goods_price"`
-	Nums       int32        `json:"nums"`
+	ID             int64        `json:"id"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+	DeletedAt      sql.NullTime `json:"deleted_at"`
+	OrderID        int64        `json:"order_id"`
+	GoodsID        int32        `json:"goods_id"`
+	GoodsName      string       `json:"goods_name"`
+	GoodsPrice     int64        `json:"goods_price"`
+	Nums           int32        `json:"nums"`
+	DiscountAmount int64        `json:"discount_amount"`
 }
 
 type OrderInfo struct {
-	ID           int64           `json:"id"`
-	CreatedAt    time.Time       `json:"created_at"`
-	UpdatedAt    time.Time       `json:"updated_at"`
-	DeletedAt    sql.NullTime    `json:"deleted_at"`
-	UserID       int32           `json:"user_id"`
-	OrderID      int64           `json:"order_id"`
-	PayType      sql.NullString  `json:"pay_type"`
-	Status       int16           `json:"status"`
-	TradeID      sql.NullString  `json:"trade_id"`
-	OrderMount   sql.NullFloat64 `json:"order_mount"`
-	PayTime      sql.NullTime    `json:"pay_time"`
-	Address      string          `json:"address"`
-	SignerName   string          `json:"signer_name"`
-	SignerMobile string          `json:"signer_mobile"`
-	Post         string          `json:"post"`
+	ID             int64          `json:"id"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	DeletedAt      sql.NullTime   `json:"deleted_at"`
+	UserID         int32          `json:"user_id"`
+	OrderID        int64          `json:"order_id"`
+	PayType        sql.NullString `json:"pay_type"`
+	Status         int16          `json:"status"`
+	TradeID        sql.NullString `json:"trade_id"`
+	OrderMount     sql.NullInt64  `json:"order_mount"`
+	PayTime        sql.NullTime   `json:"pay_time"`
+	Address        string         `json:"address"`
+	SignerName     string         `json:"signer_name"`
+	SignerMobile   string         `json:"signer_mobile"`
+	Post           string         `json:"post"`
+	CouponID       sql.NullInt64  `json:"coupon_id"`
+	GoodsAmount    int64          `json:"goods_amount"`
+	DiscountAmount int64          `json:"discount_amount"`
+	Currency       string         `json:"currency"`
+	ExchangeRate   float64        `json:"exchange_rate"`
+	ShippingFee    int64          `json:"shipping_fee"`
+	ShippingRule   string         `json:"shipping_rule"`
+	TaxAmount      int64          `json:"tax_amount"`
+	CancelReason   string         `json:"cancel_reason"`
+	CancelledAt    sql.NullTime   `json:"cancelled_at"`
+}
+
+type OrderTax struct {
+	ID        int64     `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	OrderID   int64     `json:"order_id"`
+	Name      string    `json:"name"`
+	Rate      float64   `json:"rate"`
+	Amount    int64     `json:"amount"`
+}
+
+type ReportRefresh struct {
+	Name           string    `json:"name"`
+	RefreshedUntil time.Time `json:"refreshed_until"`
+}
+
+type SalesDaily struct {
+	Day            time.Time `json:"day"`
+	Currency       string    `json:"currency"`
+	OrderCount     int32     `json:"order_count"`
+	PaidOrderCount int32     `json:"paid_order_count"`
+	Revenue        int64     `json:"revenue"`
+	Discount       int64     `json:"discount"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type SalesGoodsDaily struct {
+	Day       time.Time `json:"day"`
+	GoodsID   int32     `json:"goods_id"`
+	Currency  string    `json:"currency"`
+	GoodsName string    `json:"goods_name"`
+	Nums      int32     `json:"nums"`
+	Revenue   int64     `json:"revenue"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Shipment struct {
+	ID          int64        `json:"id"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+	DeletedAt   sql.NullTime `json:"deleted_at"`
+	OrderID     int64        `json:"order_id"`
+	Carrier     string       `json:"carrier"`
+	TrackingNo  string       `json:"tracking_no"`
+	Status      int16        `json:"status"`
+	DeliveredAt sql.NullTime `json:"delivered_at"`
+}
+
+type ShipmentEvent struct {
+	ID          int64     `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	ShipmentID  int64     `json:"shipment_id"`
+	Status      int16     `json:"status"`
+	Location    string    `json:"location"`
+	Description string    `json:"description"`
+	OccurredAt  time.Time `json:"occurred_at"`
 }
 
 type ShoppingCart struct {
@@ -46,4 +176,27 @@ type ShoppingCart struct {
 	GoodsID   int32        `json:"goods_id"`
 	Nums      int32        `json:"nums"`
 	Checked   bool         `json:"checked"`
+	Price     int64        `json:"price"`
+	CartToken string       `json:"cart_token"`
+}
+
+type UserCoupon struct {
+	ID         int64         `json:"id"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+	DeletedAt  sql.NullTime  `json:"deleted_at"`
+	UserID     int32         `json:"user_id"`
+	TemplateID int64         `json:"template_id"`
+	Status     int16         `json:"status"`
+	OrderID    sql.NullInt64 `json:"order_id"`
+	UsedAt     sql.NullTime  `json:"used_at"`
+}
+
+type Wishlist struct {
+	ID        int64        `json:"id"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+	DeletedAt sql.NullTime `json:"deleted_at"`
+	UserID    int32        `json:"user_id"`
+	GoodsID   int32        `json:"goods_id"`
 }
@@ -7,27 +7,149 @@ import (
 	"context"
 	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
+const cancelOrder = `-- name: CancelOrder :one
+UPDATE "order_info"
+set updated_at    = $1,
+    status        = 3,
+    cancel_reason = $2,
+    cancelled_at  = $1
+where order_id = $3
+  and user_id = $4
+  and status = 1
+  and deleted_at IS NULL
+returning id, created_at, updated_at, deleted_at, user_id, order_id, pay_type, status, trade_id, order_mount, pay_time, address, signer_name, signer_mobile, post, coupon_id, goods_amount, discount_amount, currency, exchange_rate, shipping_fee, shipping_rule, tax_amount, cancel_reason, cancelled_at
+`
+
+type CancelOrderParams struct {
+	CancelledAt  time.Time `json:"cancelled_at"`
+	CancelReason string    `json:"cancel_reason"`
+	OrderID      int64     `json:"order_id"`
+	UserID       int32     `json:"user_id"`
+}
+
+func (q *Queries) CancelOrder(ctx context.Context, arg CancelOrderParams) (OrderInfo, error) {
+	row := q.db.QueryRowContext(ctx, cancelOrder,
+		arg.CancelledAt,
+		arg.CancelReason,
+		arg.OrderID,
+		arg.UserID,
+	)
+	var i OrderInfo
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.UserID,
+		&i.OrderID,
+		&i.PayType,
+		&i.Status,
+		&i.TradeID,
+		&i.OrderMount,
+		&i.PayTime,
+		&i.Address,
+		&i.SignerName,
+		&i.SignerMobile,
+		&i.Post,
+		&i.CouponID,
+		&i.GoodsAmount,
+		&i.DiscountAmount,
+		&i.Currency,
+		&i.ExchangeRate,
+		&i.ShippingFee,
+		&i.ShippingRule,
+		&i.TaxAmount,
+		&i.CancelReason,
+		&i.CancelledAt,
+	)
+	return i, err
+}
+
+const countOrderList = `-- name: CountOrderList :one
+SELECT count(*)
+FROM "order_info"
+WHERE user_id = $1 and deleted_at IS  NULL
+`
+
+func (q *Queries) CountOrderList(ctx context.Context, userID int32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countOrderList, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSearchOrders = `-- name: CountSearchOrders :one
+SELECT count(*)
+FROM "order_info"
+WHERE deleted_at IS NULL
+  and ($1::int2 = 0 or status = $1::int2)
+  and ($2::int4 = 0 or user_id = $2::int4)
+  and created_at >= $3::timestamptz
+  and created_at < $4::timestamptz
+  and coalesce(order_mount, 0) >= $5::int8
+  and ($6::int8 = 0 or coalesce(order_mount, 0) <= $6::int8)
+  and order_id::text LIKE $7::text || '%'
+  and ($8::int4 = 0 or exists(SELECT 1
+                                               FROM "order_goods"
+                                               WHERE order_goods.order_id = order_info.order_id
+                                                 and order_goods.goods_id = $8::int4
+                                                 and order_goods.deleted_at IS NULL))
+`
+
+type CountSearchOrdersParams struct {
+	Status        int16     `json:"status"`
+	UserID        int32     `json:"user_id"`
+	CreatedFrom   time.Time `json:"created_from"`
+	CreatedTo     time.Time `json:"created_to"`
+	MinAmount     int64     `json:"min_amount"`
+	MaxAmount     int64     `json:"max_amount"`
+	OrderIDPrefix string    `json:"order_id_prefix"`
+	GoodsID       int32     `json:"goods_id"`
+}
+
+func (q *Queries) CountSearchOrders(ctx context.Context, arg CountSearchOrdersParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSearchOrders,
+		arg.Status,
+		arg.UserID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.OrderIDPrefix,
+		arg.GoodsID,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createCart = `-- name: CreateCart :one
-INSERT INTO "shopping_cart"(user_id, goods_id, nums, checked)
-VALUES ($1, $2, $3, $4)
-returning id, created_at, updated_at, deleted_at, user_id, goods_id, nums, checked
+INSERT INTO "shopping_cart"(user_id, cart_token, goods_id, nums, checked, price)
+VALUES ($1, $2, $3, $4, $5, $6)
+returning id, created_at, updated_at, deleted_at, user_id, goods_id, nums, checked, price, cart_token
 `
 
 type CreateCartParams struct {
-	UserID  int32 `json:"user_id"`
-	GoodsID int32 `json:"goods_id"`
-	Nums    int32 `json:"nums"`
-	Checked bool  `json:"checked"`
+	UserID    int32  `json:"user_id"`
+	CartToken string `json:"cart_token"`
+	GoodsID   int32  `json:"goods_id"`
+	Nums      int32  `json:"nums"`
+	Checked   bool   `json:"checked"`
+	Price     int64  `json:"price"`
 }
 
 func (q *Queries) CreateCart(ctx context.Context, arg CreateCartParams) (ShoppingCart, error) {
 	row := q.db.QueryRowContext(ctx, createCart,
 		arg.UserID,
+		arg.CartToken,
 		arg.GoodsID,
 		arg.Nums,
 		arg.Checked,
+		arg.Price,
 	)
 	var i ShoppingCart
 	err := row.Scan(
@@ -39,6 +161,8 @@ func (q *Queries) CreateCart(ctx context.Context, arg CreateCartParams) (Shoppin
 		&i.GoodsID,
 		&i.Nums,
 		&i.Checked,
+		&i.Price,
+		&i.CartToken,
 	)
 	return i, err
 }
@@ -51,20 +175,36 @@ INSERT INTO "order_info"(user_id,
                          address,
                          signer_name,
                          signer_mobile,
-                         post)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-returning id, created_at, updated_at, deleted_at, user_id, order_id, pay_type, status, trade_id, order_mount, pay_time, address, signer_name, signer_mobile, post
+                         post,
+                         coupon_id,
+                         goods_amount,
+                         discount_amount,
+                         currency,
+                         exchange_rate,
+                         shipping_fee,
+                         shipping_rule,
+                         tax_amount)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+returning id, created_at, updated_at, deleted_at, user_id, order_id, pay_type, status, trade_id, order_mount, pay_time, address, signer_name, signer_mobile, post, coupon_id, goods_amount, discount_amount, currency, exchange_rate, shipping_fee, shipping_rule, tax_amount, cancel_reason, cancelled_at
 `
 
 type CreateOrderParams struct {
-	UserID       int32           `json:"user_id"`
-	OrderID      int64           `json:"order_id"`
-	Status       int16           `json:"status"`
-	OrderMount   sql.NullFloat64 `json:"order_mount"`
-	Address      string          `json:"address"`
-	SignerName   string          `json:"signer_name"`
-	SignerMobile string          `json:"signer_mobile"`
-	Post         string          `json:"post"`
+	UserID         int32         `json:"user_id"`
+	OrderID        int64         `json:"order_id"`
+	Status         int16         `json:"status"`
+	OrderMount     sql.NullInt64 `json:"order_mount"`
+	Address        string        `json:"address"`
+	SignerName     string        `json:"signer_name"`
+	SignerMobile   string        `json:"signer_mobile"`
+	Post           string        `json:"post"`
+	CouponID       sql.NullInt64 `json:"coupon_id"`
+	GoodsAmount    int64         `json:"goods_amount"`
+	DiscountAmount int64         `json:"discount_amount"`
+	Currency       string        `json:"currency"`
+	ExchangeRate   float64       `json:"exchange_rate"`
+	ShippingFee    int64         `json:"shipping_fee"`
+	ShippingRule   string        `json:"shipping_rule"`
+	TaxAmount      int64         `json:"tax_amount"`
 }
 
 func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (OrderInfo, error) {
@@ -77,6 +217,14 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order
 		arg.SignerName,
 		arg.SignerMobile,
 		arg.Post,
+		arg.CouponID,
+		arg.GoodsAmount,
+		arg.DiscountAmount,
+		arg.Currency,
+		arg.ExchangeRate,
+		arg.ShippingFee,
+		arg.ShippingRule,
+		arg.TaxAmount,
 	)
 	var i OrderInfo
 	err := row.Scan(
@@ -95,22 +243,33 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order
 		&i.SignerName,
 		&i.SignerMobile,
 		&i.Post,
+		&i.CouponID,
+		&i.GoodsAmount,
+		&i.DiscountAmount,
+		&i.Currency,
+		&i.ExchangeRate,
+		&i.ShippingFee,
+		&i.ShippingRule,
+		&i.TaxAmount,
+		&i.CancelReason,
+		&i.CancelledAt,
 	)
 	return i, err
 }
 
 const createOrderGoods = `-- name: CreateOrderGoods :one
-INSERT INTO "order_goods"(ORDER_ID, GOODS_ID, GOODS_NAME, GOODS_PRICE, NUMS)
-VALUES ($1, $2, $3, $4, $5)
-returning id, created_at, updated_at, deleted_at, order_id, goods_id, goods_name, goods_price, nums
+INSERT INTO "order_goods"(ORDER_ID, GOODS_ID, GOODS_NAME, GOODS_PRICE, NUMS, DISCOUNT_AMOUNT)
+VALUES ($1, $2, $3, $4, $5, $6)
+returning id, created_at, updated_at, deleted_at, order_id, goods_id, goods_name, goods_price, nums, discount_amount
 `
 
 type CreateOrderGoodsParams struct {
-	OrderID    int64   `json:"order_id"`
-	GoodsID    int32   `json:"goods_id"`
-	GoodsName  string  `json:"goods_name"`
-	GoodsPrice float64 `json:"goods_price"`
-	Nums       int32   `json:"nums"`
+	OrderID        int64  `json:"order_id"`
+	GoodsID        int32  `json:"goods_id"`
+	GoodsName      string `json:"goods_name"`
+	GoodsPrice     int64  `json:"goods_price"`
+	Nums           int32  `json:"nums"`
+	DiscountAmount int64  `json:"discount_amount"`
 }
 
 func (q *Queries) CreateOrderGoods(ctx context.Context, arg CreateOrderGoodsParams) (OrderGood, error) {
@@ -120,6 +279,7 @@ func (q *Queries) CreateOrderGoods(ctx context.Context, arg CreateOrderGoodsPara
 		arg.GoodsName,
 		arg.GoodsPrice,
 		arg.Nums,
+		arg.DiscountAmount,
 	)
 	var i OrderGood
 	err := row.Scan(
@@ -132,6 +292,7 @@ func (q *Queries) CreateOrderGoods(ctx context.Context, arg CreateOrderGoodsPara
 		&i.GoodsName,
 		&i.GoodsPrice,
 		&i.Nums,
+		&i.DiscountAmount,
 	)
 	return i, err
 }
@@ -140,18 +301,25 @@ const deleteCartItem = `-- name: DeleteCartItem :one
 UPDATE "shopping_cart"
 set deleted_at =$1
 where user_id = $2
-  and goods_id = $3 and deleted_at IS  NULL
-returning id, created_at, updated_at, deleted_at, user_id, goods_id, nums, checked
+  and cart_token = $3
+  and goods_id = $4 and deleted_at IS  NULL
+returning id, created_at, updated_at, deleted_at, user_id, goods_id, nums, checked, price, cart_token
 `
 
 type DeleteCartItemParams struct {
 	DeletedAt sql.NullTime `json:"deleted_at"`
 	UserID    int32        `json:"user_id"`
+	CartToken string       `json:"cart_token"`
 	GoodsID   int32        `json:"goods_id"`
 }
 
 func (q *Queries) DeleteCartItem(ctx context.Context, arg DeleteCartItemParams) (ShoppingCart, error) {
-	row := q.db.QueryRowContext(ctx, deleteCartItem, arg.DeletedAt, arg.UserID, arg.GoodsID)
+	row := q.db.QueryRowContext(ctx, deleteCartItem,
+		arg.DeletedAt,
+		arg.UserID,
+		arg.CartToken,
+		arg.GoodsID,
+	)
 	var i ShoppingCart
 	err := row.Scan(
 		&i.ID,
@@ -162,24 +330,78 @@ func (q *Queries) DeleteCartItem(ctx context.Context, arg DeleteCartItemParams)
 		&i.GoodsID,
 		&i.Nums,
 		&i.Checked,
+		&i.Price,
+		&i.CartToken,
 	)
 	return i, err
 }
 
+const deleteCartItemsByGoodsIDs = `-- name: DeleteCartItemsByGoodsIDs :execrows
+UPDATE "shopping_cart"
+set deleted_at = $1
+where user_id = $2
+  and cart_token = $3
+  and goods_id = ANY ($4::int[])
+  and deleted_at IS  NULL
+`
+
+type DeleteCartItemsByGoodsIDsParams struct {
+	DeletedAt sql.NullTime `json:"deleted_at"`
+	UserID    int32        `json:"user_id"`
+	CartToken string       `json:"cart_token"`
+	GoodsIds  []int32      `json:"goods_ids"`
+}
+
+func (q *Queries) DeleteCartItemsByGoodsIDs(ctx context.Context, arg DeleteCartItemsByGoodsIDsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteCartItemsByGoodsIDs,
+		arg.DeletedAt,
+		arg.UserID,
+		arg.CartToken,
+		pq.Array(arg.GoodsIds),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteGuestCart = `-- name: DeleteGuestCart :execrows
+UPDATE "shopping_cart"
+set deleted_at = $1
+where user_id = 0
+  and cart_token = $2
+  and deleted_at IS NULL
+`
+
+type DeleteGuestCartParams struct {
+	DeletedAt sql.NullTime `json:"deleted_at"`
+	CartToken string       `json:"cart_token"`
+}
+
+func (q *Queries) DeleteGuestCart(ctx context.Context, arg DeleteGuestCartParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteGuestCart, arg.DeletedAt, arg.CartToken)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getCartDetailByUIDAndGoodsID = `-- name: GetCartDetailByUIDAndGoodsID :one
-SELECT id, created_at, updated_at, deleted_at, user_id, goods_id, nums, checked
+SELECT id, created_at, updated_at, deleted_at, user_id, goods_id, nums, checked, price, cart_token
 FROM "shopping_cart"
 where user_id = $1
-  and goods_id = $2 and deleted_at IS  NULL
+  and cart_token = $2
+  and goods_id = $3 and deleted_at IS  NULL
 `
 
 type GetCartDetailByUIDAndGoodsIDParams struct {
-	UserID  int32 `json:"user_id"`
-	GoodsID int32 `json:"goods_id"`
+	UserID    int32  `json:"user_id"`
+	CartToken string `json:"cart_token"`
+	GoodsID   int32  `json:"goods_id"`
 }
 
 func (q *Queries) GetCartDetailByUIDAndGoodsID(ctx context.Context, arg GetCartDetailByUIDAndGoodsIDParams) (ShoppingCart, error) {
-	row := q.db.QueryRowContext(ctx, getCartDetailByUIDAndGoodsID, arg.UserID, arg.GoodsID)
+	row := q.db.QueryRowContext(ctx, getCartDetailByUIDAndGoodsID, arg.UserID, arg.CartToken, arg.GoodsID)
 	var i ShoppingCart
 	err := row.Scan(
 		&i.ID,
@@ -190,19 +412,27 @@ func (q *Queries) GetCartDetailByUIDAndGoodsID(ctx context.Context, arg GetCartD
 		&i.GoodsID,
 		&i.Nums,
 		&i.Checked,
+		&i.Price,
+		&i.CartToken,
 	)
 	return i, err
 }
 
 const getCartListByUid = `-- name: GetCartListByUid :many
-SELECT id, created_at, updated_at, deleted_at, user_id, goods_id, nums, checked
+SELECT id, created_at, updated_at, deleted_at, user_id, goods_id, nums, checked, price, cart_token
 FROM "shopping_cart"
 WHERE user_id = $1
+  and cart_token = $2
   and deleted_at IS  NULL
 `
 
-func (q *Queries) GetCartListByUid(ctx context.Context, userID int32) ([]ShoppingCart, error) {
-	rows, err := q.db.QueryContext(ctx, getCartListByUid, userID)
+type GetCartListByUidParams struct {
+	UserID    int32  `json:"user_id"`
+	CartToken string `json:"cart_token"`
+}
+
+func (q *Queries) GetCartListByUid(ctx context.Context, arg GetCartListByUidParams) ([]ShoppingCart, error) {
+	rows, err := q.db.QueryContext(ctx, getCartListByUid, arg.UserID, arg.CartToken)
 	if err != nil {
 		return nil, err
 	}
@@ -219,6 +449,8 @@ func (q *Queries) GetCartListByUid(ctx context.Context, userID int32) ([]Shoppin
 			&i.GoodsID,
 			&i.Nums,
 			&i.Checked,
+			&i.Price,
+			&i.CartToken,
 		); err != nil {
 			return nil, err
 		}
@@ -234,7 +466,7 @@ func (q *Queries) GetCartListByUid(ctx context.Context, userID int32) ([]Shoppin
 }
 
 const getCartListChecked = `-- name: GetCartListChecked :many
-SELECT id, created_at, updated_at, deleted_at, user_id, goods_id, nums, checked
+SELECT id, created_at, updated_at, deleted_at, user_id, goods_id, nums, checked, price, cart_token
 FROM "shopping_cart"
 WHERE user_id = $1 
   and checked = $2 
@@ -264,6 +496,8 @@ func (q *Queries) GetCartListChecked(ctx context.Context, arg GetCartListChecked
 			&i.GoodsID,
 			&i.Nums,
 			&i.Checked,
+			&i.Price,
+			&i.CartToken,
 		); err != nil {
 			return nil, err
 		}
@@ -279,7 +513,7 @@ func (q *Queries) GetCartListChecked(ctx context.Context, arg GetCartListChecked
 }
 
 const getOrderDetail = `-- name: GetOrderDetail :one
-SELECT id, created_at, updated_at, deleted_at, user_id, order_id, pay_type, status, trade_id, order_mount, pay_time, address, signer_name, signer_mobile, post
+SELECT id, created_at, updated_at, deleted_at, user_id, order_id, pay_type, status, trade_id, order_mount, pay_time, address, signer_name, signer_mobile, post, coupon_id, goods_amount, discount_amount, currency, exchange_rate, shipping_fee, shipping_rule, tax_amount, cancel_reason, cancelled_at
 FROM "order_info"
 WHERE  order_id = $1 and deleted_at IS  NULL
 LIMIT 1
@@ -304,12 +538,22 @@ func (q *Queries) GetOrderDetail(ctx context.Context, orderID int64) (OrderInfo,
 		&i.SignerName,
 		&i.SignerMobile,
 		&i.Post,
+		&i.CouponID,
+		&i.GoodsAmount,
+		&i.DiscountAmount,
+		&i.Currency,
+		&i.ExchangeRate,
+		&i.ShippingFee,
+		&i.ShippingRule,
+		&i.TaxAmount,
+		&i.CancelReason,
+		&i.CancelledAt,
 	)
 	return i, err
 }
 
 const getOrderList = `-- name: GetOrderList :many
-SELECT id, created_at, updated_at, deleted_at, user_id, order_id, pay_type, status, trade_id, order_mount, pay_time, address, signer_name, signer_mobile, post
+SELECT id, created_at, updated_at, deleted_at, user_id, order_id, pay_type, status, trade_id, order_mount, pay_time, address, signer_name, signer_mobile, post, coupon_id, goods_amount, discount_amount, currency, exchange_rate, shipping_fee, shipping_rule, tax_amount, cancel_reason, cancelled_at
 FROM "order_info"
 WHERE user_id = $1 and deleted_at IS  NULL
 limit $2 offset $3
@@ -346,6 +590,16 @@ func (q *Queries) GetOrderList(ctx context.Context, arg GetOrderListParams) ([]O
 			&i.SignerName,
 			&i.SignerMobile,
 			&i.Post,
+			&i.CouponID,
+			&i.GoodsAmount,
+			&i.DiscountAmount,
+			&i.Currency,
+			&i.ExchangeRate,
+			&i.ShippingFee,
+			&i.ShippingRule,
+			&i.TaxAmount,
+			&i.CancelReason,
+			&i.CancelledAt,
 		); err != nil {
 			return nil, err
 		}
@@ -361,7 +615,7 @@ func (q *Queries) GetOrderList(ctx context.Context, arg GetOrderListParams) ([]O
 }
 
 const getOrderListByOrderID = `-- name: GetOrderListByOrderID :many
-SELECT id, created_at, updated_at, deleted_at, order_id, goods_id, goods_name, goods_price, nums
+SELECT id, created_at, updated_at, deleted_at, order_id, goods_id, goods_name, goods_price, nums, discount_amount
 FROM order_goods
 WHERE order_id = $1
   and deleted_at IS  NULL
@@ -386,6 +640,7 @@ func (q *Queries) GetOrderListByOrderID(ctx context.Context, orderID int64) ([]O
 			&i.GoodsName,
 			&i.GoodsPrice,
 			&i.Nums,
+			&i.DiscountAmount,
 		); err != nil {
 			return nil, err
 		}
@@ -400,14 +655,226 @@ func (q *Queries) GetOrderListByOrderID(ctx context.Context, orderID int64) ([]O
 	return items, nil
 }
 
+const searchOrders = `-- name: SearchOrders :many
+SELECT id, created_at, updated_at, deleted_at, user_id, order_id, pay_type, status, trade_id, order_mount, pay_time, address, signer_name, signer_mobile, post, coupon_id, goods_amount, discount_amount, currency, exchange_rate, shipping_fee, shipping_rule, tax_amount, cancel_reason, cancelled_at
+FROM "order_info"
+WHERE deleted_at IS NULL
+  and ($1::int2 = 0 or status = $1::int2)
+  and ($2::int4 = 0 or user_id = $2::int4)
+  and created_at >= $3::timestamptz
+  and created_at < $4::timestamptz
+  and coalesce(order_mount, 0) >= $5::int8
+  and ($6::int8 = 0 or coalesce(order_mount, 0) <= $6::int8)
+  and order_id::text LIKE $7::text || '%'
+  and ($8::int4 = 0 or exists(SELECT 1
+                                               FROM "order_goods"
+                                               WHERE order_goods.order_id = order_info.order_id
+                                                 and order_goods.goods_id = $8::int4
+                                                 and order_goods.deleted_at IS NULL))
+ORDER BY CASE WHEN $9::text = 'created_at_asc' THEN created_at END,
+         CASE WHEN $9::text = 'amount_desc' THEN order_mount END DESC,
+         CASE WHEN $9::text = 'amount_asc' THEN order_mount END,
+         created_at DESC,
+         id DESC
+limit $11 offset $10
+`
+
+type SearchOrdersParams struct {
+	Status        int16     `json:"status"`
+	UserID        int32     `json:"user_id"`
+	CreatedFrom   time.Time `json:"created_from"`
+	CreatedTo     time.Time `json:"created_to"`
+	MinAmount     int64     `json:"min_amount"`
+	MaxAmount     int64     `json:"max_amount"`
+	OrderIDPrefix string    `json:"order_id_prefix"`
+	GoodsID       int32     `json:"goods_id"`
+	Sort          string    `json:"sort"`
+	PageOffset    int32     `json:"page_offset"`
+	PageSize      int32     `json:"page_size"`
+}
+
+// 后台搜索订单 条件为 0 或者空时不过滤
+func (q *Queries) SearchOrders(ctx context.Context, arg SearchOrdersParams) ([]OrderInfo, error) {
+	rows, err := q.db.QueryContext(ctx, searchOrders,
+		arg.Status,
+		arg.UserID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.OrderIDPrefix,
+		arg.GoodsID,
+		arg.Sort,
+		arg.PageOffset,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrderInfo
+	for rows.Next() {
+		var i OrderInfo
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.UserID,
+			&i.OrderID,
+			&i.PayType,
+			&i.Status,
+			&i.TradeID,
+			&i.OrderMount,
+			&i.PayTime,
+			&i.Address,
+			&i.SignerName,
+			&i.SignerMobile,
+			&i.Post,
+			&i.CouponID,
+			&i.GoodsAmount,
+			&i.DiscountAmount,
+			&i.Currency,
+			&i.ExchangeRate,
+			&i.ShippingFee,
+			&i.ShippingRule,
+			&i.TaxAmount,
+			&i.CancelReason,
+			&i.CancelledAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setOrderStatus = `-- name: SetOrderStatus :one
+UPDATE "order_info"
+set updated_at = $1,
+    status     = $2
+where order_id = $3
+  and status IN (2, 4)
+  and deleted_at IS NULL
+returning id, created_at, updated_at, deleted_at, user_id, order_id, pay_type, status, trade_id, order_mount, pay_time, address, signer_name, signer_mobile, post, coupon_id, goods_amount, discount_amount, currency, exchange_rate, shipping_fee, shipping_rule, tax_amount, cancel_reason, cancelled_at
+`
+
+type SetOrderStatusParams struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	Status    int16     `json:"status"`
+	OrderID   int64     `json:"order_id"`
+}
+
+// 只有已支付和已发货的订单可以发货和签收，关闭的订单不会被修改
+func (q *Queries) SetOrderStatus(ctx context.Context, arg SetOrderStatusParams) (OrderInfo, error) {
+	row := q.db.QueryRowContext(ctx, setOrderStatus, arg.UpdatedAt, arg.Status, arg.OrderID)
+	var i OrderInfo
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.UserID,
+		&i.OrderID,
+		&i.PayType,
+		&i.Status,
+		&i.TradeID,
+		&i.OrderMount,
+		&i.PayTime,
+		&i.Address,
+		&i.SignerName,
+		&i.SignerMobile,
+		&i.Post,
+		&i.CouponID,
+		&i.GoodsAmount,
+		&i.DiscountAmount,
+		&i.Currency,
+		&i.ExchangeRate,
+		&i.ShippingFee,
+		&i.ShippingRule,
+		&i.TaxAmount,
+		&i.CancelReason,
+		&i.CancelledAt,
+	)
+	return i, err
+}
+
+const updateCartCheckedAll = `-- name: UpdateCartCheckedAll :execrows
+UPDATE "shopping_cart"
+SET updated_at = $1,
+    checked    = $2
+WHERE user_id = $3
+  and cart_token = $4
+  and deleted_at IS  NULL
+`
+
+type UpdateCartCheckedAllParams struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	Checked   bool      `json:"checked"`
+	UserID    int32     `json:"user_id"`
+	CartToken string    `json:"cart_token"`
+}
+
+func (q *Queries) UpdateCartCheckedAll(ctx context.Context, arg UpdateCartCheckedAllParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateCartCheckedAll,
+		arg.UpdatedAt,
+		arg.Checked,
+		arg.UserID,
+		arg.CartToken,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateCartCheckedByGoodsIDs = `-- name: UpdateCartCheckedByGoodsIDs :execrows
+UPDATE "shopping_cart"
+SET updated_at = $1,
+    checked    = $2
+WHERE user_id = $3
+  and cart_token = $4
+  and goods_id = ANY ($5::int[])
+  and deleted_at IS  NULL
+`
+
+type UpdateCartCheckedByGoodsIDsParams struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	Checked   bool      `json:"checked"`
+	UserID    int32     `json:"user_id"`
+	CartToken string    `json:"cart_token"`
+	GoodsIds  []int32   `json:"goods_ids"`
+}
+
+func (q *Queries) UpdateCartCheckedByGoodsIDs(ctx context.Context, arg UpdateCartCheckedByGoodsIDsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateCartCheckedByGoodsIDs,
+		arg.UpdatedAt,
+		arg.Checked,
+		arg.UserID,
+		arg.CartToken,
+		pq.Array(arg.GoodsIds),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateCartItem = `-- name: UpdateCartItem :one
 UPDATE "shopping_cart"
 SET updated_at = $1,
     nums       = $2,
     checked    = $3
 WHERE user_id = $4
-  and goods_id = $5 and deleted_at IS  NULL
-returning id, created_at, updated_at, deleted_at, user_id, goods_id, nums, checked
+  and cart_token = $5
+  and goods_id = $6 and deleted_at IS  NULL
+returning id, created_at, updated_at, deleted_at, user_id, goods_id, nums, checked, price, cart_token
 `
 
 type UpdateCartItemParams struct {
@@ -415,6 +882,7 @@ type UpdateCartItemParams struct {
 	Nums      int32     `json:"nums"`
 	Checked   bool      `json:"checked"`
 	UserID    int32     `json:"user_id"`
+	CartToken string    `json:"cart_token"`
 	GoodsID   int32     `json:"goods_id"`
 }
 
@@ -424,6 +892,91 @@ func (q *Queries) UpdateCartItem(ctx context.Context, arg UpdateCartItemParams)
 		arg.Nums,
 		arg.Checked,
 		arg.UserID,
+		arg.CartToken,
+		arg.GoodsID,
+	)
+	var i ShoppingCart
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.UserID,
+		&i.GoodsID,
+		&i.Nums,
+		&i.Checked,
+		&i.Price,
+		&i.CartToken,
+	)
+	return i, err
+}
+
+const updateCartItemNums = `-- name: UpdateCartItemNums :one
+UPDATE "shopping_cart"
+SET updated_at = $1,
+    nums       = $2
+WHERE user_id = $3
+  and cart_token = $4
+  and goods_id = $5 and deleted_at IS  NULL
+returning id, created_at, updated_at, deleted_at, user_id, goods_id, nums, checked, price, cart_token
+`
+
+type UpdateCartItemNumsParams struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	Nums      int32     `json:"nums"`
+	UserID    int32     `json:"user_id"`
+	CartToken string    `json:"cart_token"`
+	GoodsID   int32     `json:"goods_id"`
+}
+
+func (q *Queries) UpdateCartItemNums(ctx context.Context, arg UpdateCartItemNumsParams) (ShoppingCart, error) {
+	row := q.db.QueryRowContext(ctx, updateCartItemNums,
+		arg.UpdatedAt,
+		arg.Nums,
+		arg.UserID,
+		arg.CartToken,
+		arg.GoodsID,
+	)
+	var i ShoppingCart
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.UserID,
+		&i.GoodsID,
+		&i.Nums,
+		&i.Checked,
+		&i.Price,
+		&i.CartToken,
+	)
+	return i, err
+}
+
+const updateCartItemPrice = `-- name: UpdateCartItemPrice :one
+UPDATE "shopping_cart"
+SET updated_at = $1,
+    price      = $2
+WHERE user_id = $3
+  and cart_token = $4
+  and goods_id = $5 and deleted_at IS  NULL
+returning id, created_at, updated_at, deleted_at, user_id, goods_id, nums, checked, price, cart_token
+`
+
+type UpdateCartItemPriceParams struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	Price     int64     `json:"price"`
+	UserID    int32     `json:"user_id"`
+	CartToken string    `json:"cart_token"`
+	GoodsID   int32     `json:"goods_id"`
+}
+
+func (q *Queries) UpdateCartItemPrice(ctx context.Context, arg UpdateCartItemPriceParams) (ShoppingCart, error) {
+	row := q.db.QueryRowContext(ctx, updateCartItemPrice,
+		arg.UpdatedAt,
+		arg.Price,
+		arg.UserID,
+		arg.CartToken,
 		arg.GoodsID,
 	)
 	var i ShoppingCart
@@ -436,6 +989,8 @@ func (q *Queries) UpdateCartItem(ctx context.Context, arg UpdateCartItemParams)
 		&i.GoodsID,
 		&i.Nums,
 		&i.Checked,
+		&i.Price,
+		&i.CartToken,
 	)
 	return i, err
 }
@@ -447,7 +1002,7 @@ set updated_at = $1,
     pay_time   = $3,
     status     = $4
 where order_id = $5 and deleted_at IS  NULL
-returning id, created_at, updated_at, deleted_at, user_id, order_id, pay_type, status, trade_id, order_mount, pay_time, address, signer_name, signer_mobile, post
+returning id, created_at, updated_at, deleted_at, user_id, order_id, pay_type, status, trade_id, order_mount, pay_time, address, signer_name, signer_mobile, post, coupon_id, goods_amount, discount_amount, currency, exchange_rate, shipping_fee, shipping_rule, tax_amount, cancel_reason, cancelled_at
 `
 
 type UpdateOrderParams struct {
@@ -483,6 +1038,16 @@ func (q *Queries) UpdateOrder(ctx context.Context, arg UpdateOrderParams) (Order
 		&i.SignerName,
 		&i.SignerMobile,
 		&i.Post,
+		&i.CouponID,
+		&i.GoodsAmount,
+		&i.DiscountAmount,
+		&i.Currency,
+		&i.ExchangeRate,
+		&i.ShippingFee,
+		&i.ShippingRule,
+		&i.TaxAmount,
+		&i.CancelReason,
+		&i.CancelledAt,
 	)
 	return i, err
 }
@@ -0,0 +1,77 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: order_tax.sql
+
+package model
+
+import (
+	"context"
+)
+
+const createOrderTax = `-- name: CreateOrderTax :one
+INSERT INTO "order_tax"(order_id, name, rate, amount)
+VALUES ($1, $2, $3, $4)
+returning id, created_at, order_id, name, rate, amount
+`
+
+type CreateOrderTaxParams struct {
+	OrderID int64   `json:"order_id"`
+	Name    string  `json:"name"`
+	Rate    float64 `json:"rate"`
+	Amount  int64   `json:"amount"`
+}
+
+func (q *Queries) CreateOrderTax(ctx context.Context, arg CreateOrderTaxParams) (OrderTax, error) {
+	row := q.db.QueryRowContext(ctx, createOrderTax,
+		arg.OrderID,
+		arg.Name,
+		arg.Rate,
+		arg.Amount,
+	)
+	var i OrderTax
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.OrderID,
+		&i.Name,
+		&i.Rate,
+		&i.Amount,
+	)
+	return i, err
+}
+
+const getOrderTaxes = `-- name: GetOrderTaxes :many
+SELECT id, created_at, order_id, name, rate, amount
+FROM "order_tax"
+WHERE order_id = $1
+ORDER BY id
+`
+
+func (q *Queries) GetOrderTaxes(ctx context.Context, orderID int64) ([]OrderTax, error) {
+	rows, err := q.db.QueryContext(ctx, getOrderTaxes, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrderTax
+	for rows.Next() {
+		var i OrderTax
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.OrderID,
+			&i.Name,
+			&i.Rate,
+			&i.Amount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
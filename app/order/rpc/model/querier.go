@@ -4,21 +4,73 @@ package model
 
 import (
 	"context"
+	"time"
 )
 
 type Querier interface {
+	CancelOrder(ctx context.Context, arg CancelOrderParams) (OrderInfo, error)
+	CountOrderList(ctx context.Context, userID int32) (int64, error)
+	CountSearchOrders(ctx context.Context, arg CountSearchOrdersParams) (int64, error)
+	CountUndeliveredShipments(ctx context.Context, orderID int64) (int64, error)
+	CountUserCouponByTemplate(ctx context.Context, arg CountUserCouponByTemplateParams) (int64, error)
 	CreateCart(ctx context.Context, arg CreateCartParams) (ShoppingCart, error)
+	CreateCouponTemplate(ctx context.Context, arg CreateCouponTemplateParams) (CouponTemplate, error)
+	CreateInvoice(ctx context.Context, arg CreateInvoiceParams) (Invoice, error)
+	CreateInvoiceLine(ctx context.Context, arg CreateInvoiceLineParams) (InvoiceLine, error)
 	CreateOrder(ctx context.Context, arg CreateOrderParams) (OrderInfo, error)
 	CreateOrderGoods(ctx context.Context, arg CreateOrderGoodsParams) (OrderGood, error)
+	CreateOrderTax(ctx context.Context, arg CreateOrderTaxParams) (OrderTax, error)
+	CreateShipment(ctx context.Context, arg CreateShipmentParams) (Shipment, error)
+	CreateShipmentEvent(ctx context.Context, arg CreateShipmentEventParams) (int64, error)
+	CreateUserCoupon(ctx context.Context, arg CreateUserCouponParams) (UserCoupon, error)
+	CreateWishlistItem(ctx context.Context, arg CreateWishlistItemParams) (Wishlist, error)
 	DeleteCartItem(ctx context.Context, arg DeleteCartItemParams) (ShoppingCart, error)
+	DeleteCartItemsByGoodsIDs(ctx context.Context, arg DeleteCartItemsByGoodsIDsParams) (int64, error)
+	DeleteGuestCart(ctx context.Context, arg DeleteGuestCartParams) (int64, error)
+	DeleteSalesDaily(ctx context.Context, day time.Time) error
+	DeleteSalesGoodsDaily(ctx context.Context, day time.Time) error
+	DeleteWishlistItem(ctx context.Context, arg DeleteWishlistItemParams) (Wishlist, error)
 	GetCartDetailByUIDAndGoodsID(ctx context.Context, arg GetCartDetailByUIDAndGoodsIDParams) (ShoppingCart, error)
-	GetCartListByUid(ctx context.Context, userID int32) ([]ShoppingCart, error)
+	GetCartListByUid(ctx context.Context, arg GetCartListByUidParams) ([]ShoppingCart, error)
 	GetCartListChecked(ctx context.Context, arg GetCartListCheckedParams) ([]ShoppingCart, error)
+	GetConversionDaily(ctx context.Context, arg GetConversionDailyParams) ([]ConversionDaily, error)
+	GetCouponTemplate(ctx context.Context, id int64) (CouponTemplate, error)
+	GetGoodsSales(ctx context.Context, arg GetGoodsSalesParams) ([]GetGoodsSalesRow, error)
+	GetInvoiceByOrderID(ctx context.Context, orderID int64) (Invoice, error)
+	GetInvoiceLines(ctx context.Context, invoiceID int64) ([]InvoiceLine, error)
 	GetOrderDetail(ctx context.Context, orderID int64) (OrderInfo, error)
 	GetOrderList(ctx context.Context, arg GetOrderListParams) ([]OrderInfo, error)
 	GetOrderListByOrderID(ctx context.Context, orderID int64) ([]OrderGood, error)
+	GetOrderTaxes(ctx context.Context, orderID int64) ([]OrderTax, error)
+	GetReportDaysChangedSince(ctx context.Context, since time.Time) ([]time.Time, error)
+	GetReportWatermark(ctx context.Context, name string) (time.Time, error)
+	GetSalesDaily(ctx context.Context, arg GetSalesDailyParams) ([]SalesDaily, error)
+	GetShipmentByTrackingNo(ctx context.Context, arg GetShipmentByTrackingNoParams) (Shipment, error)
+	GetShipmentEvents(ctx context.Context, shipmentID int64) ([]ShipmentEvent, error)
+	GetShipmentsByOrderID(ctx context.Context, orderID int64) ([]Shipment, error)
+	GetUserCoupon(ctx context.Context, arg GetUserCouponParams) (UserCoupon, error)
+	GetUserCouponList(ctx context.Context, userID int32) ([]UserCoupon, error)
+	GetWishlistByUid(ctx context.Context, userID int32) ([]Wishlist, error)
+	GetWishlistItem(ctx context.Context, arg GetWishlistItemParams) (Wishlist, error)
+	IssueCouponTemplate(ctx context.Context, arg IssueCouponTemplateParams) (CouponTemplate, error)
+	LockUserCoupon(ctx context.Context, arg LockUserCouponParams) (int64, error)
+	NextInvoiceNumber(ctx context.Context, year int32) (int32, error)
+	RefreshConversionDaily(ctx context.Context, day time.Time) error
+	RefreshSalesDaily(ctx context.Context, day time.Time) error
+	RefreshSalesGoodsDaily(ctx context.Context, day time.Time) error
+	ReleaseUserCouponByOrderID(ctx context.Context, arg ReleaseUserCouponByOrderIDParams) (int64, error)
+	SearchOrders(ctx context.Context, arg SearchOrdersParams) ([]OrderInfo, error)
+	SetOrderStatus(ctx context.Context, arg SetOrderStatusParams) (OrderInfo, error)
+	SetReportWatermark(ctx context.Context, arg SetReportWatermarkParams) error
+	TryLockReportRefresh(ctx context.Context, key int64) (bool, error)
+	UpdateCartCheckedAll(ctx context.Context, arg UpdateCartCheckedAllParams) (int64, error)
+	UpdateCartCheckedByGoodsIDs(ctx context.Context, arg UpdateCartCheckedByGoodsIDsParams) (int64, error)
 	UpdateCartItem(ctx context.Context, arg UpdateCartItemParams) (ShoppingCart, error)
+	UpdateCartItemNums(ctx context.Context, arg UpdateCartItemNumsParams) (ShoppingCart, error)
+	UpdateCartItemPrice(ctx context.Context, arg UpdateCartItemPriceParams) (ShoppingCart, error)
 	UpdateOrder(ctx context.Context, arg UpdateOrderParams) (OrderInfo, error)
+	UpdateShipmentStatus(ctx context.Context, arg UpdateShipmentStatusParams) (Shipment, error)
+	UseUserCouponByOrderID(ctx context.Context, arg UseUserCouponByOrderIDParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
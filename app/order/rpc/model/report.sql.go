@@ -0,0 +1,319 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: report.sql
+
+package model
+
+import (
+	"context"
+	"time"
+)
+
+const deleteSalesDaily = `-- name: DeleteSalesDaily :exec
+DELETE
+FROM "sales_daily"
+WHERE day = $1
+`
+
+func (q *Queries) DeleteSalesDaily(ctx context.Context, day time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteSalesDaily, day)
+	return err
+}
+
+const deleteSalesGoodsDaily = `-- name: DeleteSalesGoodsDaily :exec
+DELETE
+FROM "sales_goods_daily"
+WHERE day = $1
+`
+
+func (q *Queries) DeleteSalesGoodsDaily(ctx context.Context, day time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteSalesGoodsDaily, day)
+	return err
+}
+
+const getConversionDaily = `-- name: GetConversionDaily :many
+SELECT day, cart_users, order_users, updated_at
+FROM "conversion_daily"
+WHERE day >= $1::date
+  and day <= $2::date
+ORDER BY day
+`
+
+type GetConversionDailyParams struct {
+	FromDay time.Time `json:"from_day"`
+	ToDay   time.Time `json:"to_day"`
+}
+
+func (q *Queries) GetConversionDaily(ctx context.Context, arg GetConversionDailyParams) ([]ConversionDaily, error) {
+	rows, err := q.db.QueryContext(ctx, getConversionDaily, arg.FromDay, arg.ToDay)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ConversionDaily
+	for rows.Next() {
+		var i ConversionDaily
+		if err := rows.Scan(
+			&i.Day,
+			&i.CartUsers,
+			&i.OrderUsers,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGoodsSales = `-- name: GetGoodsSales :many
+SELECT goods_id,
+       currency,
+       max(goods_name)::varchar AS goods_name,
+       sum(nums)::int8          AS nums,
+       sum(revenue)::int8       AS revenue
+FROM "sales_goods_daily"
+WHERE day >= $1::date
+  and day <= $2::date
+GROUP BY goods_id, currency
+ORDER BY CASE WHEN $3::text = 'nums' THEN sum(nums) END DESC,
+         sum(revenue) DESC,
+         goods_id
+limit $4
+`
+
+type GetGoodsSalesParams struct {
+	FromDay  time.Time `json:"from_day"`
+	ToDay    time.Time `json:"to_day"`
+	Sort     string    `json:"sort"`
+	RowLimit int32     `json:"row_limit"`
+}
+
+type GetGoodsSalesRow struct {
+	GoodsID   int32  `json:"goods_id"`
+	Currency  string `json:"currency"`
+	GoodsName string `json:"goods_name"`
+	Nums      int64  `json:"nums"`
+	Revenue   int64  `json:"revenue"`
+}
+
+func (q *Queries) GetGoodsSales(ctx context.Context, arg GetGoodsSalesParams) ([]GetGoodsSalesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getGoodsSales,
+		arg.FromDay,
+		arg.ToDay,
+		arg.Sort,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGoodsSalesRow
+	for rows.Next() {
+		var i GetGoodsSalesRow
+		if err := rows.Scan(
+			&i.GoodsID,
+			&i.Currency,
+			&i.GoodsName,
+			&i.Nums,
+			&i.Revenue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReportDaysChangedSince = `-- name: GetReportDaysChangedSince :many
+SELECT DISTINCT created_at::date AS day
+FROM "order_info"
+WHERE updated_at >= $1::timestamptz
+UNION
+SELECT DISTINCT created_at::date AS day
+FROM "shopping_cart"
+WHERE updated_at >= $1::timestamptz
+ORDER BY day
+`
+
+// 上次刷新之后有变化的日期
+func (q *Queries) GetReportDaysChangedSince(ctx context.Context, since time.Time) ([]time.Time, error) {
+	rows, err := q.db.QueryContext(ctx, getReportDaysChangedSince, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []time.Time
+	for rows.Next() {
+		var day time.Time
+		if err := rows.Scan(&day); err != nil {
+			return nil, err
+		}
+		items = append(items, day)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReportWatermark = `-- name: GetReportWatermark :one
+SELECT refreshed_until
+FROM "report_refresh"
+WHERE name = $1
+`
+
+func (q *Queries) GetReportWatermark(ctx context.Context, name string) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, getReportWatermark, name)
+	var refreshed_until time.Time
+	err := row.Scan(&refreshed_until)
+	return refreshed_until, err
+}
+
+const getSalesDaily = `-- name: GetSalesDaily :many
+SELECT day, currency, order_count, paid_order_count, revenue, discount, updated_at
+FROM "sales_daily"
+WHERE day >= $1::date
+  and day <= $2::date
+ORDER BY day, currency
+`
+
+type GetSalesDailyParams struct {
+	FromDay time.Time `json:"from_day"`
+	ToDay   time.Time `json:"to_day"`
+}
+
+func (q *Queries) GetSalesDaily(ctx context.Context, arg GetSalesDailyParams) ([]SalesDaily, error) {
+	rows, err := q.db.QueryContext(ctx, getSalesDaily, arg.FromDay, arg.ToDay)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SalesDaily
+	for rows.Next() {
+		var i SalesDaily
+		if err := rows.Scan(
+			&i.Day,
+			&i.Currency,
+			&i.OrderCount,
+			&i.PaidOrderCount,
+			&i.Revenue,
+			&i.Discount,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const refreshConversionDaily = `-- name: RefreshConversionDaily :exec
+INSERT INTO "conversion_daily"(day, cart_users, order_users, updated_at)
+VALUES ($1::date,
+        (SELECT count(DISTINCT CASE WHEN user_id > 0 THEN user_id::text ELSE cart_token END)
+         FROM "shopping_cart"
+         WHERE created_at::date = $1::date),
+        (SELECT count(DISTINCT user_id)
+         FROM "order_info"
+         WHERE created_at::date = $1::date
+           and deleted_at IS NULL),
+        now())
+ON CONFLICT (day) DO UPDATE SET cart_users  = excluded.cart_users,
+                                order_users = excluded.order_users,
+                                updated_at  = excluded.updated_at
+`
+
+func (q *Queries) RefreshConversionDaily(ctx context.Context, day time.Time) error {
+	_, err := q.db.ExecContext(ctx, refreshConversionDaily, day)
+	return err
+}
+
+const refreshSalesDaily = `-- name: RefreshSalesDaily :exec
+INSERT INTO "sales_daily"(day, currency, order_count, paid_order_count, revenue, discount)
+SELECT $1::date,
+       currency,
+       count(*),
+       count(*) FILTER (WHERE status IN (2, 4, 5)),
+       coalesce(sum(order_mount) FILTER (WHERE status IN (2, 4, 5)), 0)::int8,
+       coalesce(sum(discount_amount) FILTER (WHERE status IN (2, 4, 5)), 0)::int8
+FROM "order_info"
+WHERE created_at::date = $1::date
+  and deleted_at IS NULL
+GROUP BY currency
+`
+
+func (q *Queries) RefreshSalesDaily(ctx context.Context, day time.Time) error {
+	_, err := q.db.ExecContext(ctx, refreshSalesDaily, day)
+	return err
+}
+
+const refreshSalesGoodsDaily = `-- name: RefreshSalesGoodsDaily :exec
+INSERT INTO "sales_goods_daily"(day, goods_id, currency, goods_name, nums, revenue)
+SELECT $1::date,
+       g.goods_id,
+       o.currency,
+       max(g.goods_name),
+       sum(g.nums),
+       sum(g.goods_price * g.nums - g.discount_amount)::int8
+FROM "order_goods" g
+         JOIN "order_info" o ON o.order_id = g.order_id
+WHERE o.created_at::date = $1::date
+  and o.status IN (2, 4, 5)
+  and o.deleted_at IS NULL
+  and g.deleted_at IS NULL
+GROUP BY g.goods_id, o.currency
+`
+
+func (q *Queries) RefreshSalesGoodsDaily(ctx context.Context, day time.Time) error {
+	_, err := q.db.ExecContext(ctx, refreshSalesGoodsDaily, day)
+	return err
+}
+
+const setReportWatermark = `-- name: SetReportWatermark :exec
+INSERT INTO "report_refresh"(name, refreshed_until)
+VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET refreshed_until = excluded.refreshed_until
+`
+
+type SetReportWatermarkParams struct {
+	Name           string    `json:"name"`
+	RefreshedUntil time.Time `json:"refreshed_until"`
+}
+
+func (q *Queries) SetReportWatermark(ctx context.Context, arg SetReportWatermarkParams) error {
+	_, err := q.db.ExecContext(ctx, setReportWatermark, arg.Name, arg.RefreshedUntil)
+	return err
+}
+
+const tryLockReportRefresh = `-- name: TryLockReportRefresh :one
+SELECT pg_try_advisory_xact_lock($1::int8) AS locked
+`
+
+// 多个副本同时刷新时只有拿到锁的副本刷新，锁在事务结束时释放
+func (q *Queries) TryLockReportRefresh(ctx context.Context, key int64) (bool, error) {
+	row := q.db.QueryRowContext(ctx, tryLockReportRefresh, key)
+	var locked bool
+	err := row.Scan(&locked)
+	return locked, err
+}
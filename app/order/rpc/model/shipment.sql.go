@@ -0,0 +1,230 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: shipment.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const countUndeliveredShipments = `-- name: CountUndeliveredShipments :one
+SELECT count(*)
+FROM "shipment"
+WHERE order_id = $1
+  and status < 4
+  and deleted_at IS NULL
+`
+
+func (q *Queries) CountUndeliveredShipments(ctx context.Context, orderID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUndeliveredShipments, orderID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createShipment = `-- name: CreateShipment :one
+INSERT INTO "shipment"(order_id, carrier, tracking_no)
+VALUES ($1, $2, $3)
+returning id, created_at, updated_at, deleted_at, order_id, carrier, tracking_no, status, delivered_at
+`
+
+type CreateShipmentParams struct {
+	OrderID    int64  `json:"order_id"`
+	Carrier    string `json:"carrier"`
+	TrackingNo string `json:"tracking_no"`
+}
+
+func (q *Queries) CreateShipment(ctx context.Context, arg CreateShipmentParams) (Shipment, error) {
+	row := q.db.QueryRowContext(ctx, createShipment, arg.OrderID, arg.Carrier, arg.TrackingNo)
+	var i Shipment
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.OrderID,
+		&i.Carrier,
+		&i.TrackingNo,
+		&i.Status,
+		&i.DeliveredAt,
+	)
+	return i, err
+}
+
+const createShipmentEvent = `-- name: CreateShipmentEvent :execrows
+INSERT INTO "shipment_event"(shipment_id, status, location, description, occurred_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT DO NOTHING
+`
+
+type CreateShipmentEventParams struct {
+	ShipmentID  int64     `json:"shipment_id"`
+	Status      int16     `json:"status"`
+	Location    string    `json:"location"`
+	Description string    `json:"description"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+func (q *Queries) CreateShipmentEvent(ctx context.Context, arg CreateShipmentEventParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createShipmentEvent,
+		arg.ShipmentID,
+		arg.Status,
+		arg.Location,
+		arg.Description,
+		arg.OccurredAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getShipmentByTrackingNo = `-- name: GetShipmentByTrackingNo :one
+SELECT id, created_at, updated_at, deleted_at, order_id, carrier, tracking_no, status, delivered_at
+FROM "shipment"
+WHERE carrier = $1
+  and tracking_no = $2
+  and deleted_at IS NULL
+`
+
+type GetShipmentByTrackingNoParams struct {
+	Carrier    string `json:"carrier"`
+	TrackingNo string `json:"tracking_no"`
+}
+
+func (q *Queries) GetShipmentByTrackingNo(ctx context.Context, arg GetShipmentByTrackingNoParams) (Shipment, error) {
+	row := q.db.QueryRowContext(ctx, getShipmentByTrackingNo, arg.Carrier, arg.TrackingNo)
+	var i Shipment
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.OrderID,
+		&i.Carrier,
+		&i.TrackingNo,
+		&i.Status,
+		&i.DeliveredAt,
+	)
+	return i, err
+}
+
+const getShipmentEvents = `-- name: GetShipmentEvents :many
+SELECT id, created_at, shipment_id, status, location, description, occurred_at
+FROM "shipment_event"
+WHERE shipment_id = $1
+order by occurred_at, id
+`
+
+func (q *Queries) GetShipmentEvents(ctx context.Context, shipmentID int64) ([]ShipmentEvent, error) {
+	rows, err := q.db.QueryContext(ctx, getShipmentEvents, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ShipmentEvent
+	for rows.Next() {
+		var i ShipmentEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.ShipmentID,
+			&i.Status,
+			&i.Location,
+			&i.Description,
+			&i.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getShipmentsByOrderID = `-- name: GetShipmentsByOrderID :many
+SELECT id, created_at, updated_at, deleted_at, order_id, carrier, tracking_no, status, delivered_at
+FROM "shipment"
+WHERE order_id = $1
+  and deleted_at IS NULL
+order by id
+`
+
+func (q *Queries) GetShipmentsByOrderID(ctx context.Context, orderID int64) ([]Shipment, error) {
+	rows, err := q.db.QueryContext(ctx, getShipmentsByOrderID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Shipment
+	for rows.Next() {
+		var i Shipment
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.OrderID,
+			&i.Carrier,
+			&i.TrackingNo,
+			&i.Status,
+			&i.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateShipmentStatus = `-- name: UpdateShipmentStatus :one
+UPDATE "shipment"
+set updated_at   = $1,
+    status       = $2,
+    delivered_at = $3
+where id = $4
+  and deleted_at IS NULL
+returning id, created_at, updated_at, deleted_at, order_id, carrier, tracking_no, status, delivered_at
+`
+
+type UpdateShipmentStatusParams struct {
+	UpdatedAt   time.Time    `json:"updated_at"`
+	Status      int16        `json:"status"`
+	DeliveredAt sql.NullTime `json:"delivered_at"`
+	ID          int64        `json:"id"`
+}
+
+func (q *Queries) UpdateShipmentStatus(ctx context.Context, arg UpdateShipmentStatusParams) (Shipment, error) {
+	row := q.db.QueryRowContext(ctx, updateShipmentStatus,
+		arg.UpdatedAt,
+		arg.Status,
+		arg.DeliveredAt,
+		arg.ID,
+	)
+	var i Shipment
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.OrderID,
+		&i.Carrier,
+		&i.TrackingNo,
+		&i.Status,
+		&i.DeliveredAt,
+	)
+	return i, err
+}
@@ -0,0 +1,128 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: wishlist.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createWishlistItem = `-- name: CreateWishlistItem :one
+INSERT INTO "wishlist"(user_id, goods_id)
+VALUES ($1, $2)
+returning id, created_at, updated_at, deleted_at, user_id, goods_id
+`
+
+type CreateWishlistItemParams struct {
+	UserID  int32 `json:"user_id"`
+	GoodsID int32 `json:"goods_id"`
+}
+
+func (q *Queries) CreateWishlistItem(ctx context.Context, arg CreateWishlistItemParams) (Wishlist, error) {
+	row := q.db.QueryRowContext(ctx, createWishlistItem, arg.UserID, arg.GoodsID)
+	var i Wishlist
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.UserID,
+		&i.GoodsID,
+	)
+	return i, err
+}
+
+const deleteWishlistItem = `-- name: DeleteWishlistItem :one
+UPDATE "wishlist"
+set deleted_at = $1
+where user_id = $2
+  and goods_id = $3
+  and deleted_at IS NULL
+returning id, created_at, updated_at, deleted_at, user_id, goods_id
+`
+
+type DeleteWishlistItemParams struct {
+	DeletedAt sql.NullTime `json:"deleted_at"`
+	UserID    int32        `json:"user_id"`
+	GoodsID   int32        `json:"goods_id"`
+}
+
+func (q *Queries) DeleteWishlistItem(ctx context.Context, arg DeleteWishlistItemParams) (Wishlist, error) {
+	row := q.db.QueryRowContext(ctx, deleteWishlistItem, arg.DeletedAt, arg.UserID, arg.GoodsID)
+	var i Wishlist
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.UserID,
+		&i.GoodsID,
+	)
+	return i, err
+}
+
+const getWishlistByUid = `-- name: GetWishlistByUid :many
+SELECT id, created_at, updated_at, deleted_at, user_id, goods_id
+FROM "wishlist"
+WHERE user_id = $1
+  and deleted_at IS NULL
+order by id desc
+`
+
+func (q *Queries) GetWishlistByUid(ctx context.Context, userID int32) ([]Wishlist, error) {
+	rows, err := q.db.QueryContext(ctx, getWishlistByUid, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Wishlist
+	for rows.Next() {
+		var i Wishlist
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.UserID,
+			&i.GoodsID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWishlistItem = `-- name: GetWishlistItem :one
+SELECT id, created_at, updated_at, deleted_at, user_id, goods_id
+FROM "wishlist"
+WHERE user_id = $1
+  and goods_id = $2
+  and deleted_at IS NULL
+`
+
+type GetWishlistItemParams struct {
+	UserID  int32 `json:"user_id"`
+	GoodsID int32 `json:"goods_id"`
+}
+
+func (q *Queries) GetWishlistItem(ctx context.Context, arg GetWishlistItemParams) (Wishlist, error) {
+	row := q.db.QueryRowContext(ctx, getWishlistItem, arg.UserID, arg.GoodsID)
+	var i Wishlist
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.UserID,
+		&i.GoodsID,
+	)
+	return i, err
+}
@@ -40,6 +40,9 @@ func main() {
 	// 初始化第三方服务
 	initialize.InitGrpcClient()
 
+	// 初始化运费和税费的规则
+	initialize.InitPricing()
+
 	tracer, cl, err := initialize.InitJaeger()
 	if err != nil {
 		global.Logger.Fatal("创建 tracer 失败", zap.Error(err))
@@ -56,9 +59,12 @@ func main() {
 	// 数据库的连接
 	sqlStore := model.NewSQLStore(global.DB)
 
-	orderServer := handler.NewOrderServer(sqlStore)
+	orderServer := handler.NewOrderServer(sqlStore, global.Pricing)
 	proto.RegisterOrderServer(grpcServer, orderServer)
 
+	// 定时刷新销售报表
+	initialize.InitReport(sqlStore)
+
 	// 优先使用配置的端口
 	listener, err := net.Listen(
 		"tcp",
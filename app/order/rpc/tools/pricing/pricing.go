@@ -0,0 +1,270 @@
+package pricing
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/jimyag/shop/common/utils/money"
+)
+
+// 运费的计算方式
+const (
+	ModeCount  = "count"  // 按件数
+	ModeWeight = "weight" // 按重量
+)
+
+// 计算运费和税费时返回的错误
+var (
+	ErrNoShippingRule = errors.New("该地区暂不支持配送")
+	ErrInvalidRule    = errors.New("运费规则的配置不合法")
+)
+
+//
+// ShippingRule
+//  @Description: 运费规则，金额的单位都是分
+//
+type ShippingRule struct {
+	Name          string   // 规则的名称
+	Regions       []string // 适用的地区 纯数字为邮编的前缀 其他为地址中包含的地名 为空表示默认规则
+	Mode          string   // 计算方式 count 按件数 weight 按重量
+	FirstUnits    int64    // 首件的件数或首重的克数
+	FirstFee      int64    // 首件或首重的运费
+	ExtraUnits    int64    // 每个续件的件数或续重的克数
+	ExtraFee      int64    // 每个续件或续重的运费
+	FreeThreshold int64    // 优惠后的商品金额达到该值时包邮 0 表示不包邮
+}
+
+//
+// TaxRule
+//  @Description: 税费规则
+//
+type TaxRule struct {
+	Name            string   // 税的名称
+	Rate            float64  // 税率 0.13 表示 13%
+	Regions         []string // 适用的地区 规则同运费 为空表示所有地区
+	IncludeShipping bool     // 运费是否计税
+}
+
+//
+// Config
+//  @Description: 运费和税费的规则
+//
+type Config struct {
+	Shipping []ShippingRule
+	Taxes    []TaxRule
+}
+
+//
+// Input
+//  @Description: 计算运费和税费需要的订单信息
+//
+type Input struct {
+	Post        string // 邮政编码
+	Address     string // 收货地址
+	GoodsAmount int64  // 优惠后的商品金额
+	Nums        int64  // 商品的件数
+	Weight      int64  // 商品的重量 单位 克
+}
+
+//
+// TaxLine
+//  @Description: 一项税费
+//
+type TaxLine struct {
+	Name   string
+	Rate   float64
+	Amount int64
+}
+
+//
+// Quote
+//  @Description: 运费和税费的计算结果，金额的单位都是分
+//
+type Quote struct {
+	ShippingRule string // 使用的运费规则
+	ShippingFee  int64
+	Taxes        []TaxLine
+	TaxAmount    int64 // 所有税费的和
+}
+
+//
+// Calculate
+//  @Description: 计算订单的运费和税费
+//  运费使用匹配地区最具体的规则，都不匹配时使用默认规则
+//  @receiver cfg
+//  @param input
+//  @return *Quote
+//  @return error
+//
+func (cfg *Config) Calculate(input Input) (*Quote, error) {
+	rule, ok := cfg.matchShipping(input.Post, input.Address)
+	if !ok {
+		return nil, ErrNoShippingRule
+	}
+	fee, err := rule.fee(input)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &Quote{
+		ShippingRule: rule.Name,
+		ShippingFee:  fee,
+		Taxes:        make([]TaxLine, 0, len(cfg.Taxes)),
+	}
+	for _, tax := range cfg.Taxes {
+		if tax.Rate <= 0 || matchRegion(tax.Regions, input.Post, input.Address) < 0 {
+			continue
+		}
+		base := input.GoodsAmount
+		if tax.IncludeShipping {
+			base += fee
+		}
+		line := TaxLine{Name: tax.Name, Rate: tax.Rate, Amount: money.ApplyRate(base, tax.Rate)}
+		quote.Taxes = append(quote.Taxes, line)
+		quote.TaxAmount += line.Amount
+	}
+	return quote, nil
+}
+
+//
+// Convert
+//  @Description: 规则中的金额是基准货币的，返回转换为订单币种之后的规则
+//  @receiver cfg
+//  @param convert 金额的转换函数
+//  @return *Config
+//
+func (cfg *Config) Convert(convert func(int64) int64) *Config {
+	converted := &Config{
+		Shipping: make([]ShippingRule, 0, len(cfg.Shipping)),
+		Taxes:    cfg.Taxes,
+	}
+	for _, rule := range cfg.Shipping {
+		rule.FirstFee = convert(rule.FirstFee)
+		rule.ExtraFee = convert(rule.ExtraFee)
+		rule.FreeThreshold = convert(rule.FreeThreshold)
+		converted.Shipping = append(converted.Shipping, rule)
+	}
+	return converted
+}
+
+func (cfg *Config) matchShipping(post, address string) (ShippingRule, bool) {
+	best, bestScore := -1, -1
+	for i, rule := range cfg.Shipping {
+		// 分数相同时使用靠前的规则
+		if score := matchRegion(rule.Regions, post, address); score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	if best < 0 {
+		return ShippingRule{}, false
+	}
+	return cfg.Shipping[best], true
+}
+
+func (rule ShippingRule) fee(input Input) (int64, error) {
+	if rule.FreeThreshold > 0 && input.GoodsAmount >= rule.FreeThreshold {
+		return 0, nil
+	}
+	var units int64
+	switch rule.Mode {
+	case ModeCount, "":
+		units = input.Nums
+	case ModeWeight:
+		units = input.Weight
+	default:
+		return 0, ErrInvalidRule
+	}
+	if units <= rule.FirstUnits {
+		return rule.FirstFee, nil
+	}
+	if rule.ExtraUnits <= 0 {
+		return 0, ErrInvalidRule
+	}
+	// 不足一个续件或续重的按一个计算
+	extra := (units - rule.FirstUnits + rule.ExtraUnits - 1) / rule.ExtraUnits
+	return rule.FirstFee + extra*rule.ExtraFee, nil
+}
+
+//
+// matchRegion
+//  @Description: 地区匹配的程度，匹配的内容越长越具体
+//  @param regions
+//  @param post
+//  @param address
+//  @return int 没有限制地区时返回 0 不匹配时返回 -1
+//
+func matchRegion(regions []string, post, address string) int {
+	if len(regions) == 0 {
+		return 0
+	}
+	score := -1
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+		if region == "" {
+			continue
+		}
+		var matched bool
+		if isDigits(region) {
+			matched = strings.HasPrefix(strings.TrimSpace(post), region)
+		} else {
+			matched = strings.Contains(address, region)
+		}
+		if matched && len(region) > score {
+			score = len(region)
+		}
+	}
+	return score
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+//
+// Engine
+//  @Description: 保存当前使用的规则，远程配置变化时可以替换
+//
+type Engine struct {
+	mu     sync.RWMutex
+	config *Config
+}
+
+//
+// NewEngine
+//  @Description: 新建规则的容器
+//  @param cfg
+//  @return *Engine
+//
+func NewEngine(cfg *Config) *Engine {
+	return &Engine{config: cfg}
+}
+
+//
+// Config
+//  @Description: 获取当前的规则
+//  @receiver engine
+//  @return *Config
+//
+func (engine *Engine) Config() *Config {
+	engine.mu.RLock()
+	defer engine.mu.RUnlock()
+	return engine.config
+}
+
+//
+// Update
+//  @Description: 替换规则，正在计算的订单不受影响
+//  @receiver engine
+//  @param cfg
+//
+func (engine *Engine) Update(cfg *Config) {
+	engine.mu.Lock()
+	defer engine.mu.Unlock()
+	engine.config = cfg
+}
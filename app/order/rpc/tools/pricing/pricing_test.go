@@ -0,0 +1,99 @@
+package pricing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var config = &Config{
+	Shipping: []ShippingRule{
+		{Name: "默认", Mode: ModeCount, FirstUnits: 1, FirstFee: 1000, ExtraUnits: 1, ExtraFee: 200, FreeThreshold: 9900},
+		{Name: "江浙沪", Regions: []string{"20", "21", "31"}, Mode: ModeCount, FirstUnits: 2, FirstFee: 600, ExtraUnits: 1, ExtraFee: 100, FreeThreshold: 4900},
+		{Name: "偏远地区", Regions: []string{"83", "新疆", "西藏"}, Mode: ModeWeight, FirstUnits: 1000, FirstFee: 2000, ExtraUnits: 500, ExtraFee: 800},
+	},
+	Taxes: []TaxRule{
+		{Name: "增值税", Rate: 0.13},
+		{Name: "消费税", Rate: 0.1, Regions: []string{"21"}, IncludeShipping: true},
+	},
+}
+
+func TestCalculateShippingByRegion(t *testing.T) {
+	// 没有匹配的地区使用默认规则 首件 10 元 续件 2 元
+	quote, err := config.Calculate(Input{Post: "100000", GoodsAmount: 5000, Nums: 3})
+	require.NoError(t, err)
+	require.Equal(t, "默认", quote.ShippingRule)
+	require.Equal(t, int64(1400), quote.ShippingFee)
+
+	quote, err = config.Calculate(Input{Post: "200000", GoodsAmount: 1000, Nums: 3})
+	require.NoError(t, err)
+	require.Equal(t, "江浙沪", quote.ShippingRule)
+	require.Equal(t, int64(700), quote.ShippingFee)
+
+	// 邮编为空时按照地址匹配，按重量计算 不足 500 克按 500 克
+	quote, err = config.Calculate(Input{Address: "新疆乌鲁木齐市", GoodsAmount: 100000, Weight: 1600})
+	require.NoError(t, err)
+	require.Equal(t, "偏远地区", quote.ShippingRule)
+	require.Equal(t, int64(2000+2*800), quote.ShippingFee)
+}
+
+func TestCalculateFreeShipping(t *testing.T) {
+	quote, err := config.Calculate(Input{Post: "200000", GoodsAmount: 4900, Nums: 10})
+	require.NoError(t, err)
+	require.Zero(t, quote.ShippingFee)
+
+	quote, err = config.Calculate(Input{Post: "200000", GoodsAmount: 4899, Nums: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(600), quote.ShippingFee)
+}
+
+func TestCalculateTaxes(t *testing.T) {
+	quote, err := config.Calculate(Input{Post: "100000", GoodsAmount: 5000, Nums: 1})
+	require.NoError(t, err)
+	require.Len(t, quote.Taxes, 1)
+	require.Equal(t, int64(650), quote.TaxAmount)
+
+	// 消费税只在 21 开头的地区收取并且运费也要计税
+	quote, err = config.Calculate(Input{Post: "210000", GoodsAmount: 5000, Nums: 1})
+	require.NoError(t, err)
+	require.Zero(t, quote.ShippingFee)
+	require.Len(t, quote.Taxes, 2)
+	require.Equal(t, int64(650+500), quote.TaxAmount)
+
+	quote, err = config.Calculate(Input{Post: "210000", GoodsAmount: 1000, Nums: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(600), quote.ShippingFee)
+	require.Equal(t, "消费税", quote.Taxes[1].Name)
+	require.Equal(t, int64(160), quote.Taxes[1].Amount)
+}
+
+func TestCalculateWithoutRule(t *testing.T) {
+	cfg := &Config{Shipping: []ShippingRule{{Name: "江浙沪", Regions: []string{"20"}}}}
+	_, err := cfg.Calculate(Input{Post: "100000", Nums: 1})
+	require.ErrorIs(t, err, ErrNoShippingRule)
+
+	cfg = &Config{Shipping: []ShippingRule{{Name: "错误", Mode: "volume"}}}
+	_, err = cfg.Calculate(Input{Nums: 1})
+	require.ErrorIs(t, err, ErrInvalidRule)
+
+	cfg = &Config{Shipping: []ShippingRule{{Name: "没有续件", FirstUnits: 1, FirstFee: 100}}}
+	_, err = cfg.Calculate(Input{Nums: 2})
+	require.ErrorIs(t, err, ErrInvalidRule)
+}
+
+func TestConvert(t *testing.T) {
+	converted := config.Convert(func(amount int64) int64 { return amount * 2 })
+	require.Equal(t, int64(2000), converted.Shipping[0].FirstFee)
+	require.Equal(t, int64(19800), converted.Shipping[0].FreeThreshold)
+	// 原来的规则不受影响
+	require.Equal(t, int64(1000), config.Shipping[0].FirstFee)
+}
+
+func TestEngine(t *testing.T) {
+	engine := NewEngine(config)
+	require.Equal(t, config, engine.Config())
+
+	cfg := &Config{}
+	engine.Update(cfg)
+	require.Equal(t, cfg, engine.Config())
+}
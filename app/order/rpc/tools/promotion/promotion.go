@@ -0,0 +1,142 @@
+package promotion
+
+import (
+	"errors"
+
+	"github.com/jimyag/shop/common/utils/money"
+)
+
+// 优惠券的类型
+const (
+	TypeFixedAmount int16 = 1 // 立减
+	TypePercentage  int16 = 2 // 折扣
+	TypeThreshold   int16 = 3 // 满减
+)
+
+// 计算优惠时返回的错误
+var (
+	ErrUnknownType        = errors.New("未知的优惠券类型")
+	ErrThresholdNotMet    = errors.New("未达到优惠券的使用门槛")
+	ErrNoApplicableGoods  = errors.New("没有可以使用优惠券的商品")
+	ErrInvalidRuleSetting = errors.New("优惠券的配置不合法")
+)
+
+//
+// Rule
+//  @Description: 优惠的规则，来自优惠券模板，金额的单位都是分
+//
+type Rule struct {
+	Type      int16   // 优惠券的类型
+	Amount    int64   // 立减和满减的金额
+	Rate      float64 // 折扣率 0.8 表示八折
+	Threshold int64   // 使用门槛 0 表示没有门槛
+	GoodsID   int32   // 适用的商品 0 表示全部商品
+}
+
+//
+// Line
+//  @Description: 订单中的一行商品
+//
+type Line struct {
+	GoodsID int32
+	Price   int64 // 单价 单位 分
+	Nums    int32
+}
+
+//
+// LineResult
+//  @Description: 每一行商品的金额和分摊到的优惠
+//
+type LineResult struct {
+	GoodsID  int32
+	Amount   int64 // 这一行商品的原价
+	Discount int64 // 分摊到这一行的优惠金额
+}
+
+//
+// Result
+//  @Description: 优惠计算的结果，金额的单位都是分
+//
+type Result struct {
+	GoodsAmount int64 // 商品总金额
+	Discount    int64 // 总的优惠金额
+	PayAmount   int64 // 需要支付的金额
+	Lines       []LineResult
+}
+
+//
+// Evaluate
+//  @Description: 计算订单的优惠，rule 为 nil 时表示没有使用优惠券
+//  优惠按照每行商品金额的比例分摊，最后一行承担舍入的误差
+//  @param rule
+//  @param lines
+//  @return *Result
+//  @return error
+//
+func Evaluate(rule *Rule, lines []Line) (*Result, error) {
+	result := &Result{Lines: make([]LineResult, 0, len(lines))}
+	// 可以使用优惠的商品金额
+	var applicable int64
+	applicableIndex := make([]int, 0)
+	for i, line := range lines {
+		amount := money.Mul(line.Price, line.Nums)
+		result.GoodsAmount += amount
+		result.Lines = append(result.Lines, LineResult{GoodsID: line.GoodsID, Amount: amount})
+		if rule != nil && (rule.GoodsID == 0 || rule.GoodsID == line.GoodsID) {
+			applicable += amount
+			applicableIndex = append(applicableIndex, i)
+		}
+	}
+	result.PayAmount = result.GoodsAmount
+	if rule == nil {
+		return result, nil
+	}
+
+	if len(applicableIndex) == 0 || applicable <= 0 {
+		return nil, ErrNoApplicableGoods
+	}
+	if applicable < rule.Threshold {
+		return nil, ErrThresholdNotMet
+	}
+
+	var discount int64
+	switch rule.Type {
+	case TypeFixedAmount:
+		discount = rule.Amount
+	case TypePercentage:
+		if rule.Rate <= 0 || rule.Rate > 1 {
+			return nil, ErrInvalidRuleSetting
+		}
+		discount = applicable - money.ApplyRate(applicable, rule.Rate)
+	case TypeThreshold:
+		if rule.Threshold <= 0 {
+			return nil, ErrInvalidRuleSetting
+		}
+		discount = rule.Amount
+	default:
+		return nil, ErrUnknownType
+	}
+	if discount < 0 {
+		return nil, ErrInvalidRuleSetting
+	}
+	// 优惠不能超过商品的金额
+	if discount > applicable {
+		discount = applicable
+	}
+
+	// 按比例分摊到每一行
+	remain := discount
+	for n, i := range applicableIndex {
+		line := &result.Lines[i]
+		if n == len(applicableIndex)-1 {
+			line.Discount = remain
+			break
+		}
+		line.Discount = money.Share(discount, line.Amount, applicable)
+		remain -= line.Discount
+	}
+
+	result.Discount = discount
+	result.PayAmount = result.GoodsAmount - discount
+	return result, nil
+}
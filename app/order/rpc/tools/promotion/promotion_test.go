@@ -0,0 +1,110 @@
+package promotion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jimyag/shop/common/utils/money"
+)
+
+var lines = []Line{
+	{GoodsID: 1, Price: 1000, Nums: 2},
+	{GoodsID: 2, Price: 3000, Nums: 1},
+	{GoodsID: 3, Price: 3333, Nums: 1},
+}
+
+func sumDiscount(result *Result) int64 {
+	var sum int64
+	for _, line := range result.Lines {
+		sum += line.Discount
+	}
+	return sum
+}
+
+func TestEvaluateWithoutRule(t *testing.T) {
+	result, err := Evaluate(nil, lines)
+	require.NoError(t, err)
+	require.Equal(t, int64(8333), result.GoodsAmount)
+	require.Equal(t, int64(8333), result.PayAmount)
+	require.Zero(t, result.Discount)
+	require.Len(t, result.Lines, 3)
+}
+
+func TestEvaluateExactAmount(t *testing.T) {
+	// 0.1 + 0.2 用浮点数计算不等于 0.3
+	result, err := Evaluate(nil, []Line{
+		{GoodsID: 1, Price: money.FromYuan(0.1), Nums: 1},
+		{GoodsID: 2, Price: money.FromYuan(0.2), Nums: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, money.FromYuan(0.3), result.GoodsAmount)
+	require.Equal(t, "0.30", money.Format(result.PayAmount))
+
+	result, err = Evaluate(&Rule{Type: TypeFixedAmount, Amount: money.FromYuan(0.1)}, []Line{
+		{GoodsID: 1, Price: money.FromYuan(0.1), Nums: 3},
+		{GoodsID: 2, Price: money.FromYuan(0.2), Nums: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0.40", money.Format(result.PayAmount))
+	require.Equal(t, result.Discount, sumDiscount(result))
+}
+
+func TestEvaluateFixedAmount(t *testing.T) {
+	result, err := Evaluate(&Rule{Type: TypeFixedAmount, Amount: 1000}, lines)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), result.Discount)
+	require.Equal(t, int64(7333), result.PayAmount)
+	require.Equal(t, result.Discount, sumDiscount(result))
+
+	// 优惠不能超过商品金额
+	result, err = Evaluate(&Rule{Type: TypeFixedAmount, Amount: 100000}, lines)
+	require.NoError(t, err)
+	require.Equal(t, int64(8333), result.Discount)
+	require.Zero(t, result.PayAmount)
+	for _, line := range result.Lines {
+		require.Equal(t, line.Amount, line.Discount)
+	}
+}
+
+func TestEvaluatePercentage(t *testing.T) {
+	result, err := Evaluate(&Rule{Type: TypePercentage, Rate: 0.8}, lines)
+	require.NoError(t, err)
+	require.Equal(t, int64(1667), result.Discount)
+	require.Equal(t, int64(6666), result.PayAmount)
+	require.Equal(t, result.Discount, sumDiscount(result))
+
+	_, err = Evaluate(&Rule{Type: TypePercentage, Rate: 1.2}, lines)
+	require.ErrorIs(t, err, ErrInvalidRuleSetting)
+}
+
+func TestEvaluateThreshold(t *testing.T) {
+	result, err := Evaluate(&Rule{Type: TypeThreshold, Amount: 2000, Threshold: 8000}, lines)
+	require.NoError(t, err)
+	require.Equal(t, int64(2000), result.Discount)
+	require.Equal(t, int64(6333), result.PayAmount)
+
+	_, err = Evaluate(&Rule{Type: TypeThreshold, Amount: 2000, Threshold: 10000}, lines)
+	require.ErrorIs(t, err, ErrThresholdNotMet)
+}
+
+func TestEvaluateSingleGoods(t *testing.T) {
+	result, err := Evaluate(&Rule{Type: TypeFixedAmount, Amount: 500, GoodsID: 2}, lines)
+	require.NoError(t, err)
+	require.Equal(t, int64(500), result.Discount)
+	require.Zero(t, result.Lines[0].Discount)
+	require.Equal(t, int64(500), result.Lines[1].Discount)
+	require.Zero(t, result.Lines[2].Discount)
+
+	// 门槛只计算适用的商品
+	_, err = Evaluate(&Rule{Type: TypeThreshold, Amount: 500, Threshold: 5000, GoodsID: 2}, lines)
+	require.ErrorIs(t, err, ErrThresholdNotMet)
+
+	_, err = Evaluate(&Rule{Type: TypeFixedAmount, Amount: 500, GoodsID: 99}, lines)
+	require.ErrorIs(t, err, ErrNoApplicableGoods)
+}
+
+func TestEvaluateUnknownType(t *testing.T) {
+	_, err := Evaluate(&Rule{Type: 99}, lines)
+	require.ErrorIs(t, err, ErrUnknownType)
+}
@@ -0,0 +1,153 @@
+package report
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/jimyag/shop/app/order/rpc/model"
+)
+
+// 销售报表刷新进度的名称
+const watermarkName = "sales"
+
+// 刷新销售报表的 postgres advisory lock 的 key
+const refreshLockKey int64 = 20220601
+
+//
+// Refresh
+//  @Description: 增量刷新销售报表的汇总表，只重新计算上次刷新之后有订单或购物车变化的日期
+//  整个刷新在一个事务中持有 advisory lock，多个副本同时刷新时只有一个副本会刷新，其他副本直接返回
+//  每个日期的汇总先删除再重新计算，重复刷新不会重复统计
+//  @param ctx
+//  @param store
+//  @return int 刷新的天数，没有拿到锁时为 0
+//  @return error
+//
+func Refresh(ctx context.Context, store model.Store) (int, error) {
+	refreshed := 0
+	err := store.ExecTx(ctx, func(queries *model.Queries) error {
+		locked, err := queries.TryLockReportRefresh(ctx, refreshLockKey)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			// 其他副本正在刷新
+			return nil
+		}
+		since, err := queries.GetReportWatermark(ctx, watermarkName)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		// 在查询之前记录时间，刷新期间的变化下一次还会被刷新
+		now := time.Now()
+		days, err := queries.GetReportDaysChangedSince(ctx, since)
+		if err != nil {
+			return err
+		}
+		for _, day := range days {
+			if err = refreshDay(ctx, queries, day); err != nil {
+				return err
+			}
+		}
+		refreshed = len(days)
+		return queries.SetReportWatermark(ctx, model.SetReportWatermarkParams{
+			Name:           watermarkName,
+			RefreshedUntil: now,
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return refreshed, nil
+}
+
+//
+// refreshDay
+//  @Description: 重新计算一天的销售汇总
+//  @param ctx
+//  @param queries
+//  @param day
+//  @return error
+//
+func refreshDay(ctx context.Context, queries *model.Queries, day time.Time) error {
+	if err := queries.DeleteSalesDaily(ctx, day); err != nil {
+		return err
+	}
+	if err := queries.RefreshSalesDaily(ctx, day); err != nil {
+		return err
+	}
+	if err := queries.DeleteSalesGoodsDaily(ctx, day); err != nil {
+		return err
+	}
+	if err := queries.RefreshSalesGoodsDaily(ctx, day); err != nil {
+		return err
+	}
+	return queries.RefreshConversionDaily(ctx, day)
+}
+
+//
+// Summary
+//  @Description: 一个币种在一段时间内的销售汇总，金额是该币种的最小单位
+//
+type Summary struct {
+	Currency          string
+	Orders            int64 // 下单数量
+	PaidOrders        int64 // 已支付的订单数量
+	Revenue           int64 // 已支付订单的金额
+	Discount          int64 // 已支付订单的优惠
+	AverageOrderValue int64 // 已支付订单的平均金额
+}
+
+//
+// Summarize
+//  @Description: 按照币种汇总每天的销售数据
+//  @param days
+//  @return []Summary 按照币种排序
+//
+func Summarize(days []model.SalesDaily) []Summary {
+	summaries := make(map[string]*Summary)
+	for _, day := range days {
+		summary, ok := summaries[day.Currency]
+		if !ok {
+			summary = &Summary{Currency: day.Currency}
+			summaries[day.Currency] = summary
+		}
+		summary.Orders += int64(day.OrderCount)
+		summary.PaidOrders += int64(day.PaidOrderCount)
+		summary.Revenue += day.Revenue
+		summary.Discount += day.Discount
+	}
+	result := make([]Summary, 0, len(summaries))
+	for _, summary := range summaries {
+		if summary.PaidOrders > 0 {
+			summary.AverageOrderValue = (summary.Revenue + summary.PaidOrders/2) / summary.PaidOrders
+		}
+		result = append(result, *summary)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Currency < result[j].Currency
+	})
+	return result
+}
+
+//
+// Conversion
+//  @Description: 一段时间内加入购物车的用户和下单的用户，每天分别计算后相加
+//  @param days
+//  @return cartUsers
+//  @return orderUsers
+//  @return rate 下单的用户 / 加入购物车的用户，没有加入购物车的用户时为 0
+//
+func Conversion(days []model.ConversionDaily) (cartUsers, orderUsers int64, rate float64) {
+	for _, day := range days {
+		cartUsers += int64(day.CartUsers)
+		orderUsers += int64(day.OrderUsers)
+	}
+	if cartUsers > 0 {
+		rate = float64(orderUsers) / float64(cartUsers)
+	}
+	return cartUsers, orderUsers, rate
+}
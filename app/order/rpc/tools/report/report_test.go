@@ -0,0 +1,38 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jimyag/shop/app/order/rpc/model"
+)
+
+func TestSummarize(t *testing.T) {
+	summaries := Summarize([]model.SalesDaily{
+		{Currency: "USD", OrderCount: 2, PaidOrderCount: 1, Revenue: 1000, Discount: 100},
+		{Currency: "CNY", OrderCount: 3, PaidOrderCount: 2, Revenue: 3001, Discount: 0},
+		{Currency: "USD", OrderCount: 1, PaidOrderCount: 1, Revenue: 2001, Discount: 50},
+		{Currency: "JPY", OrderCount: 1},
+	})
+	require.Len(t, summaries, 3)
+	require.Equal(t, Summary{Currency: "CNY", Orders: 3, PaidOrders: 2, Revenue: 3001, AverageOrderValue: 1501}, summaries[0])
+	// 没有支付的订单时平均金额为 0
+	require.Equal(t, Summary{Currency: "JPY", Orders: 1}, summaries[1])
+	require.Equal(t, Summary{Currency: "USD", Orders: 3, PaidOrders: 2, Revenue: 3001, Discount: 150, AverageOrderValue: 1501}, summaries[2])
+
+	require.Empty(t, Summarize(nil))
+}
+
+func TestConversion(t *testing.T) {
+	cartUsers, orderUsers, rate := Conversion([]model.ConversionDaily{
+		{CartUsers: 10, OrderUsers: 2},
+		{CartUsers: 10, OrderUsers: 3},
+	})
+	require.Equal(t, int64(20), cartUsers)
+	require.Equal(t, int64(5), orderUsers)
+	require.InDelta(t, 0.25, rate, 1e-9)
+
+	_, _, rate = Conversion(nil)
+	require.Zero(t, rate)
+}
@@ -0,0 +1,202 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/user/api/global"
+	"github.com/jimyag/shop/app/user/api/model/request"
+	"github.com/jimyag/shop/app/user/api/model/response"
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
+	"github.com/jimyag/shop/common/utils/handle_grpc_error"
+	"github.com/jimyag/shop/common/utils/paseto"
+	"github.com/jimyag/shop/common/utils/validate"
+)
+
+// 导出订单时每页的订单数
+const exportOrderPageSize = 100
+
+//
+// DeleteAccount
+//  @Description: 注销账号，不传 id 时注销自己的账号，管理员可以注销其他用户的账号
+//  注销后之前签发的 token 都不能再使用，只能由管理员恢复
+//  @param ctx
+//
+func DeleteAccount(ctx *gin.Context) {
+	deleteAccount := request.DeleteAccount{}
+	_ = ctx.ShouldBindJSON(&deleteAccount)
+	msg, err := validate.Validate(deleteAccount, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+	uid, ok := auth.ResolveUserID(ctx, deleteAccount.Id, auth.PermUserWriteAll)
+	if !ok {
+		return
+	}
+
+	_, err = global.UserSrvClient.DeleteUser(ctx, &proto.IdRequest{Id: uint32(uid)})
+	if err != nil {
+		global.Logger.Info("注销账号失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	// 注销后之前签发的 token 都不能再使用，吊销失败时不能返回成功
+	// refresh token 也换不到新的 token，刷新时会重新查找用户
+	err = global.Revoker.RevokeUser(ctx, uid)
+	if err != nil {
+		global.Logger.Error("吊销用户的token失败", zap.Int32("uid", uid), zap.Error(err))
+		model.FailWithMsg("系统错误，请稍后重试", ctx)
+		return
+	}
+	model.OkWithMsg("账号已注销", ctx)
+}
+
+//
+// RestoreUser
+//  @Description: 恢复被注销的账号
+//  @param ctx
+//
+func RestoreUser(ctx *gin.Context) {
+	restoreUser := request.RestoreUser{}
+	_ = ctx.ShouldBindJSON(&restoreUser)
+	msg, err := validate.Validate(restoreUser, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	user, err := global.UserSrvClient.RestoreUser(ctx, &proto.IdRequest{Id: uint32(restoreUser.Id)})
+	if err != nil {
+		global.Logger.Info("恢复账号失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithData(response.NewUserInfo(user), ctx)
+}
+
+//
+// ExportUserData
+//  @Description: 导出当前用户的个人信息、收货地址、购物车、收藏、优惠券、订单和物流信息，以 json 文件的形式下载
+//  @param ctx
+//
+func ExportUserData(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	export, err := collectUserData(ctx, payload.UID)
+	if err != nil {
+		global.Logger.Info("导出用户数据失败", zap.Int32("uid", payload.UID), zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		global.Logger.Error("序列化用户数据失败", zap.Error(err))
+		model.FailWithMsg("系统错误，请稍后重试", ctx)
+		return
+	}
+
+	filename := fmt.Sprintf("user-%d-%s.json", payload.UID, time.Now().Format("20060102150405"))
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	ctx.Data(http.StatusOK, "application/json; charset=utf-8", data)
+}
+
+//
+// collectUserData
+//  @Description: 从 user 和 order 服务获得用户的所有数据，包括收藏、优惠券和物流信息
+//  @param ctx
+//  @param uid
+//  @return *response.UserDataExport
+//  @return error grpc 的错误
+//
+func collectUserData(ctx context.Context, uid int32) (*response.UserDataExport, error) {
+	user, err := global.UserSrvClient.GetUserById(ctx, &proto.IdRequest{Id: uint32(uid)})
+	if err != nil {
+		return nil, err
+	}
+	export := &response.UserDataExport{
+		ExportedAt: time.Now().Unix(),
+		Profile:    response.NewUserInfo(user),
+		Addresses:  []*proto.AddressInfo{},
+		Cart:       []*proto.ShopCartInfoResponse{},
+		Wishlist:   []*proto.WishlistItemInfo{},
+		Coupons:    []*proto.UserCouponInfo{},
+		Orders:     []*proto.OrderDetailResponse{},
+		Shipments:  []*proto.OrderTrackingResponse{},
+	}
+
+	addresses, err := global.UserSrvClient.GetAddressList(ctx, &proto.AddressListRequest{UserID: uid})
+	if err != nil {
+		return nil, err
+	}
+	export.Addresses = append(export.Addresses, addresses.Data...)
+
+	// 购物车为空时返回 NotFound
+	cart, err := global.OrderSrvClient.CartItemList(ctx, &proto.CartItemListRequest{Uid: uid})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	} else if err == nil {
+		export.Cart = append(export.Cart, cart.Data...)
+	}
+
+	wishlist, err := global.OrderSrvClient.WishlistItemList(ctx, &proto.WishlistItemListRequest{UserID: uid})
+	if err != nil {
+		return nil, err
+	}
+	export.Wishlist = append(export.Wishlist, wishlist.Data...)
+
+	coupons, err := global.OrderSrvClient.UserCouponList(ctx, &proto.UserCouponListRequest{UserID: uid})
+	if err != nil {
+		return nil, err
+	}
+	export.Coupons = append(export.Coupons, coupons.Data...)
+
+	for pageNum := int32(1); ; pageNum++ {
+		orders, err := global.OrderSrvClient.GetOrderList(ctx, &proto.GetOrderListRequest{
+			UserID:   uid,
+			PageSize: exportOrderPageSize,
+			PageNum:  pageNum,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, order := range orders.Data {
+			detail, err := global.OrderSrvClient.GetOrderDetail(ctx, &proto.GetOrderDetailRequest{OrderID: order.OrderID})
+			if err != nil {
+				return nil, err
+			}
+			export.Orders = append(export.Orders, detail)
+
+			tracking, err := global.OrderSrvClient.GetOrderTracking(ctx, &proto.OrderTrackingRequest{
+				UserID:  uid,
+				OrderID: order.OrderID,
+			})
+			if err != nil {
+				return nil, err
+			}
+			// 没有发货的订单没有物流信息
+			if len(tracking.Shipments) > 0 {
+				export.Shipments = append(export.Shipments, tracking)
+			}
+		}
+		if len(orders.Data) < exportOrderPageSize {
+			break
+		}
+	}
+	return export, nil
+}
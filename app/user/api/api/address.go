@@ -0,0 +1,140 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/user/api/global"
+	"github.com/jimyag/shop/app/user/api/model/request"
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/handle_grpc_error"
+	"github.com/jimyag/shop/common/utils/paseto"
+	"github.com/jimyag/shop/common/utils/validate"
+)
+
+//
+// CreateAddress
+//  @Description: 添加当前用户的收货地址
+//  @param ctx
+//
+func CreateAddress(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	createAddress := request.CreateAddress{}
+	_ = ctx.ShouldBindJSON(&createAddress)
+	msg, err := validate.Validate(createAddress, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	address, err := global.UserSrvClient.CreateAddress(ctx, &proto.CreateAddressRequest{
+		UserID:    payload.UID,
+		Name:      createAddress.Name,
+		Mobile:    createAddress.Mobile,
+		Address:   createAddress.Address,
+		Post:      createAddress.Post,
+		IsDefault: createAddress.IsDefault,
+	})
+	if err != nil {
+		global.Logger.Info("添加收货地址失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithData(address, ctx)
+}
+
+//
+// UpdateAddress
+//  @Description: 更新当前用户的收货地址
+//  @param ctx
+//
+func UpdateAddress(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	updateAddress := request.UpdateAddress{}
+	_ = ctx.ShouldBindJSON(&updateAddress)
+	msg, err := validate.Validate(updateAddress, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	address, err := global.UserSrvClient.UpdateAddress(ctx, &proto.AddressInfo{
+		Id:        updateAddress.ID,
+		UserID:    payload.UID,
+		Name:      updateAddress.Name,
+		Mobile:    updateAddress.Mobile,
+		Address:   updateAddress.Address,
+		Post:      updateAddress.Post,
+		IsDefault: updateAddress.IsDefault,
+	})
+	if err != nil {
+		global.Logger.Info("更新收货地址失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithData(address, ctx)
+}
+
+//
+// DeleteAddress
+//  @Description: 删除当前用户的收货地址
+//  @param ctx
+//
+func DeleteAddress(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	addressID := request.AddressID{}
+	_ = ctx.ShouldBindJSON(&addressID)
+	msg, err := validate.Validate(addressID, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	_, err = global.UserSrvClient.DeleteAddress(ctx, &proto.AddressRequest{
+		Id:     addressID.ID,
+		UserID: payload.UID,
+	})
+	if err != nil {
+		global.Logger.Info("删除收货地址失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithMsg("删除收货地址成功", ctx)
+}
+
+//
+// GetAddressList
+//  @Description: 获得当前用户所有的收货地址
+//  @param ctx
+//
+func GetAddressList(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	rsp, err := global.UserSrvClient.GetAddressList(ctx, &proto.AddressListRequest{UserID: payload.UID})
+	if err != nil {
+		global.Logger.Info("获得收货地址列表失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithData(rsp, ctx)
+}
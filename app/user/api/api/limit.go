@@ -0,0 +1,70 @@
+package api
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/user/api/global"
+	"github.com/jimyag/shop/common/model"
+)
+
+//
+// allowRequest
+//  @Description: 按照客户端的 IP 和邮箱限制登录和验证码的请求，超过限制时已经返回了错误信息
+//  @param ctx
+//  @param action 不同的操作分别计数
+//  @param email
+//  @return bool
+//
+func allowRequest(ctx *gin.Context, action string, email string) bool {
+	if !global.IPLimiter.Allow(ctx, action+":"+ctx.ClientIP()) ||
+		!global.EmailLimiter.Allow(ctx, action+":"+strings.ToLower(email)) {
+		model.FailWithMsg("请求过于频繁，请稍后再试", ctx)
+		return false
+	}
+	return true
+}
+
+//
+// checkLoginLocked
+//  @Description: 检查邮箱是否因为连续登录失败被锁定，被锁定时已经返回了错误信息
+//  @param ctx
+//  @param email
+//  @return bool 被锁定时返回 true
+//
+func checkLoginLocked(ctx *gin.Context, email string) bool {
+	remaining, err := global.LoginLockout.Locked(ctx, strings.ToLower(email))
+	if err != nil {
+		// 限流还可以限制请求的次数
+		global.Logger.Error("检查账号是否锁定失败", zap.Error(err))
+		return false
+	}
+	if remaining > 0 {
+		minutes := int(math.Ceil(remaining.Minutes()))
+		model.FailWithMsg(fmt.Sprintf("登录失败次数过多，请 %d 分钟后再试", minutes), ctx)
+		return true
+	}
+	return false
+}
+
+//
+// loginFailed
+//  @Description: 记录一次登录失败，邮箱不存在和密码错误返回相同的信息
+//  @param ctx
+//  @param email
+//  @param msg 返回给用户的错误信息
+//
+func loginFailed(ctx *gin.Context, email string, msg string) {
+	duration, err := global.LoginLockout.Fail(ctx, strings.ToLower(email))
+	if err != nil {
+		global.Logger.Error("记录登录失败次数失败", zap.Error(err))
+	} else if duration > 0 {
+		global.Logger.Warn("连续登录失败，锁定账号", zap.String("email", email), zap.Duration("duration", duration.Round(time.Second)))
+	}
+	model.FailWithMsg(msg, ctx)
+}
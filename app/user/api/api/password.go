@@ -0,0 +1,129 @@
+package api
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/user/api/global"
+	"github.com/jimyag/shop/app/user/api/model/request"
+	"github.com/jimyag/shop/app/user/api/tools/auth_code"
+	"github.com/jimyag/shop/app/user/api/tools/email"
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/handle_grpc_error"
+	"github.com/jimyag/shop/common/utils/validate"
+)
+
+//
+// ForgotPassword
+//  @Description: 忘记密码时发送验证码，不管邮箱是否注册都返回相同的信息
+//  @param ctx
+//
+func ForgotPassword(ctx *gin.Context) {
+	forgotPassword := request.ForgotPassword{}
+	_ = ctx.ShouldBindJSON(&forgotPassword)
+	msg, err := validate.Validate(forgotPassword, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+	if !allowRequest(ctx, "forgot_password", forgotPassword.Email) {
+		return
+	}
+	const sentMsg = "如果该邮箱已经注册，验证码会发送到该邮箱，请注意查收"
+
+	_, err = global.UserSrvClient.GetUserByEmail(ctx, &proto.EmailRequest{Email: forgotPassword.Email})
+	if status.Code(err) == codes.NotFound {
+		model.OkWithMsg(sentMsg, ctx)
+		return
+	} else if err != nil {
+		global.Logger.Info("查找用户错误", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	code := auth_code.Code()
+	err = auth_code.Save(ctx, global.Redis.ResetPassword, forgotPassword.Email, code,
+		time.Duration(global.RemoteConfig.Timeout.ResetPasswordEmail)*time.Minute)
+	if errors.Is(err, auth_code.ErrCodeSent) {
+		model.OkWithMsg(sentMsg, ctx)
+		return
+	} else if err != nil {
+		global.Logger.Info("重置密码验证码写入redis失败", zap.Error(err))
+		model.FailWithMsg("验证码发送失败，请稍后重试", ctx)
+		return
+	}
+
+	err = email.Send([]string{forgotPassword.Email}, "重置密码", auth_code.CodeBody(code, " 重置密码 "))
+	if err != nil {
+		global.Logger.Info("邮件发送失败", zap.Error(err))
+		// 删除验证码，可以马上重新发送
+		global.Redis.ResetPassword.Del(ctx, forgotPassword.Email)
+		model.FailWithMsg("验证码发送失败，请稍后重试", ctx)
+		return
+	}
+	model.OkWithMsg(sentMsg, ctx)
+}
+
+//
+// ResetPassword
+//  @Description: 使用邮箱验证码重置密码，重置后之前签发的 token 都不能再使用
+//  @param ctx
+//
+func ResetPassword(ctx *gin.Context) {
+	resetPassword := request.ResetPassword{}
+	_ = ctx.ShouldBindJSON(&resetPassword)
+	msg, err := validate.Validate(resetPassword, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	if !allowRequest(ctx, "reset_password", resetPassword.Email) {
+		return
+	}
+
+	// 验证码只能使用一次，输错多次后验证码失效
+	err = auth_code.Verify(ctx, global.Redis.ResetPassword, resetPassword.Email, resetPassword.AuthCode, global.RemoteConfig.RateLimit.CodeMaxAttempts)
+	if errors.Is(err, auth_code.ErrCodeInvalid) {
+		model.FailWithMsg("验证码错误或已失效", ctx)
+		return
+	} else if err != nil {
+		global.Logger.Error("检查重置密码的验证码失败", zap.Error(err))
+		model.FailWithMsg("系统错误，请稍后重试", ctx)
+		return
+	}
+
+	user, err := global.UserSrvClient.GetUserByEmail(ctx, &proto.EmailRequest{Email: resetPassword.Email})
+	if status.Code(err) == codes.NotFound {
+		model.FailWithMsg("验证码错误或已失效", ctx)
+		return
+	} else if err != nil {
+		global.Logger.Info("查找用户错误", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	_, err = global.UserSrvClient.UpdateUser(ctx, &proto.UpdateUserRequest{
+		Id:       user.Id,
+		Password: resetPassword.Password,
+	})
+	if err != nil {
+		global.Logger.Error("重置密码出错", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	// 吊销失败时之前签发的 token 还能使用，不能返回成功
+	err = global.Revoker.RevokeUser(ctx, user.Id)
+	if err != nil {
+		global.Logger.Error("吊销用户的token失败", zap.Int32("uid", user.Id), zap.Error(err))
+		model.FailWithMsg("系统错误，请稍后重试", ctx)
+		return
+	}
+	model.OkWithMsg("重置密码成功，请重新登录", ctx)
+}
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/user/api/global"
+	"github.com/jimyag/shop/app/user/api/model/request"
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/handle_grpc_error"
+	"github.com/jimyag/shop/common/utils/validate"
+)
+
+//
+// CreateRole
+//  @Description: 创建角色
+//  @param ctx
+//
+func CreateRole(ctx *gin.Context) {
+	createRole := request.CreateRole{}
+	_ = ctx.ShouldBindJSON(&createRole)
+	msg, err := validate.Validate(createRole, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	role, err := global.UserSrvClient.CreateRole(ctx, &proto.RoleInfo{
+		Name:        createRole.Name,
+		Description: createRole.Description,
+		Permissions: createRole.Permissions,
+	})
+	if err != nil {
+		global.Logger.Info("创建角色失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithData(role, ctx)
+}
+
+//
+// UpdateRolePermissions
+//  @Description: 修改角色的权限，用户刷新 token 后使用新的权限
+//  @param ctx
+//
+func UpdateRolePermissions(ctx *gin.Context) {
+	updateRole := request.UpdateRolePermissions{}
+	_ = ctx.ShouldBindJSON(&updateRole)
+	msg, err := validate.Validate(updateRole, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	role, err := global.UserSrvClient.UpdateRolePermissions(ctx, &proto.RoleInfo{
+		Id:          updateRole.ID,
+		Permissions: updateRole.Permissions,
+	})
+	if err != nil {
+		global.Logger.Info("修改角色权限失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithData(role, ctx)
+}
+
+//
+// DeleteRole
+//  @Description: 删除没有用户的角色
+//  @param ctx
+//
+func DeleteRole(ctx *gin.Context) {
+	roleID := request.RoleID{}
+	_ = ctx.ShouldBindJSON(&roleID)
+	msg, err := validate.Validate(roleID, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	_, err = global.UserSrvClient.DeleteRole(ctx, &proto.RoleRequest{Id: roleID.ID})
+	if err != nil {
+		global.Logger.Info("删除角色失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithMsg("删除成功", ctx)
+}
+
+//
+// GetRole
+//  @Description: 获得角色和它的权限
+//  @param ctx
+//
+func GetRole(ctx *gin.Context) {
+	roleID := request.RoleID{}
+	_ = ctx.ShouldBindQuery(&roleID)
+	msg, err := validate.Validate(roleID, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	role, err := global.UserSrvClient.GetRole(ctx, &proto.RoleRequest{Id: roleID.ID})
+	if err != nil {
+		global.Logger.Info("获得角色失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithData(role, ctx)
+}
+
+//
+// ListRoles
+//  @Description: 获得所有的角色
+//  @param ctx
+//
+func ListRoles(ctx *gin.Context) {
+	roles, err := global.UserSrvClient.ListRoles(ctx, &proto.Empty{})
+	if err != nil {
+		global.Logger.Info("获得角色列表失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithData(roles.Data, ctx)
+}
+
+//
+// ListPermissions
+//  @Description: 获得所有可以分配的权限
+//  @param ctx
+//
+func ListPermissions(ctx *gin.Context) {
+	permissions, err := global.UserSrvClient.ListPermissions(ctx, &proto.Empty{})
+	if err != nil {
+		global.Logger.Info("获得权限列表失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithData(permissions.Data, ctx)
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/user/api/global"
+	"github.com/jimyag/shop/app/user/api/model/request"
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/paseto"
+	"github.com/jimyag/shop/common/utils/validate"
+)
+
+//
+// createTokens
+//  @Description: 使用载荷签发 access token 和 refresh token
+//  @param payload
+//  @return map[string]string 响应给用户的 token
+//  @return *paseto.Payload refresh token 的载荷
+//  @return error
+//
+func createTokens(payload *paseto.Payload) (map[string]string, *paseto.Payload, error) {
+	accessToken, err := global.PasetoMaker.CreateToken(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	refreshToken, refreshPayload, err := global.PasetoMaker.CreateRefreshToken(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	res := make(map[string]string)
+	res["token"] = accessToken
+	res["refresh_token"] = refreshToken
+	return res, refreshPayload, nil
+}
+
+//
+// RefreshToken
+//  @Description: 使用 refresh token 换取新的 token，旧的 refresh token 不能再使用
+//  @param ctx
+//
+func RefreshToken(ctx *gin.Context) {
+	refreshParams := request.RefreshToken{}
+	_ = ctx.ShouldBindJSON(&refreshParams)
+	msg, err := validate.Validate(refreshParams, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	oldPayload, err := global.PasetoMaker.VerifyRefreshToken(refreshParams.RefreshToken)
+	if err != nil {
+		model.FailWithMsg("token 无效，请重新登录", ctx)
+		return
+	}
+	err = global.Revoker.Check(ctx, oldPayload)
+	if errors.Is(err, paseto.ErrRevokedToken) {
+		model.FailWithMsg("token 已失效，请重新登录", ctx)
+		return
+	} else if err != nil {
+		global.Logger.Error("检查token是否吊销失败", zap.Error(err))
+		model.FailWithMsg("系统错误，请稍后重试", ctx)
+		return
+	}
+
+	// 重新获取用户的权限，权限变化后新的 token 会使用新的权限
+	user, err := global.UserSrvClient.GetUserById(ctx, &proto.IdRequest{Id: uint32(oldPayload.UID)})
+	if err != nil {
+		global.Logger.Info("刷新token时查找用户失败", zap.Error(err))
+		model.FailWithMsg("token 无效，请重新登录", ctx)
+		return
+	}
+	role, err := global.UserSrvClient.GetRole(ctx, &proto.RoleRequest{Id: user.Role})
+	if err != nil {
+		global.Logger.Error("刷新token时查找用户角色失败", zap.Error(err))
+		model.FailWithMsg("刷新token失败", ctx)
+		return
+	}
+	res, refreshPayload, err := createTokens(&paseto.Payload{
+		Family:      oldPayload.Family,
+		UID:         user.Id,
+		Role:        user.Role,
+		Permissions: role.Permissions,
+	})
+	if err != nil {
+		global.Logger.Info("创建Token失败", zap.Error(err))
+		model.FailWithMsg("刷新token失败", ctx)
+		return
+	}
+	err = global.Revoker.Rotate(ctx, oldPayload, refreshPayload)
+	if errors.Is(err, paseto.ErrRevokedToken) {
+		global.Logger.Warn("refresh token 被重复使用", zap.Int32("uid", oldPayload.UID), zap.String("family", oldPayload.Family))
+		model.FailWithMsg("token 已失效，请重新登录", ctx)
+		return
+	} else if err != nil {
+		global.Logger.Error("轮换refresh token失败", zap.Error(err))
+		model.FailWithMsg("系统错误，请稍后重试", ctx)
+		return
+	}
+	model.OkWithData(res, ctx)
+}
+
+//
+// Logout
+//  @Description: 退出登录，吊销这次登录签发的所有 token
+//  @param ctx
+//
+func Logout(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+	err = global.Revoker.RevokeFamily(ctx, payload.Family)
+	if err != nil {
+		global.Logger.Error("吊销token失败", zap.Error(err))
+		model.FailWithMsg("系统错误，请稍后重试", ctx)
+		return
+	}
+	model.OkWithMsg("退出登录成功", ctx)
+}
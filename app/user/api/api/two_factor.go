@@ -0,0 +1,187 @@
+package api
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/user/api/global"
+	"github.com/jimyag/shop/app/user/api/model/request"
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/handle_grpc_error"
+	"github.com/jimyag/shop/common/utils/paseto"
+	"github.com/jimyag/shop/common/utils/validate"
+)
+
+//
+// TwoFactorLogin
+//  @Description: 使用密码登录返回的 challenge token 和两步验证的验证码完成登录
+//  @param ctx
+//
+func TwoFactorLogin(ctx *gin.Context) {
+	twoFactorLogin := request.TwoFactorLogin{}
+	_ = ctx.ShouldBindJSON(&twoFactorLogin)
+	msg, err := validate.Validate(twoFactorLogin, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	challenge, err := global.PasetoMaker.VerifyChallengeToken(twoFactorLogin.ChallengeToken)
+	if err != nil {
+		model.FailWithMsg("登录已过期，请重新登录", ctx)
+		return
+	}
+	// challenge token 只能使用一次
+	err = global.Revoker.Check(ctx, challenge)
+	if errors.Is(err, paseto.ErrRevokedToken) {
+		model.FailWithMsg("登录已过期，请重新登录", ctx)
+		return
+	} else if err != nil {
+		global.Logger.Error("检查token是否吊销失败", zap.Error(err))
+		model.FailWithMsg("系统错误，请稍后重试", ctx)
+		return
+	}
+	if !allowRequest(ctx, "login_2fa", strconv.Itoa(int(challenge.UID))) {
+		return
+	}
+
+	user, err := global.UserSrvClient.GetUserById(ctx, &proto.IdRequest{Id: uint32(challenge.UID)})
+	if err != nil {
+		global.Logger.Info("查找用户错误", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	if checkLoginLocked(ctx, user.Email) {
+		return
+	}
+	verified, err := global.UserSrvClient.VerifyTwoFactor(ctx, &proto.TwoFactorCodeRequest{
+		UserID: user.Id,
+		Code:   twoFactorLogin.Code,
+	})
+	if err != nil {
+		global.Logger.Info("两步验证失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	// 验证码错误也计入连续登录失败的次数
+	if !verified.GetSuccess() {
+		loginFailed(ctx, user.Email, "验证码错误")
+		return
+	}
+
+	err = global.Revoker.RevokeFamily(ctx, challenge.Family)
+	if err != nil {
+		global.Logger.Error("吊销challenge token失败", zap.Error(err))
+		model.FailWithMsg("登录失败", ctx)
+		return
+	}
+	completeLogin(ctx, user)
+}
+
+//
+// GetTwoFactorStatus
+//  @Description: 获得当前用户两步验证的状态
+//  @param ctx
+//
+func GetTwoFactorStatus(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	rsp, err := global.UserSrvClient.GetTwoFactorStatus(ctx, &proto.IdRequest{Id: uint32(payload.UID)})
+	if err != nil {
+		global.Logger.Info("获得两步验证状态失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithData(rsp, ctx)
+}
+
+//
+// EnrollTwoFactor
+//  @Description: 为当前用户生成两步验证的密钥，返回 otpauth 的 URI，验证后才会开启
+//  @param ctx
+//
+func EnrollTwoFactor(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+
+	rsp, err := global.UserSrvClient.EnrollTwoFactor(ctx, &proto.IdRequest{Id: uint32(payload.UID)})
+	if err != nil {
+		global.Logger.Info("生成两步验证的密钥失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithData(rsp, ctx)
+}
+
+//
+// EnableTwoFactor
+//  @Description: 使用认证器 App 中的验证码开启两步验证，返回只显示一次的恢复码
+//  @param ctx
+//
+func EnableTwoFactor(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+	twoFactorCode := request.TwoFactorCode{}
+	_ = ctx.ShouldBindJSON(&twoFactorCode)
+	msg, err := validate.Validate(twoFactorCode, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	rsp, err := global.UserSrvClient.EnableTwoFactor(ctx, &proto.TwoFactorCodeRequest{
+		UserID: payload.UID,
+		Code:   twoFactorCode.Code,
+	})
+	if err != nil {
+		global.Logger.Info("开启两步验证失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithDataMsg(rsp, "开启成功，请妥善保存恢复码", ctx)
+}
+
+//
+// DisableTwoFactor
+//  @Description: 使用验证码或恢复码关闭两步验证
+//  @param ctx
+//
+func DisableTwoFactor(ctx *gin.Context) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return
+	}
+	twoFactorCode := request.TwoFactorCode{}
+	_ = ctx.ShouldBindJSON(&twoFactorCode)
+	msg, err := validate.Validate(twoFactorCode, global.Validate, global.Trans)
+	if err != nil {
+		model.FailWithMsg(msg, ctx)
+		return
+	}
+
+	_, err = global.UserSrvClient.DisableTwoFactor(ctx, &proto.TwoFactorCodeRequest{
+		UserID: payload.UID,
+		Code:   twoFactorCode.Code,
+	})
+	if err != nil {
+		global.Logger.Info("关闭两步验证失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	model.OkWithMsg("关闭成功", ctx)
+}
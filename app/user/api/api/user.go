@@ -1,8 +1,9 @@
 package api
 
 import (
-	"context"
+	"errors"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -16,6 +17,8 @@ import (
 	"github.com/jimyag/shop/app/user/api/tools/email"
 	"github.com/jimyag/shop/common/model"
 	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
+	"github.com/jimyag/shop/common/utils/cart_token"
 	"github.com/jimyag/shop/common/utils/handle_grpc_error"
 	"github.com/jimyag/shop/common/utils/paseto"
 	"github.com/jimyag/shop/common/utils/validate"
@@ -79,44 +82,99 @@ func PasswordLogin(ctx *gin.Context) {
 		return
 	}
 
-	// 使用email查询用户
-	user, err := global.UserSrvClient.GetUserByEmail(ctx, &proto.EmailRequest{Email: passwordLoginForm.Email})
-	if err != nil {
-		global.Logger.Info("查找用户错误", zap.Error(err))
-		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
-		//model.FailWithMsg("用户不存在", ctx)
+	// 限制尝试的次数
+	if !allowRequest(ctx, "login", passwordLoginForm.Email) || checkLoginLocked(ctx, passwordLoginForm.Email) {
 		return
 	}
 
-	// 检查用户的密码
-	checkP := proto.PasswordCheckInfo{
-		Password:          passwordLoginForm.Password,
-		EncryptedPassword: user.GetPassword(),
-	}
-	password, err := global.UserSrvClient.CheckPassword(ctx, &checkP)
+	// 在 user 服务中检查邮箱和密码，用户不存在和密码错误返回相同的信息
+	credentials, err := global.UserSrvClient.VerifyCredentials(ctx, &proto.CredentialsRequest{
+		Email:    passwordLoginForm.Email,
+		Password: passwordLoginForm.Password,
+	})
 	if err != nil {
 		global.Logger.Info("用户登录错误", zap.Error(err))
 		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
 		//model.FailWithMsg("登录失败", ctx)
 		return
 	}
-	if !password.GetSuccess() {
-		model.FailWithMsg("邮箱或密码错误", ctx)
+	if !credentials.GetSuccess() {
+		loginFailed(ctx, passwordLoginForm.Email, "邮箱或密码错误")
+		return
+	}
+	user := credentials.GetUser()
+	err = global.LoginLockout.Reset(ctx, strings.ToLower(passwordLoginForm.Email))
+	if err != nil {
+		global.Logger.Error("清除登录失败次数失败", zap.Error(err))
+	}
+
+	// 开启了两步验证时返回 challenge token，使用验证码换取 token
+	twoFactor, err := global.UserSrvClient.GetTwoFactorStatus(ctx, &proto.IdRequest{Id: uint32(user.Id)})
+	if err != nil {
+		global.Logger.Error("获得两步验证状态失败", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	if twoFactor.Enabled {
+		payload, _ := paseto.NewPayload(user.Id, user.Role, nil)
+		challengeToken, err := global.PasetoMaker.CreateChallengeToken(payload)
+		if err != nil {
+			global.Logger.Info("创建Token失败", zap.Error(err))
+			model.FailWithMsg("登录失败", ctx)
+			return
+		}
+		res := make(map[string]interface{})
+		res["two_factor"] = true
+		res["challenge_token"] = challengeToken
+		model.OkWithDataMsg(res, "请输入两步验证的验证码", ctx)
 		return
 	}
 
-	// 生成token
-	payload, _ := paseto.NewPayload(user.Id, user.Role)
-	token, err := global.PasetoMaker.CreateToken(payload)
+	completeLogin(ctx, user)
+}
+
+//
+// completeLogin
+//  @Description: 验证通过后签发 token，合并游客的购物车
+//  @param ctx
+//  @param user
+//
+func completeLogin(ctx *gin.Context, user *proto.UserInfoResponse) {
+	// 生成token，token 中带有用户角色的权限
+	role, err := global.UserSrvClient.GetRole(ctx, &proto.RoleRequest{Id: user.Role})
+	if err != nil {
+		global.Logger.Error("查找用户角色错误", zap.Error(err))
+		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	payload, _ := paseto.NewPayload(user.Id, user.Role, role.Permissions)
+	res, refreshPayload, err := createTokens(payload)
 	if err != nil {
 		global.Logger.Info("创建Token失败", zap.Error(err))
 		model.FailWithMsg("登录失败", ctx)
 		return
 	}
+	err = global.Revoker.Register(ctx, refreshPayload)
+	if err != nil {
+		global.Logger.Error("记录refresh token失败", zap.Error(err))
+		model.FailWithMsg("登录失败", ctx)
+		return
+	}
+
+	// 把游客的购物车合并到用户的购物车，合并失败不影响登录，下次登录时还可以合并
+	if cartToken := cart_token.FromRequest(ctx); cartToken != "" {
+		_, err = global.OrderSrvClient.MergeGuestCart(ctx, &proto.MergeGuestCartRequest{
+			UserID:    user.Id,
+			CartToken: cartToken,
+		})
+		if err != nil {
+			global.Logger.Error("合并购物车失败", zap.Int32("userID", user.Id), zap.Error(err))
+		} else {
+			cart_token.Clear(ctx)
+		}
+	}
 
 	// 响应
-	res := make(map[string]string)
-	res["token"] = token
 	model.OkWithDataMsg(res, "登录成功", ctx)
 }
 
@@ -135,21 +193,21 @@ func CreateUserEmail(ctx *gin.Context) {
 		return
 	}
 
-	// 判断用户是否已经发送过验证码了
-	i, err := global.Redis.CreateUser.Exists(context.Background(), createUserEmail.Email).Result()
-	if i == 1 {
-		model.FailWithMsg("验证码已经发送，请稍后", ctx)
+	if !allowRequest(ctx, "register_email", createUserEmail.Email) {
 		return
 	}
 
-	// 没有发送
+	// 没有发送过验证码时才发送
 	code := auth_code.Code()
-	_, err = global.Redis.CreateUser.SetEX(context.Background(),
+	err = auth_code.Save(ctx, global.Redis.CreateUser,
 		createUserEmail.Email,
 		code,
 		time.Duration(global.RemoteConfig.Timeout.CreateUserEmail)*time.Minute,
-	).Result()
-	if err != nil {
+	)
+	if errors.Is(err, auth_code.ErrCodeSent) {
+		model.FailWithMsg("验证码已经发送，请稍后", ctx)
+		return
+	} else if err != nil {
 		global.Logger.Info("注册验证码写入redis失败", zap.Error(err))
 		model.FailWithMsg("验证码发送失败，请稍后重试", ctx)
 		return
@@ -183,17 +241,22 @@ func CreateUser(ctx *gin.Context) {
 		return
 	}
 
-	// 是否发送过验证码
-	i, _ := global.Redis.CreateUser.Exists(ctx, createUserParams.Email).Result()
-	if i == 0 {
-		model.FailWithMsg("请先发送验证码", ctx)
+	if !allowRequest(ctx, "register", createUserParams.Email) {
 		return
 	}
 
-	// 有 判断和发送来的是否一样
-	i, _ = global.Redis.CreateUser.Get(ctx, createUserParams.Email).Int64()
-	if i != int64(createUserParams.AuthCode) {
-		model.FailWithMsg("验证码错误", ctx)
+	// 验证码只能使用一次，输错多次后验证码失效
+	err = auth_code.Verify(ctx, global.Redis.CreateUser,
+		createUserParams.Email,
+		createUserParams.AuthCode,
+		global.RemoteConfig.RateLimit.CodeMaxAttempts,
+	)
+	if errors.Is(err, auth_code.ErrCodeInvalid) {
+		model.FailWithMsg("验证码错误或已失效", ctx)
+		return
+	} else if err != nil {
+		global.Logger.Error("检查注册的验证码失败", zap.Error(err))
+		model.FailWithMsg("系统错误，请稍后重试", ctx)
 		return
 	}
 
@@ -204,7 +267,7 @@ func CreateUser(ctx *gin.Context) {
 		Password: createUserParams.RePassword,
 		Nickname: createUserParams.Nickname,
 		Gender:   createUserParams.Gender,
-		Role:     createUserParams.Role,
+		Role:     auth.RoleUser, // 注册的都是普通用户，角色只能由管理员修改
 	}
 	_, err = global.UserSrvClient.CreateUser(ctx, &createUserRequest)
 	if err != nil {
@@ -214,8 +277,6 @@ func CreateUser(ctx *gin.Context) {
 		return
 	}
 
-	// 创建用户之后删除验证码
-	global.Redis.CreateUser.Del(ctx, createUserParams.Email)
 	model.OkWithMsg("创建用户成功", ctx)
 }
 
@@ -268,9 +329,13 @@ func GetUserByID(ctx *gin.Context) {
 		model.FailWithMsg(msg, ctx)
 		return
 	}
+	uid, ok := auth.ResolveUserID(ctx, int32(userByIDParam.ID), auth.PermUserReadAll)
+	if !ok {
+		return
+	}
 
 	// 获得用户信息
-	user, err := global.UserSrvClient.GetUserById(ctx, &proto.IdRequest{Id: userByIDParam.ID})
+	user, err := global.UserSrvClient.GetUserById(ctx, &proto.IdRequest{Id: uint32(uid)})
 	if err != nil {
 		global.Logger.Error("使用ID查询用户失败", zap.Error(err))
 		handle_grpc_error.HandleGrpcErrorToHttp(err, ctx)
@@ -303,6 +368,11 @@ func UpdateUserWithOutPassword(ctx *gin.Context) {
 		model.FailWithMsg(msg, ctx)
 		return
 	}
+	uid, ok := auth.ResolveUserID(ctx, updateUserParams.Id, auth.PermUserWriteAll)
+	if !ok {
+		return
+	}
+	updateUserParams.Id = uid
 
 	// 更新用户信息
 	arg := proto.UpdateUserRequest{}
@@ -321,7 +391,7 @@ func UpdateUserWithOutPassword(ctx *gin.Context) {
 		return
 	}
 
-	model.OkWithData(user, ctx)
+	model.OkWithData(response.NewUserInfo(user), ctx)
 }
 
 //
@@ -338,6 +408,11 @@ func ChangePassword(ctx *gin.Context) {
 		model.FailWithMsg(msg, ctx)
 		return
 	}
+	uid, ok := auth.ResolveUserID(ctx, changePassword.Id, auth.PermUserWriteAll)
+	if !ok {
+		return
+	}
+	changePassword.Id = uid
 
 	arg := proto.UpdateUserRequest{}
 	err = copier.Copy(&arg, &changePassword)
@@ -356,7 +431,13 @@ func ChangePassword(ctx *gin.Context) {
 		return
 	}
 
-	model.OkWithData(user, ctx)
+	// 修改密码后之前签发的 token 都不能再使用
+	err = global.Revoker.RevokeUser(ctx, arg.Id)
+	if err != nil {
+		global.Logger.Error("吊销用户的token失败", zap.Error(err))
+	}
+
+	model.OkWithData(response.NewUserInfo(user), ctx)
 }
 
 //
@@ -388,5 +469,14 @@ func ChangeRole(ctx *gin.Context) {
 		//model.FailWithMsg("系统错误，请稍后重试", ctx)
 		return
 	}
-	model.OkWithData(user, ctx)
+
+	// 权限变化后需要重新登录，旧的 token 中还是原来的权限
+	// 吊销失败时旧的 token 还有原来的权限，不能返回成功
+	err = global.Revoker.RevokeUser(ctx, arg.Id)
+	if err != nil {
+		global.Logger.Error("吊销用户的token失败", zap.Int32("uid", arg.Id), zap.Error(err))
+		model.FailWithMsg("角色已修改，但旧的登录状态没有失效，请重试", ctx)
+		return
+	}
+	model.OkWithData(response.NewUserInfo(user), ctx)
 }
@@ -24,9 +24,10 @@ type JaegerConfig struct {
 //  @Description: token的配置
 //
 type Secret struct {
-	PrivateKey string `mapstructure:"private-key"` // token的 私钥
-	PublicKey  string `mapstructure:"public-key"`  // token的 公钥
-	Duration   int    `mapstructure:"duration"`    // token的过期时间 单位 小时
+	PrivateKey      string `mapstructure:"private-key"`      // token的 私钥
+	PublicKey       string `mapstructure:"public-key"`       // token的 公钥
+	Duration        int    `mapstructure:"duration"`         // access token的过期时间 单位 分钟
+	RefreshDuration int    `mapstructure:"refresh-duration"` // refresh token的过期时间 单位 小时
 }
 
 //
@@ -56,7 +57,21 @@ type Email struct {
 //  @Description: 所有超时的配置
 //
 type Timeout struct {
-	CreateUserEmail int `mapstructure:"create-user-email"` // 多少分钟
+	CreateUserEmail    int `mapstructure:"create-user-email"`    // 多少分钟
+	ResetPasswordEmail int `mapstructure:"reset-password-email"` // 重置密码的验证码的有效期 多少分钟
+}
+
+//
+// RateLimit
+//  @Description: 登录和验证码的防暴力破解配置
+//
+type RateLimit struct {
+	IPPerMinute     int `mapstructure:"ip-per-minute"`     // 每个 IP 每分钟最多的请求次数
+	EmailPerMinute  int `mapstructure:"email-per-minute"`  // 每个邮箱每分钟最多的请求次数
+	LockThreshold   int `mapstructure:"lock-threshold"`    // 连续登录失败多少次后锁定账号
+	LockMinutes     int `mapstructure:"lock-minutes"`      // 第一次锁定的时间 之后每次失败翻倍
+	MaxLockMinutes  int `mapstructure:"max-lock-minutes"`  // 最长的锁定时间
+	CodeMaxAttempts int `mapstructure:"code-max-attempts"` // 验证码最多可以输错的次数 之后验证码失效
 }
 
 type GrpcServer struct {
@@ -68,11 +83,13 @@ type GrpcServer struct {
 //  @Description: 整个服务的配置
 //
 type ServerInfo struct {
-	ServiceInfo    ServiceInfo  `mapstructure:"server-info"`      // 本地服务的信息
-	JaegerInfo     JaegerConfig `mapstructure:"jaeger-info"`      // jaeger 的 配置
-	Secret         Secret       `mapstructure:"secret"`           // token 密钥
-	Redis          RedisInfo    `mapstructure:"redis"`            // redis 的配置
-	Email          Email        `mapstructure:"email"`            // 邮件的信息
-	Timeout        Timeout      `mapstructure:"timeout"`          // 各种超时配置
-	UserGrpcServer GrpcServer   `mapstructure:"user-grpc-server"` // user grpc server 的配置
+	ServiceInfo     ServiceInfo  `mapstructure:"server-info"`       // 本地服务的信息
+	JaegerInfo      JaegerConfig `mapstructure:"jaeger-info"`       // jaeger 的 配置
+	Secret          Secret       `mapstructure:"secret"`            // token 密钥
+	Redis           RedisInfo    `mapstructure:"redis"`             // redis 的配置
+	Email           Email        `mapstructure:"email"`             // 邮件的信息
+	Timeout         Timeout      `mapstructure:"timeout"`           // 各种超时配置
+	RateLimit       RateLimit    `mapstructure:"rate-limit"`        // 防暴力破解的配置
+	UserGrpcServer  GrpcServer   `mapstructure:"user-grpc-server"`  // user grpc server 的配置
+	OrderGrpcServer GrpcServer   `mapstructure:"order-grpc-server"` // order grpc server 的配置 用于登录后合并购物车和导出用户数据
 }
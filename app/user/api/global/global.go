@@ -9,20 +9,29 @@ import (
 	"github.com/jimyag/shop/app/user/api/config"
 	"github.com/jimyag/shop/common/model"
 	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
 	"github.com/jimyag/shop/common/utils/paseto"
+	"github.com/jimyag/shop/common/utils/ratelimit"
 )
 
 var (
-	Logger        *zap.Logger             // logger
-	ConfigCenter  *model.ConfigCenterInfo // 配置中心的配置
-	RemoteConfig  *config.ServerInfo      // 配置中心中的配置
-	UserSrvClient proto.UserClient        // user grpc 服务的客户端
-	Redis         *AllRedis               // 所有的redis的配置
-	Trans         ut.Translator           // 公共的翻译
-	Validate      *validator.Validate     // 公共的validate
-	PasetoMaker   *paseto.PasetoMaker     // paseto 的maker
+	Logger         *zap.Logger             // logger
+	ConfigCenter   *model.ConfigCenterInfo // 配置中心的配置
+	RemoteConfig   *config.ServerInfo      // 配置中心中的配置
+	UserSrvClient  proto.UserClient        // user grpc 服务的客户端
+	OrderSrvClient proto.OrderClient       // order grpc 服务的客户端
+	Redis          *AllRedis               // 所有的redis的配置
+	Trans          ut.Translator           // 公共的翻译
+	Validate       *validator.Validate     // 公共的validate
+	PasetoMaker    *paseto.PasetoMaker     // paseto 的maker
+	Revoker        *paseto.Revoker         // 检查 token 是否被吊销
+	Auth           *auth.Authenticator     // 验证 token 的中间件
+	IPLimiter      *ratelimit.Limiter      // 按照 IP 限制登录和验证码的请求
+	EmailLimiter   *ratelimit.Limiter      // 按照邮箱限制登录和验证码的请求
+	LoginLockout   *ratelimit.Lockout      // 连续登录失败后锁定账号
 )
 
 type AllRedis struct {
-	CreateUser *redis.Client
+	CreateUser    *redis.Client
+	ResetPassword *redis.Client // 重置密码的验证码
 }
@@ -0,0 +1,62 @@
+package initialize
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/user/api/global"
+	"github.com/jimyag/shop/common/utils/ratelimit"
+)
+
+//
+// InitRateLimit
+//  @Description: 初始化登录和验证码的限流和账号锁定
+//
+func InitRateLimit() {
+	cfg := global.RemoteConfig.RateLimit
+	if cfg.IPPerMinute == 0 {
+		cfg.IPPerMinute = 30
+	}
+	if cfg.EmailPerMinute == 0 {
+		cfg.EmailPerMinute = 10
+	}
+	if cfg.LockThreshold == 0 {
+		cfg.LockThreshold = 5
+	}
+	if cfg.LockMinutes == 0 {
+		cfg.LockMinutes = 1
+	}
+	if cfg.MaxLockMinutes == 0 {
+		cfg.MaxLockMinutes = 60
+	}
+	if cfg.CodeMaxAttempts == 0 {
+		cfg.CodeMaxAttempts = 5
+	}
+	global.RemoteConfig.RateLimit = cfg
+
+	client := redis.NewClient(&redis.Options{
+		Addr: fmt.Sprintf(
+			"%s:%d",
+			global.RemoteConfig.Redis.Host,
+			global.RemoteConfig.Redis.Port,
+		),
+		DB: 0,
+	})
+	// redis 不可用时限流使用内存中的记录，不影响启动
+	_, err := client.Ping(context.Background()).Result()
+	if err != nil {
+		global.Logger.Error("初始化限流的redis失败，使用内存限流", zap.Error(err))
+	}
+	global.IPLimiter = ratelimit.NewLimiter(client, "limit:ip:", cfg.IPPerMinute, time.Minute)
+	global.EmailLimiter = ratelimit.NewLimiter(client, "limit:email:", cfg.EmailPerMinute, time.Minute)
+	global.LoginLockout = ratelimit.NewLockout(client, "login:",
+		cfg.LockThreshold,
+		time.Duration(cfg.LockMinutes)*time.Minute,
+		time.Duration(cfg.MaxLockMinutes)*time.Minute,
+	)
+	global.Logger.Info("初始化限流成功......")
+}
@@ -28,5 +28,18 @@ func InitRedis() {
 	if err != nil {
 		global.Logger.Fatal("初始化创建用户的邮箱验证码redis失败", zap.Error(err))
 	}
+	// 重置密码的验证码和注册的验证码都使用邮箱作为 key，放在不同的库中
+	allRedis.ResetPassword = redis.NewClient(&redis.Options{
+		Addr: fmt.Sprintf(
+			"%s:%d",
+			global.RemoteConfig.Redis.Host,
+			global.RemoteConfig.Redis.Port,
+		),
+		DB: 1,
+	})
+	_, err = allRedis.ResetPassword.Ping(context.Background()).Result()
+	if err != nil {
+		global.Logger.Fatal("初始化重置密码的邮箱验证码redis失败", zap.Error(err))
+	}
 	global.Redis = &allRedis
 }
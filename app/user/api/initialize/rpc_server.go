@@ -10,6 +10,7 @@ import (
 
 	"github.com/jimyag/shop/app/user/api/global"
 	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
 	"github.com/jimyag/shop/common/utils/otgrpc"
 )
 
@@ -19,6 +20,7 @@ import (
 //
 func InitGrpcClient() {
 	initUserClient()
+	initOrderClient()
 }
 
 //
@@ -35,10 +37,12 @@ func initUserClient() {
 		),
 		grpc.WithInsecure(),
 		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
-		grpc.WithUnaryInterceptor(
+		grpc.WithChainUnaryInterceptor(
 			otgrpc.OpenTracingClientInterceptor(
 				opentracing.GlobalTracer(),
 			),
+			// 把当前用户的身份传给 rpc 服务
+			auth.UnaryClientInterceptor(),
 		),
 	)
 
@@ -49,3 +53,34 @@ func initUserClient() {
 
 	global.Logger.Info("发现用户服务......")
 }
+
+//
+// initOrderClient
+//  @Description: 初始化 order client
+//
+func initOrderClient() {
+	conn, err := grpc.Dial(
+		fmt.Sprintf("%s://%s:%d/%s?wait=14s",
+			global.ConfigCenter.Type,
+			global.ConfigCenter.Host,
+			global.ConfigCenter.Port,
+			global.RemoteConfig.OrderGrpcServer.Name,
+		),
+		grpc.WithInsecure(),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+		grpc.WithChainUnaryInterceptor(
+			otgrpc.OpenTracingClientInterceptor(
+				opentracing.GlobalTracer(),
+			),
+			// 把当前用户的身份传给 rpc 服务
+			auth.UnaryClientInterceptor(),
+		),
+	)
+
+	if err != nil {
+		global.Logger.Fatal("订单服务发现错误", zap.Error(err))
+	}
+	global.OrderSrvClient = proto.NewOrderClient(conn)
+
+	global.Logger.Info("发现订单服务......")
+}
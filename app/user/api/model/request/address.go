@@ -0,0 +1,34 @@
+package request
+
+//
+// CreateAddress
+//  @Description: 添加收货地址的参数
+//
+type CreateAddress struct {
+	Name      string `json:"name" validate:"required,max=40" label:"收货人"`
+	Mobile    string `json:"mobile" validate:"required,max=20" label:"手机号"`
+	Address   string `json:"address" validate:"required" label:"收货地址"`
+	Post      string `json:"post" validate:"required" label:"邮编"`
+	IsDefault bool   `json:"is_default" label:"默认地址"`
+}
+
+//
+// UpdateAddress
+//  @Description: 更新收货地址的参数 为空的字段保持原来的值
+//
+type UpdateAddress struct {
+	ID        int32  `json:"id" validate:"required,min=1" label:"地址ID"`
+	Name      string `json:"name" validate:"max=40" label:"收货人"`
+	Mobile    string `json:"mobile" validate:"max=20" label:"手机号"`
+	Address   string `json:"address" label:"收货地址"`
+	Post      string `json:"post" label:"邮编"`
+	IsDefault bool   `json:"is_default" label:"默认地址"`
+}
+
+//
+// AddressID
+//  @Description: 收货地址的id
+//
+type AddressID struct {
+	ID int32 `json:"id" validate:"required,min=1" label:"地址ID"`
+}
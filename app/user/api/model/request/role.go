@@ -0,0 +1,28 @@
+package request
+
+//
+// CreateRole
+//  @Description: 创建角色的参数
+//
+type CreateRole struct {
+	Name        string   `json:"name" validate:"required,max=40" label:"角色名称"`
+	Description string   `json:"description" validate:"max=200" label:"角色描述"`
+	Permissions []string `json:"permissions" label:"权限"`
+}
+
+//
+// UpdateRolePermissions
+//  @Description: 修改角色权限的参数，使用新的权限替换原来所有的权限
+//
+type UpdateRolePermissions struct {
+	ID          int32    `json:"id" validate:"required,min=1" label:"角色ID"`
+	Permissions []string `json:"permissions" label:"权限"`
+}
+
+//
+// RoleID
+//  @Description: 角色的id
+//
+type RoleID struct {
+	ID int32 `json:"id" form:"id" validate:"required,min=1" label:"角色ID"`
+}
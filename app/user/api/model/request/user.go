@@ -9,6 +9,23 @@ type PasswordLoginForm struct {
 	Password string `json:"password" validate:"required,min=6,max=20" label:"您的密码"`
 }
 
+//
+// TwoFactorLogin
+//  @Description: 使用两步验证的验证码完成登录的参数
+//
+type TwoFactorLogin struct {
+	ChallengeToken string `json:"challenge_token" validate:"required" label:"challenge token"`
+	Code           string `json:"code" validate:"required,min=6,max=20" label:"验证码"`
+}
+
+//
+// TwoFactorCode
+//  @Description: 开启和关闭两步验证的参数 可以是 TOTP 或恢复码
+//
+type TwoFactorCode struct {
+	Code string `json:"code" validate:"required,min=6,max=20" label:"验证码"`
+}
+
 //
 // CreateUser
 //  @Description: 创建用户的参数
@@ -19,7 +36,6 @@ type CreateUser struct {
 	RePassword string `json:"re_password" validate:"required,eqfield=Password" label:"确认密码"`
 	Nickname   string `json:"nickname" validate:"required,min=6,max=20" label:"昵称"`
 	Gender     string `json:"gender" validate:"required,oneof=male femal" label:"性别"`
-	Role       int32  `json:"role" validate:"required,oneof=1 2" label:"权限"`
 	AuthCode   int    `json:"auth_code" validate:"required,min=10000,max=99999" label:"验证码"`
 }
 
@@ -31,6 +47,25 @@ type CreateUserEmail struct {
 	Email string `json:"email" validate:"required,email" label:"邮件"`
 }
 
+//
+// ForgotPassword
+//  @Description: 忘记密码时发送验证码的参数
+//
+type ForgotPassword struct {
+	Email string `json:"email" validate:"required,email" label:"邮件"`
+}
+
+//
+// ResetPassword
+//  @Description: 使用邮箱验证码重置密码的参数
+//
+type ResetPassword struct {
+	Email      string `json:"email" validate:"required,email" label:"邮件"`
+	Password   string `json:"password" validate:"required,min=6,max=20" label:"您的密码"`
+	RePassword string `json:"re_password" validate:"required,eqfield=Password" label:"确认密码"`
+	AuthCode   int    `json:"auth_code" validate:"required,min=10000,max=99999" label:"验证码"`
+}
+
 //
 // GetUserByEmail
 //  @Description: 通过邮箱获得用户信息的参数
@@ -44,7 +79,7 @@ type GetUserByEmail struct {
 //  @Description: 通过uid获得用户信息的参数
 //
 type GetUserByID struct {
-	ID uint32 `json:"ID" validate:"required,min=1"`
+	ID uint32 `json:"ID" validate:"omitempty,min=1"` // 不传时为当前用户
 }
 
 //
@@ -52,7 +87,7 @@ type GetUserByID struct {
 //  @Description: 更新用户的nickname 和gender
 //
 type UpdateUserWithoutPwd struct {
-	Id       int32  `json:"id" validate:"required,min=1"`
+	Id       int32  `json:"id" validate:"omitempty,min=1"` // 不传时为当前用户
 	Nickname string `json:"nickname"`
 	Gender   string `json:"gender"`
 }
@@ -62,7 +97,7 @@ type UpdateUserWithoutPwd struct {
 //  @Description: 修改用的密码
 //
 type ChangePassword struct {
-	Id         int32  `json:"id" validate:"required,min=1"`
+	Id         int32  `json:"id" validate:"omitempty,min=1"` // 不传时为当前用户
 	Password   string `json:"password" validate:"required,min=6,max=20" label:"您的密码"`
 	RePassword string `json:"re_password" validate:"required,eqfield=Password" label:"确认密码"`
 }
@@ -73,7 +108,7 @@ type ChangePassword struct {
 //
 type ChangeRole struct {
 	Id   int32 `json:"id" validate:"required,min=1"`
-	Role int32 `json:"role" validate:"required,oneof=1 2" label:"权限"`
+	Role int32 `json:"role" validate:"required,min=1" label:"角色"`
 }
 
 //
@@ -84,3 +119,27 @@ type GetUserList struct {
 	PageNum  int `json:"page_num" form:"page_num" validate:"required,min=1"`
 	PageSize int `json:"page_size" form:"page_size" validate:"required,min=1"`
 }
+
+//
+// RefreshToken
+//  @Description: 使用 refresh token 换取新的 token 的参数
+//
+type RefreshToken struct {
+	RefreshToken string `json:"refresh_token" validate:"required" label:"refresh token"`
+}
+
+//
+// DeleteAccount
+//  @Description: 注销账号的参数
+//
+type DeleteAccount struct {
+	Id int32 `json:"id" validate:"omitempty,min=1"` // 不传时为当前用户
+}
+
+//
+// RestoreUser
+//  @Description: 恢复被注销的账号的参数
+//
+type RestoreUser struct {
+	Id int32 `json:"id" validate:"required,min=1"`
+}
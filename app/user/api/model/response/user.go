@@ -1,5 +1,7 @@
 package response
 
+import "github.com/jimyag/shop/common/proto"
+
 //
 // GetUserInfoResponse
 //  @Description: 用户信息的响应参数
@@ -13,3 +15,36 @@ type GetUserInfoResponse struct {
 	Gender    string `json:"gender"`
 	Role      int32  `json:"role"`
 }
+
+//
+// NewUserInfo
+//  @Description: 把 user 服务返回的用户信息转换为响应，所有返回用户信息的接口都使用它
+//  @param user
+//  @return GetUserInfoResponse
+//
+func NewUserInfo(user *proto.UserInfoResponse) GetUserInfoResponse {
+	return GetUserInfoResponse{
+		Id:        user.GetId(),
+		CreatedAt: user.GetCreatedAt(),
+		UpdatedAt: user.GetUpdatedAt(),
+		Email:     user.GetEmail(),
+		Nickname:  user.GetNickname(),
+		Gender:    user.GetGender(),
+		Role:      user.GetRole(),
+	}
+}
+
+//
+// UserDataExport
+//  @Description: 导出的用户数据
+//
+type UserDataExport struct {
+	ExportedAt int64                          `json:"exported_at"`
+	Profile    GetUserInfoResponse            `json:"profile"`   // 用户信息
+	Addresses  []*proto.AddressInfo           `json:"addresses"` // 收货地址
+	Cart       []*proto.ShopCartInfoResponse  `json:"cart"`      // 购物车中的商品
+	Wishlist   []*proto.WishlistItemInfo      `json:"wishlist"`  // 收藏的商品
+	Coupons    []*proto.UserCouponInfo        `json:"coupons"`   // 领取的优惠券
+	Orders     []*proto.OrderDetailResponse   `json:"orders"`    // 订单和订单中的商品
+	Shipments  []*proto.OrderTrackingResponse `json:"shipments"` // 已发货订单的包裹和物流事件
+}
@@ -4,7 +4,9 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/jimyag/shop/app/user/api/api"
+	"github.com/jimyag/shop/app/user/api/global"
 	"github.com/jimyag/shop/app/user/api/middlewares"
+	"github.com/jimyag/shop/common/utils/auth"
 )
 
 func UserRouter(router *gin.RouterGroup) {
@@ -14,26 +16,74 @@ func UserRouter(router *gin.RouterGroup) {
 	{
 		// 使用邮箱和密码登录
 		publicRouter.POST("login", api.PasswordLogin)
+		// 开启了两步验证时使用验证码完成登录
+		publicRouter.POST("login/2fa", api.TwoFactorLogin)
 		// 注册用户之前发送的验证码
 		publicRouter.POST("email/register", api.CreateUserEmail)
 		// 注册用户
 		publicRouter.POST("register", api.CreateUser)
+		// 使用 refresh token 换取新的 token
+		publicRouter.POST("refresh", api.RefreshToken)
+		// 忘记密码时发送验证码
+		publicRouter.POST("password/forgot", api.ForgotPassword)
+		// 使用验证码重置密码
+		publicRouter.POST("password/reset", api.ResetPassword)
 	}
 
 	privateRouter := baseRouter.Group("user")
-	privateRouter.Use(middlewares.Paseto())
+	privateRouter.Use(global.Auth.Paseto())
 	{
+		// 退出登录
+		privateRouter.POST("logout", api.Logout)
 		// 通过使用uid获得用户的信息
 		privateRouter.GET("info", api.GetUserByID)
 		// 通过使用email获得用户的信息
 		privateRouter.GET("info_email", api.GetUserByEmail)
 		// 获得用户列表
-		privateRouter.GET("list", api.GetUserList)
+		privateRouter.GET("list", auth.RequirePermission(auth.PermUserReadAll), api.GetUserList)
 		// 更新用户的nickname 和gender
 		privateRouter.PUT("info", api.UpdateUserWithOutPassword)
 		// 更新用户的密码
 		privateRouter.PUT("password", api.ChangePassword)
 		// 更新用户的权限
-		privateRouter.PUT("role", api.ChangeRole)
+		privateRouter.PUT("role", auth.RequirePermission(auth.PermRoleManage), api.ChangeRole)
+		// 注销账号
+		privateRouter.DELETE("account", api.DeleteAccount)
+		// 恢复被注销的账号
+		privateRouter.POST("restore", auth.RequirePermission(auth.PermUserWriteAll), api.RestoreUser)
+		// 导出当前用户的所有数据
+		privateRouter.GET("export", api.ExportUserData)
+		// 获得两步验证的状态
+		privateRouter.GET("2fa", api.GetTwoFactorStatus)
+		// 生成两步验证的密钥
+		privateRouter.POST("2fa/enroll", api.EnrollTwoFactor)
+		// 验证后开启两步验证
+		privateRouter.POST("2fa/enable", api.EnableTwoFactor)
+		// 关闭两步验证
+		privateRouter.POST("2fa/disable", api.DisableTwoFactor)
+		// 获得当前用户的收货地址
+		privateRouter.GET("address", api.GetAddressList)
+		// 添加收货地址
+		privateRouter.POST("address", api.CreateAddress)
+		// 更新收货地址
+		privateRouter.PUT("address", api.UpdateAddress)
+		// 删除收货地址
+		privateRouter.DELETE("address", api.DeleteAddress)
+	}
+	roleRouter := baseRouter.Group("role")
+	roleRouter.Use(global.Auth.Paseto(), auth.RequirePermission(auth.PermRoleManage))
+	{
+		// 获得所有的角色
+		roleRouter.GET("list", api.ListRoles)
+		// 获得所有可以分配的权限
+		roleRouter.GET("permissions", api.ListPermissions)
+		// 获得角色和它的权限
+		roleRouter.GET("", api.GetRole)
+		// 创建角色
+		roleRouter.POST("", api.CreateRole)
+		// 修改角色的权限
+		roleRouter.PUT("permissions", api.UpdateRolePermissions)
+		// 删除角色
+		roleRouter.DELETE("", api.DeleteRole)
 	}
 }
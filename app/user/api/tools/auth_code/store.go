@@ -0,0 +1,84 @@
+package auth_code
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+var (
+	ErrCodeSent    = errors.New("验证码已经发送")
+	ErrCodeInvalid = errors.New("验证码错误或已失效")
+)
+
+// 没有验证码时才保存，验证码和错误次数保存在同一个 hash 中
+var saveScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'code', ARGV[1], 'attempts', 0)
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+return 1
+`)
+
+// 验证成功后删除验证码，错误的次数达到上限后也删除验证码
+var verifyScript = redis.NewScript(`
+local code = redis.call('HGET', KEYS[1], 'code')
+if not code then
+	return -1
+end
+if code == ARGV[1] then
+	redis.call('DEL', KEYS[1])
+	return 1
+end
+local attempts = redis.call('HINCRBY', KEYS[1], 'attempts', 1)
+if attempts >= tonumber(ARGV[2]) then
+	redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+//
+// Save
+//  @Description: 保存验证码，验证码还没有过期时返回 ErrCodeSent
+//  @param ctx
+//  @param client
+//  @param key
+//  @param code
+//  @param expiration
+//  @return error
+//
+func Save(ctx context.Context, client *redis.Client, key string, code int, expiration time.Duration) error {
+	saved, err := saveScript.Run(ctx, client, []string{key}, code, expiration.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+	if saved == 0 {
+		return ErrCodeSent
+	}
+	return nil
+}
+
+//
+// Verify
+//  @Description: 检查验证码，验证码只能使用一次，错误 maxAttempts 次后验证码失效
+//  @param ctx
+//  @param client
+//  @param key
+//  @param code
+//  @param maxAttempts
+//  @return error 验证码错误或者不存在时返回 ErrCodeInvalid
+//
+func Verify(ctx context.Context, client *redis.Client, key string, code int, maxAttempts int) error {
+	result, err := verifyScript.Run(ctx, client, []string{key}, strconv.Itoa(code), maxAttempts).Int()
+	if err != nil {
+		return err
+	}
+	if result != 1 {
+		return ErrCodeInvalid
+	}
+	return nil
+}
@@ -27,18 +27,21 @@ func main() {
 	// 初始化 grpc 的 client
 	initialize.InitGrpcClient()
 
+	// 初始化Paseto 路由中使用的认证中间件依赖它
+	initialize.InitPaseto()
+
 	// 初始化router
 	router := initialize.InitRouter()
 
 	// 初始化 redis 的配置
 	initialize.InitRedis()
 
+	// 初始化登录和验证码的限流
+	initialize.InitRateLimit()
+
 	// 初始化 validate 和 trans
 	initialize.InitValidateAndTrans()
 
-	// 初始化Paseto
-	initialize.InitPaseto()
-
 	registerClient := consul.NewRegistryHttpClient(
 		global.ConfigCenter.Host,
 		global.ConfigCenter.Port,
@@ -32,12 +32,27 @@ type JaegerConfig struct {
 	Port int    `mapstructure:"port"` //port
 }
 
+//
+// PasswordHash
+//  @Description: 保存密码使用的算法和参数，登录时会把其他算法保存的密码更新为这里的算法
+//
+type PasswordHash struct {
+	Algorithm        string `mapstructure:"algorithm"`         // argon2id bcrypt pbkdf2
+	Argon2Memory     uint32 `mapstructure:"argon2-memory"`     // argon2id 使用的内存 单位 KiB
+	Argon2Time       uint32 `mapstructure:"argon2-time"`       // argon2id 的迭代次数
+	Argon2Threads    uint8  `mapstructure:"argon2-threads"`    // argon2id 的线程数
+	BcryptCost       int    `mapstructure:"bcrypt-cost"`       // bcrypt 的 cost
+	PBKDF2Iterations int    `mapstructure:"pbkdf2-iterations"` // pbkdf2 的迭代次数
+	LegacyIterations int    `mapstructure:"legacy-iterations"` // 原来没有记录迭代次数的 pbkdf2 密码使用的迭代次数
+}
+
 //
 // ALLConfig
 //  @Description: 需要用的远程配置文件
 //
 type ALLConfig struct {
-	Postgres    Postgres     `mapstructure:"postgres"`     // postgres 的配置
-	ServiceInfo ServiceInfo  `mapstructure:"service-info"` // 服务的配置
-	JaegerInfo  JaegerConfig `mapstructure:"jaeger-info"`  // jaeger的配置文件
+	Postgres     Postgres     `mapstructure:"postgres"`      // postgres 的配置
+	ServiceInfo  ServiceInfo  `mapstructure:"service-info"`  // 服务的配置
+	JaegerInfo   JaegerConfig `mapstructure:"jaeger-info"`   // jaeger的配置文件
+	PasswordHash PasswordHash `mapstructure:"password-hash"` // 保存密码的配置
 }
@@ -0,0 +1,23 @@
+CREATE TABLE "user_address"
+(
+    "id"         bigserial PRIMARY KEY,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now()),
+    "deleted_at" timestamptz          DEFAULT null,
+    "user_id"    int8        NOT NULL,
+    "name"       varchar(40) NOT NULL,
+    "mobile"     varchar(20) NOT NULL,
+    "address"    varchar     NOT NULL,
+    "post"       varchar     NOT NULL,
+    "is_default" boolean     NOT NULL DEFAULT false
+);
+
+CREATE INDEX ON "user_address" ("user_id");
+
+COMMENT ON COLUMN "user_address"."name" IS 'signer name';
+
+COMMENT ON COLUMN "user_address"."mobile" IS 'signer mobile';
+
+COMMENT ON COLUMN "user_address"."post" IS 'post code';
+
+COMMENT ON COLUMN "user_address"."is_default" IS 'default address of the user';
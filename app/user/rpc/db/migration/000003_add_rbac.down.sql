@@ -0,0 +1,8 @@
+ALTER TABLE "user"
+    DROP CONSTRAINT IF EXISTS "user_role_fkey";
+
+COMMENT ON COLUMN "user"."role" IS '1 user 2 admin';
+
+DROP TABLE IF EXISTS "role_permission";
+DROP TABLE IF EXISTS "permission";
+DROP TABLE IF EXISTS "role";
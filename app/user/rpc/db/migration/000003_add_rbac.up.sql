@@ -0,0 +1,54 @@
+CREATE TABLE "role"
+(
+    "id"          bigserial PRIMARY KEY,
+    "created_at"  timestamptz    NOT NULL DEFAULT (now()),
+    "name"        varchar UNIQUE NOT NULL,
+    "description" varchar        NOT NULL DEFAULT ''
+);
+
+CREATE TABLE "permission"
+(
+    "id"          bigserial PRIMARY KEY,
+    "code"        varchar UNIQUE NOT NULL,
+    "description" varchar        NOT NULL DEFAULT ''
+);
+
+CREATE TABLE "role_permission"
+(
+    "role_id"       int8 NOT NULL REFERENCES "role" ("id") ON DELETE CASCADE,
+    "permission_id" int8 NOT NULL REFERENCES "permission" ("id") ON DELETE CASCADE,
+    PRIMARY KEY ("role_id", "permission_id")
+);
+
+COMMENT ON COLUMN "permission"."code" IS 'resource:action';
+
+-- 原来的 1 user 2 admin 迁移为 id 相同的两个角色
+INSERT INTO "role"(id, name, description)
+VALUES (1, 'user', '普通用户'),
+       (2, 'admin', '管理员');
+SELECT setval(pg_get_serial_sequence('role', 'id'), 2);
+
+INSERT INTO "permission"(code, description)
+VALUES ('goods:write', '创建、修改和删除商品'),
+       ('coupon:write', '创建优惠券模板'),
+       ('shipment:write', '订单发货'),
+       ('order:read_all', '查看所有用户的订单'),
+       ('order:write_all', '替其他用户下单和修改订单'),
+       ('report:read', '查看销售报表'),
+       ('user:read_all', '查看所有用户的信息'),
+       ('user:write_all', '修改所有用户的信息'),
+       ('role:manage', '管理角色和用户的角色');
+
+-- 管理员拥有所有的权限，普通用户只能操作自己的数据
+INSERT INTO "role_permission"(role_id, permission_id)
+SELECT 2, id
+FROM "permission";
+
+UPDATE "user"
+SET role = 1
+WHERE role NOT IN (1, 2);
+
+ALTER TABLE "user"
+    ADD CONSTRAINT "user_role_fkey" FOREIGN KEY ("role") REFERENCES "role" ("id");
+
+COMMENT ON COLUMN "user"."role" IS 'role id';
@@ -0,0 +1,28 @@
+CREATE TABLE "user_two_factor"
+(
+    "user_id"    int8 PRIMARY KEY REFERENCES "user" ("id"),
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now()),
+    "secret"     varchar     NOT NULL,
+    "enabled"    boolean     NOT NULL DEFAULT false,
+    "last_step"  int8        NOT NULL DEFAULT 0
+);
+
+COMMENT ON COLUMN "user_two_factor"."secret" IS 'base32 TOTP secret';
+
+COMMENT ON COLUMN "user_two_factor"."enabled" IS 'false until the first code is verified';
+
+COMMENT ON COLUMN "user_two_factor"."last_step" IS 'last accepted TOTP step, codes can not be reused';
+
+CREATE TABLE "user_recovery_code"
+(
+    "id"         bigserial PRIMARY KEY,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "used_at"    timestamptz          DEFAULT null,
+    "user_id"    int8        NOT NULL REFERENCES "user" ("id"),
+    "code_hash"  varchar     NOT NULL
+);
+
+CREATE INDEX ON "user_recovery_code" ("user_id");
+
+COMMENT ON COLUMN "user_recovery_code"."code_hash" IS 'hex sha256 of the recovery code';
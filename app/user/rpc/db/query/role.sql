@@ -0,0 +1,59 @@
+-- name: InsertRole :one
+INSERT INTO "role"(name, description)
+VALUES ($1, $2)
+returning *;
+
+-- name: GetRoleByID :one
+SELECT *
+FROM "role"
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListAllRoles :many
+SELECT *
+FROM "role"
+ORDER BY id;
+
+-- name: DeleteRoleByID :execrows
+DELETE
+FROM "role"
+WHERE id = $1;
+
+-- name: CountUsersByRole :one
+SELECT count(*)
+FROM "user"
+WHERE role = $1;
+
+-- name: ListAllPermissions :many
+SELECT *
+FROM "permission"
+ORDER BY code;
+
+-- name: CountPermissionsByCodes :one
+SELECT count(*)
+FROM "permission"
+WHERE code = ANY (@codes::varchar[]);
+
+-- name: GetRolePermissions :many
+SELECT p.code
+FROM "permission" p
+         JOIN "role_permission" rp ON rp.permission_id = p.id
+WHERE rp.role_id = $1
+ORDER BY p.code;
+
+-- name: ListRolePermissions :many
+SELECT rp.role_id, p.code
+FROM "permission" p
+         JOIN "role_permission" rp ON rp.permission_id = p.id
+ORDER BY rp.role_id, p.code;
+
+-- name: DeleteRolePermissions :exec
+DELETE
+FROM "role_permission"
+WHERE role_id = $1;
+
+-- name: AddRolePermissions :exec
+INSERT INTO "role_permission"(role_id, permission_id)
+SELECT @role_id::int8, id
+FROM "permission"
+WHERE code = ANY (@codes::varchar[]);
@@ -0,0 +1,58 @@
+-- name: GetUserTwoFactor :one
+SELECT *
+FROM "user_two_factor"
+WHERE user_id = $1
+LIMIT 1;
+
+-- name: UpsertUserTwoFactor :one
+INSERT INTO "user_two_factor"(user_id, secret)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+    SET secret     = excluded.secret,
+        enabled    = false,
+        last_step  = 0,
+        updated_at = now()
+returning *;
+
+-- name: EnableUserTwoFactor :execrows
+update "user_two_factor"
+set enabled    = true,
+    last_step  = $2,
+    updated_at = now()
+where user_id = $1
+  and enabled = false;
+
+-- name: UseTwoFactorStep :execrows
+update "user_two_factor"
+set last_step  = $2,
+    updated_at = now()
+where user_id = $1
+  and enabled = true
+  and last_step < $2;
+
+-- name: DeleteUserTwoFactor :exec
+DELETE
+FROM "user_two_factor"
+WHERE user_id = $1;
+
+-- name: AddRecoveryCodes :exec
+INSERT INTO "user_recovery_code"(user_id, code_hash)
+SELECT @user_id::int8, unnest(@code_hashes::varchar[]);
+
+-- name: UseRecoveryCode :execrows
+update "user_recovery_code"
+set used_at = now()
+where user_id = $1
+  and code_hash = $2
+  and used_at IS NULL;
+
+-- name: CountUnusedRecoveryCodes :one
+SELECT count(*)
+FROM "user_recovery_code"
+WHERE user_id = $1
+  and used_at IS NULL;
+
+-- name: DeleteRecoveryCodes :exec
+DELETE
+FROM "user_recovery_code"
+WHERE user_id = $1;
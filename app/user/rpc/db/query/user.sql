@@ -29,12 +29,20 @@ order by id
 limit $1 offset $2;
 
 
--- name: DeleteUser :execrows
+-- name: DeleteUserByID :execrows
 update "user"
 set deleted_at =$2
 where id = $1
   and deleted_at is null;
 
+-- name: RestoreUserByID :one
+update "user"
+set deleted_at = null,
+    updated_at = $2
+where id = $1
+  and deleted_at is not null
+returning *;
+
 -- name: UpdateUser :one
 update "user"
 set updated_at = $1,
@@ -45,3 +53,8 @@ set updated_at = $1,
 where id = $6
 returning *;
 
+-- name: UpdateUserPasswordHash :execrows
+update "user"
+set password = @new_password
+where id = @id
+  and password = @old_password;
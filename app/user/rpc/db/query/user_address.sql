@@ -0,0 +1,60 @@
+-- name: CreateUserAddress :one
+INSERT INTO "user_address"(user_id,
+                           name,
+                           mobile,
+                           address,
+                           post,
+                           is_default)
+VALUES ($1, $2, $3, $4, $5, $6)
+returning *;
+
+-- name: GetUserAddress :one
+SELECT *
+FROM "user_address"
+WHERE deleted_at IS NULL
+  and id = $1
+  and user_id = $2
+LIMIT 1;
+
+-- name: GetUserDefaultAddress :one
+SELECT *
+FROM "user_address"
+WHERE deleted_at IS NULL
+  and user_id = $1
+  and is_default = true
+LIMIT 1;
+
+-- name: ListUserAddresses :many
+SELECT *
+FROM "user_address"
+WHERE deleted_at IS NULL
+  and user_id = $1
+order by is_default desc, id;
+
+-- name: UpdateUserAddress :one
+update "user_address"
+set updated_at = $1,
+    name       = $2,
+    mobile     = $3,
+    address    = $4,
+    post       = $5,
+    is_default = $6
+where id = $7
+  and user_id = $8
+  and deleted_at IS NULL
+returning *;
+
+-- name: ClearUserDefaultAddress :exec
+update "user_address"
+set updated_at = $1,
+    is_default = false
+where user_id = $2
+  and is_default = true
+  and deleted_at IS NULL;
+
+-- name: DeleteUserAddress :execrows
+update "user_address"
+set deleted_at = $1
+where id = $2
+  and user_id = $3
+  and deleted_at IS NULL;
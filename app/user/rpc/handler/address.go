@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/user/rpc/model"
+	"github.com/jimyag/shop/common/proto"
+)
+
+//
+// addressModel2AddressInfo
+//  @Description: 将收货地址的model转换为响应
+//  @param address
+//  @return *proto.AddressInfo
+//
+func addressModel2AddressInfo(address model.UserAddress) *proto.AddressInfo {
+	return &proto.AddressInfo{
+		Id:        int32(address.ID),
+		UserID:    int32(address.UserID),
+		Name:      address.Name,
+		Mobile:    address.Mobile,
+		Address:   address.Address,
+		Post:      address.Post,
+		IsDefault: address.IsDefault,
+	}
+}
+
+//
+// CreateAddress
+//  @Description: 添加收货地址 用户的第一个地址会被设置为默认地址
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.AddressInfo
+//  @return error
+//
+func (u *UserServer) CreateAddress(ctx context.Context, req *proto.CreateAddressRequest) (*proto.AddressInfo, error) {
+	_, err := u.Store.GetUserById(ctx, int64(req.GetUserID()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.AddressInfo{}, status.Errorf(codes.NotFound, "用户不存在")
+	} else if err != nil {
+		return &proto.AddressInfo{}, status.Errorf(codes.Internal, "系统错误")
+	}
+
+	arg := model.CreateUserAddressParams{
+		UserID:    int64(req.GetUserID()),
+		Name:      req.GetName(),
+		Mobile:    req.GetMobile(),
+		Address:   req.GetAddress(),
+		Post:      req.GetPost(),
+		IsDefault: req.GetIsDefault(),
+	}
+
+	var address model.UserAddress
+	err = u.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		addresses, err := queries.ListUserAddresses(ctx, arg.UserID)
+		if err != nil {
+			return err
+		}
+		// 第一个地址就是默认地址
+		if len(addresses) == 0 {
+			arg.IsDefault = true
+		}
+		// 默认地址只能有一个
+		if arg.IsDefault {
+			err = queries.ClearUserDefaultAddress(ctx, model.ClearUserDefaultAddressParams{
+				UpdatedAt: time.Now(),
+				UserID:    arg.UserID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		address, err = queries.CreateUserAddress(ctx, arg)
+		return err
+	})
+	if err != nil {
+		return &proto.AddressInfo{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	return addressModel2AddressInfo(address), nil
+}
+
+//
+// UpdateAddress
+//  @Description: 更新收货地址 字段为空的话保持原来的
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.AddressInfo
+//  @return error
+//
+func (u *UserServer) UpdateAddress(ctx context.Context, req *proto.AddressInfo) (*proto.AddressInfo, error) {
+	address, err := u.Store.GetUserAddress(ctx, model.GetUserAddressParams{
+		ID:     int64(req.GetId()),
+		UserID: int64(req.GetUserID()),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.AddressInfo{}, status.Errorf(codes.NotFound, "收货地址不存在")
+	} else if err != nil {
+		return &proto.AddressInfo{}, status.Errorf(codes.Internal, "系统错误")
+	}
+
+	arg := model.UpdateUserAddressParams{
+		UpdatedAt: time.Now(),
+		Name:      req.GetName(),
+		Mobile:    req.GetMobile(),
+		Address:   req.GetAddress(),
+		Post:      req.GetPost(),
+		// 默认地址不能被直接取消，只能通过设置另一个默认地址来取消
+		IsDefault: req.GetIsDefault() || address.IsDefault,
+		ID:        address.ID,
+		UserID:    address.UserID,
+	}
+	if req.GetName() == "" {
+		arg.Name = address.Name
+	}
+	if req.GetMobile() == "" {
+		arg.Mobile = address.Mobile
+	}
+	if req.GetAddress() == "" {
+		arg.Address = address.Address
+	}
+	if req.GetPost() == "" {
+		arg.Post = address.Post
+	}
+
+	err = u.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		if arg.IsDefault && !address.IsDefault {
+			err := queries.ClearUserDefaultAddress(ctx, model.ClearUserDefaultAddressParams{
+				UpdatedAt: arg.UpdatedAt,
+				UserID:    arg.UserID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		address, err = queries.UpdateUserAddress(ctx, arg)
+		return err
+	})
+	if err != nil {
+		return &proto.AddressInfo{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	return addressModel2AddressInfo(address), nil
+}
+
+//
+// DeleteAddress
+//  @Description: 删除收货地址 删除默认地址之后最早添加的地址成为默认地址
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.Empty
+//  @return error
+//
+func (u *UserServer) DeleteAddress(ctx context.Context, req *proto.AddressRequest) (*proto.Empty, error) {
+	address, err := u.Store.GetUserAddress(ctx, model.GetUserAddressParams{
+		ID:     int64(req.GetId()),
+		UserID: int64(req.GetUserID()),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.Empty{}, status.Errorf(codes.NotFound, "收货地址不存在")
+	} else if err != nil {
+		return &proto.Empty{}, status.Errorf(codes.Internal, "系统错误")
+	}
+
+	err = u.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		now := time.Now()
+		_, err := queries.DeleteUserAddress(ctx, model.DeleteUserAddressParams{
+			DeletedAt: sql.NullTime{Time: now, Valid: true},
+			ID:        address.ID,
+			UserID:    address.UserID,
+		})
+		if err != nil || !address.IsDefault {
+			return err
+		}
+
+		addresses, err := queries.ListUserAddresses(ctx, address.UserID)
+		if err != nil || len(addresses) == 0 {
+			return err
+		}
+		next := addresses[0]
+		_, err = queries.UpdateUserAddress(ctx, model.UpdateUserAddressParams{
+			UpdatedAt: now,
+			Name:      next.Name,
+			Mobile:    next.Mobile,
+			Address:   next.Address,
+			Post:      next.Post,
+			IsDefault: true,
+			ID:        next.ID,
+			UserID:    next.UserID,
+		})
+		return err
+	})
+	if err != nil {
+		return &proto.Empty{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	return &proto.Empty{}, nil
+}
+
+//
+// GetAddress
+//  @Description: 获得用户的收货地址，id 为 0 时返回默认地址
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.AddressInfo
+//  @return error
+//
+func (u *UserServer) GetAddress(ctx context.Context, req *proto.AddressRequest) (*proto.AddressInfo, error) {
+	var address model.UserAddress
+	var err error
+	if req.GetId() == 0 {
+		address, err = u.Store.GetUserDefaultAddress(ctx, int64(req.GetUserID()))
+	} else {
+		address, err = u.Store.GetUserAddress(ctx, model.GetUserAddressParams{
+			ID:     int64(req.GetId()),
+			UserID: int64(req.GetUserID()),
+		})
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.AddressInfo{}, status.Errorf(codes.NotFound, "收货地址不存在")
+	} else if err != nil {
+		return &proto.AddressInfo{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	return addressModel2AddressInfo(address), nil
+}
+
+//
+// GetAddressList
+//  @Description: 获得用户所有的收货地址，默认地址排在最前面
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.AddressListResponse
+//  @return error
+//
+func (u *UserServer) GetAddressList(ctx context.Context, req *proto.AddressListRequest) (*proto.AddressListResponse, error) {
+	addresses, err := u.Store.ListUserAddresses(ctx, int64(req.GetUserID()))
+	if err != nil {
+		return &proto.AddressListResponse{}, status.Errorf(codes.Internal, "获得收货地址列表失败")
+	}
+
+	data := make([]*proto.AddressInfo, len(addresses))
+	for i, address := range addresses {
+		data[i] = addressModel2AddressInfo(address)
+	}
+	return &proto.AddressListResponse{
+		Total: int32(len(addresses)),
+		Data:  data,
+	}, nil
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/test_util"
+)
+
+func createAddress(t *testing.T, userID int32, isDefault bool) *proto.AddressInfo {
+	request := proto.CreateAddressRequest{
+		UserID:    userID,
+		Name:      test_util.RandomNickName(),
+		Mobile:    test_util.RandomString(11),
+		Address:   test_util.RandomString(20),
+		Post:      test_util.RandomString(6),
+		IsDefault: isDefault,
+	}
+	rsp, err := userClient.CreateAddress(context.Background(), &request)
+	require.NoError(t, err)
+	require.NotEmpty(t, rsp)
+
+	require.Equal(t, request.UserID, rsp.GetUserID())
+	require.Equal(t, request.Name, rsp.GetName())
+	require.Equal(t, request.Mobile, rsp.GetMobile())
+	require.Equal(t, request.Address, rsp.GetAddress())
+	require.Equal(t, request.Post, rsp.GetPost())
+	return rsp
+}
+
+func TestUserServer_CreateAddress(t *testing.T) {
+	user, _ := createUser(t)
+	// 第一个地址就是默认地址
+	first := createAddress(t, user.GetId(), false)
+	require.True(t, first.GetIsDefault())
+
+	second := createAddress(t, user.GetId(), true)
+	require.True(t, second.GetIsDefault())
+
+	// 默认地址只有一个
+	first, err := userClient.GetAddress(context.Background(), &proto.AddressRequest{Id: first.GetId(), UserID: user.GetId()})
+	require.NoError(t, err)
+	require.False(t, first.GetIsDefault())
+
+	_, err = userClient.CreateAddress(context.Background(), &proto.CreateAddressRequest{UserID: -1})
+	require.Error(t, err)
+}
+
+func TestUserServer_GetAddress(t *testing.T) {
+	user, _ := createUser(t)
+	address := createAddress(t, user.GetId(), false)
+
+	rsp, err := userClient.GetAddress(context.Background(), &proto.AddressRequest{Id: address.GetId(), UserID: user.GetId()})
+	require.NoError(t, err)
+	require.Equal(t, address, rsp)
+
+	// id 为 0 时获得默认地址
+	rsp, err = userClient.GetAddress(context.Background(), &proto.AddressRequest{UserID: user.GetId()})
+	require.NoError(t, err)
+	require.Equal(t, address, rsp)
+
+	// 不能获得其他用户的地址
+	other, _ := createUser(t)
+	_, err = userClient.GetAddress(context.Background(), &proto.AddressRequest{Id: address.GetId(), UserID: other.GetId()})
+	require.Error(t, err)
+}
+
+func TestUserServer_UpdateAddress(t *testing.T) {
+	user, _ := createUser(t)
+	first := createAddress(t, user.GetId(), false)
+	second := createAddress(t, user.GetId(), false)
+
+	request := proto.AddressInfo{
+		Id:        second.GetId(),
+		UserID:    user.GetId(),
+		Address:   test_util.RandomString(20),
+		IsDefault: true,
+	}
+	rsp, err := userClient.UpdateAddress(context.Background(), &request)
+	require.NoError(t, err)
+	require.Equal(t, request.Address, rsp.GetAddress())
+	require.Equal(t, second.GetName(), rsp.GetName())
+	require.True(t, rsp.GetIsDefault())
+
+	first, err = userClient.GetAddress(context.Background(), &proto.AddressRequest{Id: first.GetId(), UserID: user.GetId()})
+	require.NoError(t, err)
+	require.False(t, first.GetIsDefault())
+}
+
+func TestUserServer_DeleteAddress(t *testing.T) {
+	user, _ := createUser(t)
+	first := createAddress(t, user.GetId(), false)
+	second := createAddress(t, user.GetId(), false)
+
+	_, err := userClient.DeleteAddress(context.Background(), &proto.AddressRequest{Id: first.GetId(), UserID: user.GetId()})
+	require.NoError(t, err)
+
+	// 删除默认地址之后剩下的地址成为默认地址
+	rsp, err := userClient.GetAddress(context.Background(), &proto.AddressRequest{UserID: user.GetId()})
+	require.NoError(t, err)
+	require.Equal(t, second.GetId(), rsp.GetId())
+
+	_, err = userClient.DeleteAddress(context.Background(), &proto.AddressRequest{Id: first.GetId(), UserID: user.GetId()})
+	require.Error(t, err)
+}
+
+func TestUserServer_GetAddressList(t *testing.T) {
+	user, _ := createUser(t)
+	for i := 0; i < 3; i++ {
+		createAddress(t, user.GetId(), false)
+	}
+	rsp, err := userClient.GetAddressList(context.Background(), &proto.AddressListRequest{UserID: user.GetId()})
+	require.NoError(t, err)
+	require.Len(t, rsp.Data, 3)
+	require.Equal(t, int32(3), rsp.GetTotal())
+	require.True(t, rsp.Data[0].GetIsDefault())
+}
@@ -0,0 +1,284 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/user/rpc/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
+)
+
+//
+// roleModel2RoleInfo
+//  @Description: 将角色的model转换为响应
+//  @param role
+//  @param permissions
+//  @return *proto.RoleInfo
+//
+func roleModel2RoleInfo(role model.Role, permissions []string) *proto.RoleInfo {
+	if permissions == nil {
+		permissions = []string{}
+	}
+	return &proto.RoleInfo{
+		Id:          int32(role.ID),
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: permissions,
+	}
+}
+
+//
+// checkPermissionCodes
+//  @Description: 去掉重复的权限并检查权限是否都存在
+//  @receiver u
+//  @param ctx
+//  @param codes
+//  @return []string
+//  @return error grpc 的错误
+//
+func (u *UserServer) checkPermissionCodes(ctx context.Context, permissions []string) ([]string, error) {
+	unique := make([]string, 0, len(permissions))
+	seen := make(map[string]bool, len(permissions))
+	for _, permission := range permissions {
+		if !seen[permission] {
+			seen[permission] = true
+			unique = append(unique, permission)
+		}
+	}
+	count, err := u.Store.CountPermissionsByCodes(ctx, unique)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "系统错误")
+	}
+	if int(count) != len(unique) {
+		return nil, status.Errorf(codes.InvalidArgument, "权限不存在")
+	}
+	return unique, nil
+}
+
+//
+// CreateRole
+//  @Description: 创建角色和它的权限
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.RoleInfo
+//  @return error
+//
+func (u *UserServer) CreateRole(ctx context.Context, req *proto.RoleInfo) (*proto.RoleInfo, error) {
+	if err := auth.CheckCallerPermission(ctx, auth.PermRoleManage); err != nil {
+		return &proto.RoleInfo{}, err
+	}
+	if err := u.checkCallerTwoFactor(ctx); err != nil {
+		return &proto.RoleInfo{}, err
+	}
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return &proto.RoleInfo{}, status.Errorf(codes.InvalidArgument, "角色名称不能为空")
+	}
+	permissions, err := u.checkPermissionCodes(ctx, req.GetPermissions())
+	if err != nil {
+		return &proto.RoleInfo{}, err
+	}
+
+	var role model.Role
+	err = u.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		var err error
+		role, err = queries.InsertRole(ctx, model.InsertRoleParams{
+			Name:        name,
+			Description: req.GetDescription(),
+		})
+		if err != nil {
+			return err
+		}
+		return queries.AddRolePermissions(ctx, model.AddRolePermissionsParams{
+			RoleID: role.ID,
+			Codes:  permissions,
+		})
+	})
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" {
+		return &proto.RoleInfo{}, status.Errorf(codes.AlreadyExists, "角色已存在")
+	} else if err != nil {
+		return &proto.RoleInfo{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	return u.GetRole(ctx, &proto.RoleRequest{Id: int32(role.ID)})
+}
+
+//
+// UpdateRolePermissions
+//  @Description: 使用新的权限替换角色原来的所有权限，用户在刷新 token 后使用新的权限
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.RoleInfo
+//  @return error
+//
+func (u *UserServer) UpdateRolePermissions(ctx context.Context, req *proto.RoleInfo) (*proto.RoleInfo, error) {
+	if err := auth.CheckCallerPermission(ctx, auth.PermRoleManage); err != nil {
+		return &proto.RoleInfo{}, err
+	}
+	if err := u.checkCallerTwoFactor(ctx); err != nil {
+		return &proto.RoleInfo{}, err
+	}
+	// 管理员需要拥有所有的权限，否则可能没有人可以管理角色
+	if req.GetId() == auth.RoleAdmin {
+		return &proto.RoleInfo{}, status.Errorf(codes.FailedPrecondition, "不能修改管理员的权限")
+	}
+	_, err := u.Store.GetRoleByID(ctx, int64(req.GetId()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.RoleInfo{}, status.Errorf(codes.NotFound, "角色不存在")
+	} else if err != nil {
+		return &proto.RoleInfo{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	permissions, err := u.checkPermissionCodes(ctx, req.GetPermissions())
+	if err != nil {
+		return &proto.RoleInfo{}, err
+	}
+
+	err = u.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		err := queries.DeleteRolePermissions(ctx, int64(req.GetId()))
+		if err != nil {
+			return err
+		}
+		return queries.AddRolePermissions(ctx, model.AddRolePermissionsParams{
+			RoleID: int64(req.GetId()),
+			Codes:  permissions,
+		})
+	})
+	if err != nil {
+		return &proto.RoleInfo{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	return u.GetRole(ctx, &proto.RoleRequest{Id: req.GetId()})
+}
+
+//
+// DeleteRole
+//  @Description: 删除角色，内置的角色和还有用户的角色不能删除
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.Empty
+//  @return error
+//
+func (u *UserServer) DeleteRole(ctx context.Context, req *proto.RoleRequest) (*proto.Empty, error) {
+	if err := auth.CheckCallerPermission(ctx, auth.PermRoleManage); err != nil {
+		return &proto.Empty{}, err
+	}
+	if err := u.checkCallerTwoFactor(ctx); err != nil {
+		return &proto.Empty{}, err
+	}
+	if req.GetId() == auth.RoleUser || req.GetId() == auth.RoleAdmin {
+		return &proto.Empty{}, status.Errorf(codes.FailedPrecondition, "不能删除内置的角色")
+	}
+	users, err := u.Store.CountUsersByRole(ctx, int64(req.GetId()))
+	if err != nil {
+		return &proto.Empty{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	if users > 0 {
+		return &proto.Empty{}, status.Errorf(codes.FailedPrecondition, "还有用户属于该角色")
+	}
+	rows, err := u.Store.DeleteRoleByID(ctx, int64(req.GetId()))
+	if err != nil {
+		return &proto.Empty{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	if rows == 0 {
+		return &proto.Empty{}, status.Errorf(codes.NotFound, "角色不存在")
+	}
+	return &proto.Empty{}, nil
+}
+
+//
+// GetRole
+//  @Description: 获得角色和它的权限，登录时用来获得用户的权限
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.RoleInfo
+//  @return error
+//
+func (u *UserServer) GetRole(ctx context.Context, req *proto.RoleRequest) (*proto.RoleInfo, error) {
+	// 可以查看自己的角色
+	if caller, ok := auth.CallerFromContext(ctx); ok && caller.Role != req.GetId() {
+		if err := auth.CheckCallerPermission(ctx, auth.PermRoleManage); err != nil {
+			return &proto.RoleInfo{}, err
+		}
+	}
+	role, err := u.Store.GetRoleByID(ctx, int64(req.GetId()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.RoleInfo{}, status.Errorf(codes.NotFound, "角色不存在")
+	} else if err != nil {
+		return &proto.RoleInfo{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	permissions, err := u.Store.GetRolePermissions(ctx, role.ID)
+	if err != nil {
+		return &proto.RoleInfo{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	return roleModel2RoleInfo(role, permissions), nil
+}
+
+//
+// ListRoles
+//  @Description: 获得所有的角色和它们的权限
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.RoleListResponse
+//  @return error
+//
+func (u *UserServer) ListRoles(ctx context.Context, req *proto.Empty) (*proto.RoleListResponse, error) {
+	if err := auth.CheckCallerPermission(ctx, auth.PermRoleManage); err != nil {
+		return &proto.RoleListResponse{}, err
+	}
+	roles, err := u.Store.ListAllRoles(ctx)
+	if err != nil {
+		return &proto.RoleListResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	rolePermissions, err := u.Store.ListRolePermissions(ctx)
+	if err != nil {
+		return &proto.RoleListResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	permissions := make(map[int64][]string, len(roles))
+	for _, rolePermission := range rolePermissions {
+		permissions[rolePermission.RoleID] = append(permissions[rolePermission.RoleID], rolePermission.Code)
+	}
+
+	rsp := proto.RoleListResponse{Data: make([]*proto.RoleInfo, 0, len(roles))}
+	for _, role := range roles {
+		rsp.Data = append(rsp.Data, roleModel2RoleInfo(role, permissions[role.ID]))
+	}
+	return &rsp, nil
+}
+
+//
+// ListPermissions
+//  @Description: 获得所有可以分配给角色的权限
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.PermissionListResponse
+//  @return error
+//
+func (u *UserServer) ListPermissions(ctx context.Context, req *proto.Empty) (*proto.PermissionListResponse, error) {
+	if err := auth.CheckCallerPermission(ctx, auth.PermRoleManage); err != nil {
+		return &proto.PermissionListResponse{}, err
+	}
+	permissions, err := u.Store.ListAllPermissions(ctx)
+	if err != nil {
+		return &proto.PermissionListResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	rsp := proto.PermissionListResponse{Data: make([]*proto.PermissionInfo, 0, len(permissions))}
+	for _, permission := range permissions {
+		rsp.Data = append(rsp.Data, &proto.PermissionInfo{
+			Code:        permission.Code,
+			Description: permission.Description,
+		})
+	}
+	return &rsp, nil
+}
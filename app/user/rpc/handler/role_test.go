@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
+	"github.com/jimyag/shop/common/utils/test_util"
+)
+
+func TestUserServer_Roles(t *testing.T) {
+	user, _ := createUser(t)
+	admin, _ := createUser(t)
+	enableTwoFactor(t, admin.Id)
+	userCtx := auth.NewOutgoingContext(context.Background(), auth.Caller{UID: user.Id, Role: auth.RoleUser})
+	adminCtx := auth.NewOutgoingContext(context.Background(), auth.Caller{
+		UID:         admin.Id,
+		Role:        auth.RoleAdmin,
+		Permissions: []string{auth.PermRoleManage, auth.PermUserWriteAll},
+	})
+
+	// 管理员拥有所有的权限，普通用户没有权限
+	adminRole, err := userClient.GetRole(context.Background(), &proto.RoleRequest{Id: auth.RoleAdmin})
+	require.NoError(t, err)
+	require.Contains(t, adminRole.Permissions, auth.PermRoleManage)
+	require.Contains(t, adminRole.Permissions, auth.PermGoodsWrite)
+	normal, err := userClient.GetRole(userCtx, &proto.RoleRequest{Id: auth.RoleUser})
+	require.NoError(t, err)
+	require.Empty(t, normal.Permissions)
+
+	// 没有权限时不能管理角色
+	_, err = userClient.GetRole(userCtx, &proto.RoleRequest{Id: auth.RoleAdmin})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = userClient.CreateRole(userCtx, &proto.RoleInfo{Name: test_util.RandomString(10)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// 不存在的权限
+	_, err = userClient.CreateRole(adminCtx, &proto.RoleInfo{
+		Name:        test_util.RandomString(10),
+		Permissions: []string{auth.PermGoodsWrite, "goods:unknown"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	role, err := userClient.CreateRole(adminCtx, &proto.RoleInfo{
+		Name:        test_util.RandomString(10),
+		Description: "运营",
+		Permissions: []string{auth.PermGoodsWrite, auth.PermGoodsWrite, auth.PermCouponWrite},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{auth.PermGoodsWrite, auth.PermCouponWrite}, role.Permissions)
+	_, err = userClient.CreateRole(adminCtx, &proto.RoleInfo{Name: role.Name})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	role, err = userClient.UpdateRolePermissions(adminCtx, &proto.RoleInfo{Id: role.Id, Permissions: []string{auth.PermReportRead}})
+	require.NoError(t, err)
+	require.Equal(t, []string{auth.PermReportRead}, role.Permissions)
+	_, err = userClient.UpdateRolePermissions(adminCtx, &proto.RoleInfo{Id: auth.RoleAdmin})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	roles, err := userClient.ListRoles(adminCtx, &proto.Empty{})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(roles.Data), 3)
+	permissions, err := userClient.ListPermissions(adminCtx, &proto.Empty{})
+	require.NoError(t, err)
+	require.NotEmpty(t, permissions.Data)
+
+	// 还有用户的角色和内置的角色不能删除
+	_, err = userClient.UpdateUser(adminCtx, &proto.UpdateUserRequest{Id: user.Id, Role: role.Id})
+	require.NoError(t, err)
+	_, err = userClient.DeleteRole(adminCtx, &proto.RoleRequest{Id: role.Id})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = userClient.DeleteRole(adminCtx, &proto.RoleRequest{Id: auth.RoleUser})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = userClient.UpdateUser(adminCtx, &proto.UpdateUserRequest{Id: user.Id, Role: auth.RoleUser})
+	require.NoError(t, err)
+	_, err = userClient.DeleteRole(adminCtx, &proto.RoleRequest{Id: role.Id})
+	require.NoError(t, err)
+	_, err = userClient.GetRole(adminCtx, &proto.RoleRequest{Id: role.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// 不存在的角色
+	_, err = userClient.UpdateUser(adminCtx, &proto.UpdateUserRequest{Id: user.Id, Role: role.Id})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
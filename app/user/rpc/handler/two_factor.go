@@ -0,0 +1,304 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/app/user/rpc/model"
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
+	"github.com/jimyag/shop/common/utils/totp"
+)
+
+const (
+	totpIssuer         = "shop" // 认证器 App 中显示的发行方
+	recoveryCodeCount  = 10     // 每次生成的恢复码的数量
+	recoveryCodeLength = 10     // 恢复码的长度 不包括中间的 -
+)
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// 同时开启两步验证时只有一个可以成功
+var errTwoFactorEnabled = errors.New("two factor already enabled")
+
+//
+// generateRecoveryCodes
+//  @Description: 生成恢复码 格式为 xxxxx-xxxxx
+//  @return []string 恢复码
+//  @return []string 恢复码的 hash
+//  @return error
+//
+func generateRecoveryCodes() ([]string, []string, error) {
+	recoveryCodes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	buf := make([]byte, recoveryCodeLength*5/8)
+	for i := 0; i < recoveryCodeCount; i++ {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(recoveryEncoding.EncodeToString(buf))
+		recoveryCodes = append(recoveryCodes, code[:recoveryCodeLength/2]+"-"+code[recoveryCodeLength/2:])
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return recoveryCodes, hashes, nil
+}
+
+// hashRecoveryCode 恢复码是随机生成的，使用 sha256 保存就足够了，忽略大小写和 -
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+//
+// checkCallerTwoFactor
+//  @Description: 修改角色的用户必须开启两步验证，内部服务的调用不检查
+//  @receiver u
+//  @param ctx
+//  @return error grpc 的错误
+//
+func (u *UserServer) checkCallerTwoFactor(ctx context.Context) error {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	twoFactor, err := u.Store.GetUserTwoFactor(ctx, int64(caller.UID))
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !twoFactor.Enabled) {
+		return status.Errorf(codes.FailedPrecondition, "修改角色前需要开启两步验证")
+	} else if err != nil {
+		return status.Errorf(codes.Internal, "系统错误")
+	}
+	return nil
+}
+
+//
+// verifyTwoFactorCode
+//  @Description: 检查 TOTP 或恢复码，通过后验证码不能再次使用
+//  @receiver u
+//  @param ctx
+//  @param queries
+//  @param twoFactor
+//  @param code
+//  @return bool
+//  @return error
+//
+func (u *UserServer) verifyTwoFactorCode(ctx context.Context, queries model.Querier, twoFactor model.UserTwoFactor, code string) (bool, error) {
+	if step, ok := totp.Validate(twoFactor.Secret, code, time.Now()); ok {
+		rows, err := queries.UseTwoFactorStep(ctx, model.UseTwoFactorStepParams{
+			UserID:   twoFactor.UserID,
+			LastStep: step,
+		})
+		return rows == 1, err
+	}
+	rows, err := queries.UseRecoveryCode(ctx, model.UseRecoveryCodeParams{
+		UserID:   twoFactor.UserID,
+		CodeHash: hashRecoveryCode(code),
+	})
+	return rows == 1, err
+}
+
+//
+// EnrollTwoFactor
+//  @Description: 生成新的 TOTP 密钥，使用密钥中的验证码调用 EnableTwoFactor 后才会开启
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.TwoFactorEnrollResponse
+//  @return error
+//
+func (u *UserServer) EnrollTwoFactor(ctx context.Context, req *proto.IdRequest) (*proto.TwoFactorEnrollResponse, error) {
+	if err := auth.CheckCaller(ctx, int32(req.GetId()), auth.PermUserWriteAll); err != nil {
+		return &proto.TwoFactorEnrollResponse{}, err
+	}
+	user, err := u.Store.GetUserById(ctx, int64(req.GetId()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.TwoFactorEnrollResponse{}, status.Errorf(codes.NotFound, "用户不存在")
+	} else if err != nil {
+		return &proto.TwoFactorEnrollResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	twoFactor, err := u.Store.GetUserTwoFactor(ctx, user.ID)
+	if err == nil && twoFactor.Enabled {
+		return &proto.TwoFactorEnrollResponse{}, status.Errorf(codes.FailedPrecondition, "已经开启了两步验证")
+	} else if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return &proto.TwoFactorEnrollResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return &proto.TwoFactorEnrollResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	_, err = u.Store.UpsertUserTwoFactor(ctx, model.UpsertUserTwoFactorParams{
+		UserID: user.ID,
+		Secret: secret,
+	})
+	if err != nil {
+		return &proto.TwoFactorEnrollResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	return &proto.TwoFactorEnrollResponse{
+		Secret: secret,
+		Uri:    totp.URI(totpIssuer, user.Email, secret),
+	}, nil
+}
+
+//
+// EnableTwoFactor
+//  @Description: 验证 TOTP 后开启两步验证，生成新的恢复码
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.RecoveryCodesResponse 恢复码只会返回这一次
+//  @return error
+//
+func (u *UserServer) EnableTwoFactor(ctx context.Context, req *proto.TwoFactorCodeRequest) (*proto.RecoveryCodesResponse, error) {
+	if err := auth.CheckCaller(ctx, req.GetUserID(), auth.PermUserWriteAll); err != nil {
+		return &proto.RecoveryCodesResponse{}, err
+	}
+	twoFactor, err := u.Store.GetUserTwoFactor(ctx, int64(req.GetUserID()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.RecoveryCodesResponse{}, status.Errorf(codes.FailedPrecondition, "请先生成两步验证的密钥")
+	} else if err != nil {
+		return &proto.RecoveryCodesResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	if twoFactor.Enabled {
+		return &proto.RecoveryCodesResponse{}, status.Errorf(codes.FailedPrecondition, "已经开启了两步验证")
+	}
+	step, ok := totp.Validate(twoFactor.Secret, req.GetCode(), time.Now())
+	if !ok {
+		return &proto.RecoveryCodesResponse{}, status.Errorf(codes.InvalidArgument, "验证码错误")
+	}
+	recoveryCodes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return &proto.RecoveryCodesResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+
+	err = u.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		rows, err := queries.EnableUserTwoFactor(ctx, model.EnableUserTwoFactorParams{
+			UserID:   twoFactor.UserID,
+			LastStep: step,
+		})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return errTwoFactorEnabled
+		}
+		err = queries.DeleteRecoveryCodes(ctx, twoFactor.UserID)
+		if err != nil {
+			return err
+		}
+		return queries.AddRecoveryCodes(ctx, model.AddRecoveryCodesParams{
+			UserID:     twoFactor.UserID,
+			CodeHashes: hashes,
+		})
+	})
+	if errors.Is(err, errTwoFactorEnabled) {
+		return &proto.RecoveryCodesResponse{}, status.Errorf(codes.FailedPrecondition, "已经开启了两步验证")
+	} else if err != nil {
+		return &proto.RecoveryCodesResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	return &proto.RecoveryCodesResponse{Codes: recoveryCodes}, nil
+}
+
+//
+// DisableTwoFactor
+//  @Description: 验证 TOTP 或恢复码后关闭两步验证，删除密钥和所有的恢复码
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.Empty
+//  @return error
+//
+func (u *UserServer) DisableTwoFactor(ctx context.Context, req *proto.TwoFactorCodeRequest) (*proto.Empty, error) {
+	if err := auth.CheckCaller(ctx, req.GetUserID(), auth.PermUserWriteAll); err != nil {
+		return &proto.Empty{}, err
+	}
+	twoFactor, err := u.Store.GetUserTwoFactor(ctx, int64(req.GetUserID()))
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !twoFactor.Enabled) {
+		return &proto.Empty{}, status.Errorf(codes.FailedPrecondition, "没有开启两步验证")
+	} else if err != nil {
+		return &proto.Empty{}, status.Errorf(codes.Internal, "系统错误")
+	}
+
+	var ok bool
+	err = u.Store.ExecTx(ctx, func(queries *model.Queries) error {
+		var err error
+		ok, err = u.verifyTwoFactorCode(ctx, queries, twoFactor, req.GetCode())
+		if err != nil || !ok {
+			return err
+		}
+		err = queries.DeleteRecoveryCodes(ctx, twoFactor.UserID)
+		if err != nil {
+			return err
+		}
+		return queries.DeleteUserTwoFactor(ctx, twoFactor.UserID)
+	})
+	if err != nil {
+		return &proto.Empty{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	if !ok {
+		return &proto.Empty{}, status.Errorf(codes.InvalidArgument, "验证码错误")
+	}
+	return &proto.Empty{}, nil
+}
+
+//
+// VerifyTwoFactor
+//  @Description: 登录时检查 TOTP 或恢复码，每个验证码只能使用一次
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.VerifyTwoFactorResponse
+//  @return error
+//
+func (u *UserServer) VerifyTwoFactor(ctx context.Context, req *proto.TwoFactorCodeRequest) (*proto.VerifyTwoFactorResponse, error) {
+	if err := auth.CheckCaller(ctx, req.GetUserID(), auth.PermUserWriteAll); err != nil {
+		return &proto.VerifyTwoFactorResponse{}, err
+	}
+	twoFactor, err := u.Store.GetUserTwoFactor(ctx, int64(req.GetUserID()))
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !twoFactor.Enabled) {
+		return &proto.VerifyTwoFactorResponse{}, status.Errorf(codes.FailedPrecondition, "没有开启两步验证")
+	} else if err != nil {
+		return &proto.VerifyTwoFactorResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	ok, err := u.verifyTwoFactorCode(ctx, u.Store, twoFactor, req.GetCode())
+	if err != nil {
+		return &proto.VerifyTwoFactorResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	return &proto.VerifyTwoFactorResponse{Success: ok}, nil
+}
+
+//
+// GetTwoFactorStatus
+//  @Description: 获得用户是否开启了两步验证和剩余的恢复码数量
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.TwoFactorStatusResponse
+//  @return error
+//
+func (u *UserServer) GetTwoFactorStatus(ctx context.Context, req *proto.IdRequest) (*proto.TwoFactorStatusResponse, error) {
+	if err := auth.CheckCaller(ctx, int32(req.GetId()), auth.PermUserReadAll); err != nil {
+		return &proto.TwoFactorStatusResponse{}, err
+	}
+	twoFactor, err := u.Store.GetUserTwoFactor(ctx, int64(req.GetId()))
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !twoFactor.Enabled) {
+		return &proto.TwoFactorStatusResponse{}, nil
+	} else if err != nil {
+		return &proto.TwoFactorStatusResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	count, err := u.Store.CountUnusedRecoveryCodes(ctx, twoFactor.UserID)
+	if err != nil {
+		return &proto.TwoFactorStatusResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	return &proto.TwoFactorStatusResponse{Enabled: true, RecoveryCodesLeft: int32(count)}, nil
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/totp"
+)
+
+// enableTwoFactor 为用户开启两步验证，返回密钥和恢复码
+func enableTwoFactor(t *testing.T, userID int32) (string, []string) {
+	enroll, err := userClient.EnrollTwoFactor(context.Background(), &proto.IdRequest{Id: uint32(userID)})
+	require.NoError(t, err)
+	require.Contains(t, enroll.Uri, enroll.Secret)
+
+	// 使用上一个周期的验证码，之后的测试可以使用当前周期的验证码
+	code, err := totp.Code(enroll.Secret, totp.Step(time.Now())-1)
+	require.NoError(t, err)
+	rsp, err := userClient.EnableTwoFactor(context.Background(), &proto.TwoFactorCodeRequest{UserID: userID, Code: code})
+	require.NoError(t, err)
+	require.Len(t, rsp.Codes, recoveryCodeCount)
+	return enroll.Secret, rsp.Codes
+}
+
+func TestUserServer_TwoFactor(t *testing.T) {
+	user, _ := createUser(t)
+	ctx := context.Background()
+
+	status1, err := userClient.GetTwoFactorStatus(ctx, &proto.IdRequest{Id: uint32(user.Id)})
+	require.NoError(t, err)
+	require.False(t, status1.Enabled)
+	_, err = userClient.VerifyTwoFactor(ctx, &proto.TwoFactorCodeRequest{UserID: user.Id, Code: "123456"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 没有验证的密钥不能开启
+	enroll, err := userClient.EnrollTwoFactor(ctx, &proto.IdRequest{Id: uint32(user.Id)})
+	require.NoError(t, err)
+	_, err = userClient.EnableTwoFactor(ctx, &proto.TwoFactorCodeRequest{UserID: user.Id, Code: "000000"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.NotEmpty(t, enroll.Secret)
+
+	secret, recoveryCodes := enableTwoFactor(t, user.Id)
+	status1, err = userClient.GetTwoFactorStatus(ctx, &proto.IdRequest{Id: uint32(user.Id)})
+	require.NoError(t, err)
+	require.True(t, status1.Enabled)
+	require.Equal(t, int32(recoveryCodeCount), status1.RecoveryCodesLeft)
+	_, err = userClient.EnrollTwoFactor(ctx, &proto.IdRequest{Id: uint32(user.Id)})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 开启时使用过的验证码不能再使用
+	code, err := totp.Code(secret, totp.Step(time.Now())-1)
+	require.NoError(t, err)
+	verified, err := userClient.VerifyTwoFactor(ctx, &proto.TwoFactorCodeRequest{UserID: user.Id, Code: code})
+	require.NoError(t, err)
+	require.False(t, verified.Success)
+
+	code, err = totp.Code(secret, totp.Step(time.Now()))
+	require.NoError(t, err)
+	verified, err = userClient.VerifyTwoFactor(ctx, &proto.TwoFactorCodeRequest{UserID: user.Id, Code: code})
+	require.NoError(t, err)
+	require.True(t, verified.Success)
+	verified, err = userClient.VerifyTwoFactor(ctx, &proto.TwoFactorCodeRequest{UserID: user.Id, Code: code})
+	require.NoError(t, err)
+	require.False(t, verified.Success)
+
+	// 恢复码只能使用一次
+	verified, err = userClient.VerifyTwoFactor(ctx, &proto.TwoFactorCodeRequest{UserID: user.Id, Code: recoveryCodes[0]})
+	require.NoError(t, err)
+	require.True(t, verified.Success)
+	verified, err = userClient.VerifyTwoFactor(ctx, &proto.TwoFactorCodeRequest{UserID: user.Id, Code: recoveryCodes[0]})
+	require.NoError(t, err)
+	require.False(t, verified.Success)
+	status1, err = userClient.GetTwoFactorStatus(ctx, &proto.IdRequest{Id: uint32(user.Id)})
+	require.NoError(t, err)
+	require.Equal(t, int32(recoveryCodeCount-1), status1.RecoveryCodesLeft)
+
+	_, err = userClient.DisableTwoFactor(ctx, &proto.TwoFactorCodeRequest{UserID: user.Id, Code: recoveryCodes[0]})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = userClient.DisableTwoFactor(ctx, &proto.TwoFactorCodeRequest{UserID: user.Id, Code: recoveryCodes[1]})
+	require.NoError(t, err)
+	status1, err = userClient.GetTwoFactorStatus(ctx, &proto.IdRequest{Id: uint32(user.Id)})
+	require.NoError(t, err)
+	require.False(t, status1.Enabled)
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	require.Equal(t, hashRecoveryCode("abcde-fghij"), hashRecoveryCode(" ABCDEFGHIJ "))
+	require.NotEqual(t, hashRecoveryCode("abcde-fghij"), hashRecoveryCode("abcde-fghik"))
+}
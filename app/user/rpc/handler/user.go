@@ -4,17 +4,17 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"fmt"
-	"strings"
 	"time"
 
-	"github.com/anaskhan96/go-password-encoder"
+	"github.com/lib/pq"
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/jimyag/shop/app/user/rpc/model"
 	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
+	"github.com/jimyag/shop/common/utils/hasher"
 )
 
 //
@@ -23,20 +23,20 @@ import (
 //
 type UserServer struct {
 	model.Store
-	options *password.Options
+	hasher *hasher.Hasher
 }
 
 //
 // NewUserServer
-//  @Description: 使用 store password 的 options 创建 userServer
+//  @Description: 使用 store 和保存密码的 hasher 创建 userServer
 //  @param store 存储的方式
-//  @param options 密码相关的选项
+//  @param hasher 保存和验证密码的算法
 //  @return *UserServer
 //
-func NewUserServer(store model.Store, options *password.Options) *UserServer {
+func NewUserServer(store model.Store, hasher *hasher.Hasher) *UserServer {
 	return &UserServer{
-		Store:   store,
-		options: options,
+		Store:  store,
+		hasher: hasher,
 	}
 }
 
@@ -52,7 +52,6 @@ func userModel2UserInfoResponse(user model.User) *proto.UserInfoResponse {
 		CreatedAt: user.CreatedAt.Unix(),
 		UpdatedAt: user.UpdatedAt.Unix(),
 		Email:     user.Email,
-		Password:  user.Password,
 		Nickname:  user.Nickname,
 		Gender:    user.Gender,
 		Role:      int32(user.Role),
@@ -69,6 +68,9 @@ func userModel2UserInfoResponse(user model.User) *proto.UserInfoResponse {
 //  @return error
 //
 func (u *UserServer) GetUserList(ctx context.Context, req *proto.PageIngo) (*proto.UserListResponse, error) {
+	if err := auth.CheckCallerPermission(ctx, auth.PermUserReadAll); err != nil {
+		return nil, err
+	}
 	arg := model.ListUsersParams{
 		Limit:  int32(req.PageSize),
 		Offset: int32((req.PageNum - 1) * req.PageSize),
@@ -111,10 +113,16 @@ func (u *UserServer) GetUserByEmail(ctx context.Context, req *proto.EmailRequest
 			opentracing.ChildOf(parentSpan.Context()),
 		)
 	user, err := u.Store.GetUserByEmail(ctx, req.GetEmail())
-	if err != nil {
+	getUserInfoByEmailSpan.Finish()
+	// 忘记密码时需要区分用户不存在和查询失败
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "用户不存在")
+	} else if err != nil {
 		return nil, status.Errorf(codes.Internal, "通过 Email 获得用户信息失败")
 	}
-	getUserInfoByEmailSpan.Finish()
+	if err = auth.CheckCaller(ctx, int32(user.ID), auth.PermUserReadAll); err != nil {
+		return nil, err
+	}
 
 	rsp := userModel2UserInfoResponse(user)
 	return rsp, nil
@@ -130,6 +138,9 @@ func (u *UserServer) GetUserByEmail(ctx context.Context, req *proto.EmailRequest
 //  @return error
 //
 func (u *UserServer) GetUserById(ctx context.Context, req *proto.IdRequest) (*proto.UserInfoResponse, error) {
+	if err := auth.CheckCaller(ctx, int32(req.GetId()), auth.PermUserReadAll); err != nil {
+		return nil, err
+	}
 	user, err := u.Store.GetUserById(ctx, int64(req.GetId()))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "通过 ID 获得用户信息失败")
@@ -148,10 +159,23 @@ func (u *UserServer) GetUserById(ctx context.Context, req *proto.IdRequest) (*pr
 //  @return error
 //
 func (u *UserServer) CreateUser(ctx context.Context, req *proto.CreateUserRequest) (*proto.UserInfoResponse, error) {
-	salt, pwd := password.Encode(req.Password, u.options)
+	// 没有指定角色时使用普通用户
+	if req.GetRole() == 0 {
+		req.Role = auth.RoleUser
+	}
+	// 创建其他角色的用户需要管理角色的权限
+	if req.GetRole() != auth.RoleUser {
+		if err := auth.CheckCallerPermission(ctx, auth.PermRoleManage); err != nil {
+			return &proto.UserInfoResponse{}, err
+		}
+	}
+	encryptedPassword, err := u.hasher.Hash(req.GetPassword())
+	if err != nil {
+		return &proto.UserInfoResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
 	createUserParams := model.CreateUserParams{
 		Email:    req.GetEmail(),
-		Password: fmt.Sprintf("$%s$%s$%s", "pbkdf2-sha512", salt, pwd),
+		Password: encryptedPassword,
 		Nickname: req.GetNickname(),
 		Gender:   req.GetGender(),
 		Role:     int64(req.GetRole()),
@@ -173,7 +197,11 @@ func (u *UserServer) CreateUser(ctx context.Context, req *proto.CreateUserReques
 		}
 		return nil
 	})
-	if err != nil {
+	// 被注销的账号仍然占用邮箱
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" {
+		return &proto.UserInfoResponse{}, status.Errorf(codes.AlreadyExists, "用户已存在")
+	} else if err != nil {
 		// todo 处理Tx的错误
 		return &proto.UserInfoResponse{}, status.Errorf(codes.Internal, "系统错误")
 	}
@@ -191,6 +219,18 @@ func (u *UserServer) CreateUser(ctx context.Context, req *proto.CreateUserReques
 //  @return error
 //
 func (u *UserServer) UpdateUser(ctx context.Context, req *proto.UpdateUserRequest) (*proto.UserInfoResponse, error) {
+	// 只能修改自己的信息，有权限时才能修改其他用户的信息和角色
+	if err := auth.CheckCaller(ctx, req.Id, auth.PermUserWriteAll); err != nil {
+		return &proto.UserInfoResponse{}, err
+	}
+	if req.Role != 0 {
+		if err := auth.CheckCallerPermission(ctx, auth.PermRoleManage); err != nil {
+			return &proto.UserInfoResponse{}, err
+		}
+		if err := u.checkCallerTwoFactor(ctx); err != nil {
+			return &proto.UserInfoResponse{}, err
+		}
+	}
 	arg := model.UpdateUserParams{
 		UpdatedAt: time.Now(),
 		Nickname:  req.GetNickname(),
@@ -207,8 +247,10 @@ func (u *UserServer) UpdateUser(ctx context.Context, req *proto.UpdateUserReques
 	}
 	// 如果要更新密码 重新设置
 	if req.GetPassword() != "" {
-		salt, pwd := password.Encode(req.Password, u.options)
-		arg.Password = fmt.Sprintf("$%s$%s$%s", "pbkdf2-sha512", salt, pwd)
+		arg.Password, err = u.hasher.Hash(req.GetPassword())
+		if err != nil {
+			return &proto.UserInfoResponse{}, status.Errorf(codes.Internal, "系统错误")
+		}
 	}
 
 	err = u.Store.ExecTx(ctx, func(queries *model.Queries) error {
@@ -219,7 +261,7 @@ func (u *UserServer) UpdateUser(ctx context.Context, req *proto.UpdateUserReques
 			arg.Gender = user.Gender
 		}
 		if req.Role == 0 {
-			arg.Gender = user.Gender
+			arg.Role = user.Role
 		}
 		if req.Password == "" {
 			arg.Password = user.Password
@@ -228,7 +270,63 @@ func (u *UserServer) UpdateUser(ctx context.Context, req *proto.UpdateUserReques
 		user, err = queries.UpdateUser(ctx, arg)
 		return err
 	})
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code.Name() == "foreign_key_violation" {
+		return &proto.UserInfoResponse{}, status.Errorf(codes.InvalidArgument, "角色不存在")
+	} else if err != nil {
+		return &proto.UserInfoResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	rsp := userModel2UserInfoResponse(user)
+	return rsp, nil
+}
+
+//
+// DeleteUser
+//  @Description: 注销账号，只会标记为删除，保留用户的数据，管理员可以恢复
+//  用户只能注销自己的账号，有权限时可以注销其他用户的账号
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.Empty
+//  @return error
+//
+func (u *UserServer) DeleteUser(ctx context.Context, req *proto.IdRequest) (*proto.Empty, error) {
+	if err := auth.CheckCaller(ctx, int32(req.GetId()), auth.PermUserWriteAll); err != nil {
+		return &proto.Empty{}, err
+	}
+	rows, err := u.Store.DeleteUserByID(ctx, model.DeleteUserByIDParams{
+		ID:        int64(req.GetId()),
+		DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
 	if err != nil {
+		return &proto.Empty{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	if rows == 0 {
+		return &proto.Empty{}, status.Errorf(codes.NotFound, "用户不存在")
+	}
+	return &proto.Empty{}, nil
+}
+
+//
+// RestoreUser
+//  @Description: 恢复被注销的账号，只有有权限的用户才能恢复
+//  @receiver u
+//  @param ctx
+//  @param req
+//  @return *proto.UserInfoResponse
+//  @return error
+//
+func (u *UserServer) RestoreUser(ctx context.Context, req *proto.IdRequest) (*proto.UserInfoResponse, error) {
+	if err := auth.CheckCallerPermission(ctx, auth.PermUserWriteAll); err != nil {
+		return &proto.UserInfoResponse{}, err
+	}
+	user, err := u.Store.RestoreUserByID(ctx, model.RestoreUserByIDParams{
+		ID:        int64(req.GetId()),
+		UpdatedAt: time.Now(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.UserInfoResponse{}, status.Errorf(codes.NotFound, "没有被注销的用户")
+	} else if err != nil {
 		return &proto.UserInfoResponse{}, status.Errorf(codes.Internal, "系统错误")
 	}
 	rsp := userModel2UserInfoResponse(user)
@@ -236,15 +334,19 @@ func (u *UserServer) UpdateUser(ctx context.Context, req *proto.UpdateUserReques
 }
 
 //
-// CheckPassword
-//  @Description: 检查用户的密码是否合规
+// VerifyCredentials
+//  @Description: 检查邮箱和密码，密码的 hash 不会离开 user 服务，只能由内部服务调用
+//  用户不存在和密码错误返回相同的结果
 //  @receiver u
 //  @param ctx
 //  @param req
-//  @return *proto.CheckPasswordResponse
+//  @return *proto.VerifyCredentialsResponse
 //  @return error
 //
-func (u *UserServer) CheckPassword(ctx context.Context, req *proto.PasswordCheckInfo) (*proto.CheckPasswordResponse, error) {
+func (u *UserServer) VerifyCredentials(ctx context.Context, req *proto.CredentialsRequest) (*proto.VerifyCredentialsResponse, error) {
+	if _, ok := auth.CallerFromContext(ctx); ok {
+		return &proto.VerifyCredentialsResponse{}, status.Errorf(codes.PermissionDenied, "权限不足")
+	}
 	// 开始追踪
 	// 从context总拿到parentSpan
 	parentSpan := opentracing.SpanFromContext(ctx)
@@ -254,10 +356,34 @@ func (u *UserServer) CheckPassword(ctx context.Context, req *proto.PasswordCheck
 			"check user password",
 			opentracing.ChildOf(parentSpan.Context()),
 		)
+	defer checkUserPassword.Finish()
 
-	encryptedPasswordInfo := strings.Split(req.GetEncryptedPassword(), "$")
-	check := password.Verify(req.Password, encryptedPasswordInfo[2], encryptedPasswordInfo[3], u.options)
+	user, err := u.Store.GetUserByEmail(ctx, req.GetEmail())
+	if errors.Is(err, sql.ErrNoRows) {
+		// 用户不存在时也计算一次 hash，响应的时间和密码错误时差不多
+		_, _ = u.hasher.Hash(req.GetPassword())
+		return &proto.VerifyCredentialsResponse{}, nil
+	} else if err != nil {
+		return &proto.VerifyCredentialsResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
 
-	checkUserPassword.Finish()
-	return &proto.CheckPasswordResponse{Success: check}, nil
+	ok, rehash, err := u.hasher.Verify(req.GetPassword(), user.Password)
+	if err != nil {
+		return &proto.VerifyCredentialsResponse{}, status.Errorf(codes.Internal, "系统错误")
+	}
+	if !ok {
+		return &proto.VerifyCredentialsResponse{}, nil
+	}
+	// 使用旧的算法或参数保存的密码，登录成功后使用当前的算法重新保存
+	// 保存失败不影响登录，下次登录时还会再次尝试
+	if rehash {
+		if encryptedPassword, err := u.hasher.Hash(req.GetPassword()); err == nil {
+			_, _ = u.Store.UpdateUserPasswordHash(ctx, model.UpdateUserPasswordHashParams{
+				ID:          user.ID,
+				OldPassword: user.Password,
+				NewPassword: encryptedPassword,
+			})
+		}
+	}
+	return &proto.VerifyCredentialsResponse{Success: true, User: userModel2UserInfoResponse(user)}, nil
 }
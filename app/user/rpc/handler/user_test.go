@@ -2,13 +2,14 @@ package handler
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
-	"github.com/anaskhan96/go-password-encoder"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/jimyag/shop/common/proto"
+	"github.com/jimyag/shop/common/utils/auth"
 	"github.com/jimyag/shop/common/utils/test_util"
 )
 
@@ -19,7 +20,7 @@ func createUser(t *testing.T) (*proto.UserInfoResponse, test_util.Password) {
 		Password: p.RawPassword,
 		Nickname: test_util.RandomNickName(),
 		Gender:   test_util.RandomGender(),
-		Role:     0,
+		Role:     auth.RoleUser,
 	}
 	rsp, err := userClient.CreateUser(context.Background(), &request)
 	require.NoError(t, err)
@@ -60,6 +61,9 @@ func TestUserServer_GetUserByEmail(t *testing.T) {
 	userRsp, err := userClient.GetUserByEmail(context.Background(), &request)
 	require.NoError(t, err)
 	require.Equal(t, rsp, userRsp)
+
+	_, err = userClient.GetUserByEmail(context.Background(), &proto.EmailRequest{Email: test_util.RandomEmail()})
+	require.Equal(t, codes.NotFound, status.Code(err))
 }
 
 func TestUserServer_GetUserList(t *testing.T) {
@@ -92,17 +96,132 @@ func TestUserServer_UpdateUser(t *testing.T) {
 	require.Equal(t, request.GetGender(), newRsp.GetGender())
 }
 
-func TestUserServer_CheckPassword(t *testing.T) {
-	rawPassword := test_util.RandomString(10)
-	slat, encryptedPassword := password.Encode(rawPassword, test_util.Options)
-	encryptedPassword = fmt.Sprintf("$pbkdf2-sha512$%s$%s", slat, encryptedPassword)
-
-	request := proto.PasswordCheckInfo{
-		Password:          rawPassword,
-		EncryptedPassword: encryptedPassword,
+func TestUserServer_CallerOwnership(t *testing.T) {
+	owner, _ := createUser(t)
+	other, _ := createUser(t)
+	ownerCtx := auth.NewOutgoingContext(context.Background(), auth.Caller{UID: owner.Id, Role: auth.RoleUser})
+	adminCtx := auth.NewOutgoingContext(context.Background(), auth.Caller{
+		UID:         other.Id,
+		Role:        auth.RoleAdmin,
+		Permissions: []string{auth.PermUserReadAll, auth.PermUserWriteAll, auth.PermRoleManage},
+	})
+	denied := func(err error) {
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
 	}
-	checkPasswordResponse, err := userClient.CheckPassword(context.Background(), &request)
+
+	// 可以查看自己的信息，不能查看其他用户的信息
+	_, err := userClient.GetUserById(ownerCtx, &proto.IdRequest{Id: uint32(owner.Id)})
+	require.NoError(t, err)
+	_, err = userClient.GetUserById(ownerCtx, &proto.IdRequest{Id: uint32(other.Id)})
+	denied(err)
+	_, err = userClient.GetUserByEmail(ownerCtx, &proto.EmailRequest{Email: other.Email})
+	denied(err)
+	_, err = userClient.GetUserList(ownerCtx, &proto.PageIngo{PageNum: 1, PageSize: 10})
+	denied(err)
+
+	// 不能修改其他用户的信息和密码
+	_, err = userClient.UpdateUser(ownerCtx, &proto.UpdateUserRequest{Id: other.Id, Nickname: test_util.RandomNickName()})
+	denied(err)
+	_, err = userClient.UpdateUser(ownerCtx, &proto.UpdateUserRequest{Id: other.Id, Password: test_util.RandomString(10)})
+	denied(err)
+
+	// 不能把自己改为管理员
+	_, err = userClient.UpdateUser(ownerCtx, &proto.UpdateUserRequest{Id: owner.Id, Role: auth.RoleAdmin})
+	denied(err)
+	_, err = userClient.CreateUser(ownerCtx, &proto.CreateUserRequest{
+		Email:    test_util.RandomEmail(),
+		Password: test_util.RandomString(10),
+		Nickname: test_util.RandomNickName(),
+		Gender:   test_util.RandomGender(),
+		Role:     auth.RoleAdmin,
+	})
+	denied(err)
+	user, err := userClient.UpdateUser(ownerCtx, &proto.UpdateUserRequest{Id: owner.Id, Nickname: test_util.RandomNickName()})
+	require.NoError(t, err)
+	require.Equal(t, owner.Role, user.Role)
+
+	// 管理员可以访问其他用户，开启两步验证后才能修改角色
+	_, err = userClient.GetUserById(adminCtx, &proto.IdRequest{Id: uint32(owner.Id)})
+	require.NoError(t, err)
+	_, err = userClient.UpdateUser(adminCtx, &proto.UpdateUserRequest{Id: owner.Id, Role: auth.RoleAdmin})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	enableTwoFactor(t, other.Id)
+	user, err = userClient.UpdateUser(adminCtx, &proto.UpdateUserRequest{Id: owner.Id, Role: auth.RoleAdmin})
+	require.NoError(t, err)
+	require.Equal(t, auth.RoleAdmin, user.Role)
+}
+
+func TestUserServer_VerifyCredentials(t *testing.T) {
+	user, p := createUser(t)
+	ctx := context.Background()
+
+	rsp, err := userClient.VerifyCredentials(ctx, &proto.CredentialsRequest{Email: user.Email, Password: p.RawPassword})
+	require.NoError(t, err)
+	require.True(t, rsp.Success)
+	require.Equal(t, user.Id, rsp.User.Id)
+
+	// 密码错误和用户不存在返回相同的结果
+	rsp, err = userClient.VerifyCredentials(ctx, &proto.CredentialsRequest{Email: user.Email, Password: p.RawPassword + "x"})
+	require.NoError(t, err)
+	require.False(t, rsp.Success)
+	require.Nil(t, rsp.User)
+	rsp, err = userClient.VerifyCredentials(ctx, &proto.CredentialsRequest{Email: test_util.RandomEmail(), Password: p.RawPassword})
+	require.NoError(t, err)
+	require.False(t, rsp.Success)
+
+	// 用户不能直接调用
+	userCtx := auth.NewOutgoingContext(ctx, auth.Caller{UID: user.Id, Role: auth.RoleUser})
+	_, err = userClient.VerifyCredentials(userCtx, &proto.CredentialsRequest{Email: user.Email, Password: p.RawPassword})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestUserServer_DeleteUser(t *testing.T) {
+	user, p := createUser(t)
+	other, _ := createUser(t)
+	ctx := context.Background()
+	userCtx := auth.NewOutgoingContext(ctx, auth.Caller{UID: user.Id, Role: auth.RoleUser})
+	adminCtx := auth.NewOutgoingContext(ctx, auth.Caller{
+		UID:         other.Id,
+		Role:        auth.RoleAdmin,
+		Permissions: []string{auth.PermUserReadAll, auth.PermUserWriteAll},
+	})
+
+	// 不能注销其他用户
+	_, err := userClient.DeleteUser(userCtx, &proto.IdRequest{Id: uint32(other.Id)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = userClient.DeleteUser(userCtx, &proto.IdRequest{Id: uint32(user.Id)})
+	require.NoError(t, err)
+	_, err = userClient.DeleteUser(userCtx, &proto.IdRequest{Id: uint32(user.Id)})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// 注销后不能登录和查询 邮箱也不能再注册
+	_, err = userClient.GetUserById(ctx, &proto.IdRequest{Id: uint32(user.Id)})
+	require.Error(t, err)
+	rsp, err := userClient.VerifyCredentials(ctx, &proto.CredentialsRequest{Email: user.Email, Password: p.RawPassword})
+	require.NoError(t, err)
+	require.False(t, rsp.Success)
+	_, err = userClient.CreateUser(ctx, &proto.CreateUserRequest{
+		Email:    user.Email,
+		Password: p.RawPassword,
+		Nickname: user.Nickname,
+		Gender:   user.Gender,
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// 只有管理员可以恢复
+	_, err = userClient.RestoreUser(userCtx, &proto.IdRequest{Id: uint32(user.Id)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	restored, err := userClient.RestoreUser(adminCtx, &proto.IdRequest{Id: uint32(user.Id)})
+	require.NoError(t, err)
+	require.Equal(t, user.Email, restored.Email)
+	_, err = userClient.RestoreUser(adminCtx, &proto.IdRequest{Id: uint32(user.Id)})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	rsp, err = userClient.VerifyCredentials(ctx, &proto.CredentialsRequest{Email: user.Email, Password: p.RawPassword})
+	require.NoError(t, err)
+	require.True(t, rsp.Success)
+
+	// 管理员可以注销其他用户
+	_, err = userClient.DeleteUser(adminCtx, &proto.IdRequest{Id: uint32(user.Id)})
 	require.NoError(t, err)
-	require.NotNil(t, checkPasswordResponse)
-	require.True(t, checkPasswordResponse.Success)
 }
@@ -0,0 +1,53 @@
+package initialize
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/app/user/rpc/global"
+	"github.com/jimyag/shop/common/utils/hasher"
+)
+
+//
+// InitHasher
+//  @Description: 按照配置创建保存密码的 hasher，没有配置的参数使用默认值
+//  @return *hasher.Hasher
+//
+func InitHasher() *hasher.Hasher {
+	cfg := global.RemoteConfig.PasswordHash
+	argon2id := hasher.DefaultArgon2id
+	if cfg.Argon2Memory != 0 {
+		argon2id.Memory = cfg.Argon2Memory
+	}
+	if cfg.Argon2Time != 0 {
+		argon2id.Time = cfg.Argon2Time
+	}
+	if cfg.Argon2Threads != 0 {
+		argon2id.Threads = cfg.Argon2Threads
+	}
+	bcrypt := hasher.DefaultBcrypt
+	if cfg.BcryptCost != 0 {
+		bcrypt.Cost = cfg.BcryptCost
+	}
+	pbkdf2 := hasher.DefaultPBKDF2
+	if cfg.PBKDF2Iterations != 0 {
+		pbkdf2.Iterations = cfg.PBKDF2Iterations
+	}
+	if cfg.LegacyIterations != 0 {
+		pbkdf2.LegacyIterations = cfg.LegacyIterations
+	}
+
+	// 首选的算法放在第一个，其他的算法用来验证旧的密码
+	var h *hasher.Hasher
+	switch cfg.Algorithm {
+	case "", "argon2id":
+		h = hasher.NewHasher(argon2id, bcrypt, pbkdf2)
+	case "bcrypt":
+		h = hasher.NewHasher(bcrypt, argon2id, pbkdf2)
+	case "pbkdf2":
+		h = hasher.NewHasher(pbkdf2, argon2id, bcrypt)
+	default:
+		global.Logger.Fatal("不支持的密码算法", zap.String("algorithm", cfg.Algorithm))
+	}
+	global.Logger.Info("初始化密码算法成功......", zap.String("algorithm", cfg.Algorithm))
+	return h
+}
@@ -7,6 +7,25 @@ import (
 	"time"
 )
 
+type Permission struct {
+	ID int64 `json:"id"`
+	// resource:action
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+type Role struct {
+	ID          int64     `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+}
+
+type RolePermission struct {
+	RoleID       int64 `json:"role_id"`
+	PermissionID int64 `json:"permission_id"`
+}
+
 type User struct {
 	ID        int64        `json:"id"`
 	CreatedAt time.Time    `json:"created_at"`
@@ -20,6 +39,44 @@ type User struct {
 	Nickname string `json:"nickname"`
 	// male man ,female women
 	Gender string `json:"gender"`
-	// 1 user 2 admin
+	// role id
 	Role int64 `json:"role"`
 }
+
+type UserAddress struct {
+	ID        int64        `json:"id"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+	DeletedAt sql.NullTime `json:"deleted_at"`
+	UserID    int64        `json:"user_id"`
+	// signer name
+	Name string `json:"name"`
+	// signer mobile
+	Mobile  string `json:"mobile"`
+	Address string `json:"address"`
+	// post code
+	Post string `json:"post"`
+	// default address of the user
+	IsDefault bool `json:"is_default"`
+}
+
+type UserRecoveryCode struct {
+	ID        int64        `json:"id"`
+	CreatedAt time.Time    `json:"created_at"`
+	UsedAt    sql.NullTime `json:"used_at"`
+	UserID    int64        `json:"user_id"`
+	// hex sha256 of the recovery code
+	CodeHash string `json:"code_hash"`
+}
+
+type UserTwoFactor struct {
+	UserID    int64     `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// base32 TOTP secret
+	Secret string `json:"secret"`
+	// false until the first code is verified
+	Enabled bool `json:"enabled"`
+	// last accepted TOTP step, codes can not be reused
+	LastStep int64 `json:"last_step"`
+}
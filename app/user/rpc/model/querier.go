@@ -7,12 +7,41 @@ import (
 )
 
 type Querier interface {
+	AddRecoveryCodes(ctx context.Context, arg AddRecoveryCodesParams) error
+	AddRolePermissions(ctx context.Context, arg AddRolePermissionsParams) error
+	ClearUserDefaultAddress(ctx context.Context, arg ClearUserDefaultAddressParams) error
+	CountPermissionsByCodes(ctx context.Context, codes []string) (int64, error)
+	CountUnusedRecoveryCodes(ctx context.Context, userID int64) (int64, error)
+	CountUsersByRole(ctx context.Context, role int64) (int64, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
-	DeleteUser(ctx context.Context, arg DeleteUserParams) (int64, error)
+	CreateUserAddress(ctx context.Context, arg CreateUserAddressParams) (UserAddress, error)
+	DeleteRecoveryCodes(ctx context.Context, userID int64) error
+	DeleteRoleByID(ctx context.Context, id int64) (int64, error)
+	DeleteRolePermissions(ctx context.Context, roleID int64) error
+	DeleteUserAddress(ctx context.Context, arg DeleteUserAddressParams) (int64, error)
+	DeleteUserByID(ctx context.Context, arg DeleteUserByIDParams) (int64, error)
+	DeleteUserTwoFactor(ctx context.Context, userID int64) error
+	EnableUserTwoFactor(ctx context.Context, arg EnableUserTwoFactorParams) (int64, error)
+	GetRoleByID(ctx context.Context, id int64) (Role, error)
+	GetRolePermissions(ctx context.Context, roleID int64) ([]string, error)
+	GetUserAddress(ctx context.Context, arg GetUserAddressParams) (UserAddress, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserById(ctx context.Context, id int64) (User, error)
+	GetUserDefaultAddress(ctx context.Context, userID int64) (UserAddress, error)
+	GetUserTwoFactor(ctx context.Context, userID int64) (UserTwoFactor, error)
+	InsertRole(ctx context.Context, arg InsertRoleParams) (Role, error)
+	ListAllPermissions(ctx context.Context) ([]Permission, error)
+	ListAllRoles(ctx context.Context) ([]Role, error)
+	ListRolePermissions(ctx context.Context) ([]ListRolePermissionsRow, error)
+	ListUserAddresses(ctx context.Context, userID int64) ([]UserAddress, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	RestoreUserByID(ctx context.Context, arg RestoreUserByIDParams) (User, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateUserAddress(ctx context.Context, arg UpdateUserAddressParams) (UserAddress, error)
+	UpdateUserPasswordHash(ctx context.Context, arg UpdateUserPasswordHashParams) (int64, error)
+	UpsertUserTwoFactor(ctx context.Context, arg UpsertUserTwoFactorParams) (UserTwoFactor, error)
+	UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error)
+	UseTwoFactorStep(ctx context.Context, arg UseTwoFactorStepParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
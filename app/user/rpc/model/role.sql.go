@@ -0,0 +1,249 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: role.sql
+
+package model
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+const addRolePermissions = `-- name: AddRolePermissions :exec
+INSERT INTO "role_permission"(role_id, permission_id)
+SELECT $1::int8, id
+FROM "permission"
+WHERE code = ANY ($2::varchar[])
+`
+
+type AddRolePermissionsParams struct {
+	RoleID int64    `json:"role_id"`
+	Codes  []string `json:"codes"`
+}
+
+func (q *Queries) AddRolePermissions(ctx context.Context, arg AddRolePermissionsParams) error {
+	_, err := q.db.ExecContext(ctx, addRolePermissions, arg.RoleID, pq.Array(arg.Codes))
+	return err
+}
+
+const countPermissionsByCodes = `-- name: CountPermissionsByCodes :one
+SELECT count(*)
+FROM "permission"
+WHERE code = ANY ($1::varchar[])
+`
+
+func (q *Queries) CountPermissionsByCodes(ctx context.Context, codes []string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPermissionsByCodes, pq.Array(codes))
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUsersByRole = `-- name: CountUsersByRole :one
+SELECT count(*)
+FROM "user"
+WHERE role = $1
+`
+
+func (q *Queries) CountUsersByRole(ctx context.Context, role int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUsersByRole, role)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteRoleByID = `-- name: DeleteRoleByID :execrows
+DELETE
+FROM "role"
+WHERE id = $1
+`
+
+func (q *Queries) DeleteRoleByID(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteRoleByID, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteRolePermissions = `-- name: DeleteRolePermissions :exec
+DELETE
+FROM "role_permission"
+WHERE role_id = $1
+`
+
+func (q *Queries) DeleteRolePermissions(ctx context.Context, roleID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteRolePermissions, roleID)
+	return err
+}
+
+const getRoleByID = `-- name: GetRoleByID :one
+SELECT id, created_at, name, description
+FROM "role"
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetRoleByID(ctx context.Context, id int64) (Role, error) {
+	row := q.db.QueryRowContext(ctx, getRoleByID, id)
+	var i Role
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Name,
+		&i.Description,
+	)
+	return i, err
+}
+
+const getRolePermissions = `-- name: GetRolePermissions :many
+SELECT p.code
+FROM "permission" p
+         JOIN "role_permission" rp ON rp.permission_id = p.id
+WHERE rp.role_id = $1
+ORDER BY p.code
+`
+
+func (q *Queries) GetRolePermissions(ctx context.Context, roleID int64) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getRolePermissions, roleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var code string
+		if err := rows.Scan(&code); err != nil {
+			return nil, err
+		}
+		items = append(items, code)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertRole = `-- name: InsertRole :one
+INSERT INTO "role"(name, description)
+VALUES ($1, $2)
+returning id, created_at, name, description
+`
+
+type InsertRoleParams struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (q *Queries) InsertRole(ctx context.Context, arg InsertRoleParams) (Role, error) {
+	row := q.db.QueryRowContext(ctx, insertRole, arg.Name, arg.Description)
+	var i Role
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Name,
+		&i.Description,
+	)
+	return i, err
+}
+
+const listAllPermissions = `-- name: ListAllPermissions :many
+SELECT id, code, description
+FROM "permission"
+ORDER BY code
+`
+
+func (q *Queries) ListAllPermissions(ctx context.Context) ([]Permission, error) {
+	rows, err := q.db.QueryContext(ctx, listAllPermissions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Permission
+	for rows.Next() {
+		var i Permission
+		if err := rows.Scan(&i.ID, &i.Code, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAllRoles = `-- name: ListAllRoles :many
+SELECT id, created_at, name, description
+FROM "role"
+ORDER BY id
+`
+
+func (q *Queries) ListAllRoles(ctx context.Context) ([]Role, error) {
+	rows, err := q.db.QueryContext(ctx, listAllRoles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Role
+	for rows.Next() {
+		var i Role
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Name,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRolePermissions = `-- name: ListRolePermissions :many
+SELECT rp.role_id, p.code
+FROM "permission" p
+         JOIN "role_permission" rp ON rp.permission_id = p.id
+ORDER BY rp.role_id, p.code
+`
+
+type ListRolePermissionsRow struct {
+	RoleID int64  `json:"role_id"`
+	Code   string `json:"code"`
+}
+
+func (q *Queries) ListRolePermissions(ctx context.Context) ([]ListRolePermissionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRolePermissions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRolePermissionsRow
+	for rows.Next() {
+		var i ListRolePermissionsRow
+		if err := rows.Scan(&i.RoleID, &i.Code); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
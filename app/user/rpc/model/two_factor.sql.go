@@ -0,0 +1,177 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: two_factor.sql
+
+package model
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+const addRecoveryCodes = `-- name: AddRecoveryCodes :exec
+INSERT INTO "user_recovery_code"(user_id, code_hash)
+SELECT $1::int8, unnest($2::varchar[])
+`
+
+type AddRecoveryCodesParams struct {
+	UserID     int64    `json:"user_id"`
+	CodeHashes []string `json:"code_hashes"`
+}
+
+func (q *Queries) AddRecoveryCodes(ctx context.Context, arg AddRecoveryCodesParams) error {
+	_, err := q.db.ExecContext(ctx, addRecoveryCodes, arg.UserID, pq.Array(arg.CodeHashes))
+	return err
+}
+
+const countUnusedRecoveryCodes = `-- name: CountUnusedRecoveryCodes :one
+SELECT count(*)
+FROM "user_recovery_code"
+WHERE user_id = $1
+  and used_at IS NULL
+`
+
+func (q *Queries) CountUnusedRecoveryCodes(ctx context.Context, userID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUnusedRecoveryCodes, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteRecoveryCodes = `-- name: DeleteRecoveryCodes :exec
+DELETE
+FROM "user_recovery_code"
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteRecoveryCodes(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteRecoveryCodes, userID)
+	return err
+}
+
+const deleteUserTwoFactor = `-- name: DeleteUserTwoFactor :exec
+DELETE
+FROM "user_two_factor"
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserTwoFactor(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteUserTwoFactor, userID)
+	return err
+}
+
+const enableUserTwoFactor = `-- name: EnableUserTwoFactor :execrows
+update "user_two_factor"
+set enabled    = true,
+    last_step  = $2,
+    updated_at = now()
+where user_id = $1
+  and enabled = false
+`
+
+type EnableUserTwoFactorParams struct {
+	UserID   int64 `json:"user_id"`
+	LastStep int64 `json:"last_step"`
+}
+
+func (q *Queries) EnableUserTwoFactor(ctx context.Context, arg EnableUserTwoFactorParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, enableUserTwoFactor, arg.UserID, arg.LastStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getUserTwoFactor = `-- name: GetUserTwoFactor :one
+SELECT user_id, created_at, updated_at, secret, enabled, last_step
+FROM "user_two_factor"
+WHERE user_id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetUserTwoFactor(ctx context.Context, userID int64) (UserTwoFactor, error) {
+	row := q.db.QueryRowContext(ctx, getUserTwoFactor, userID)
+	var i UserTwoFactor
+	err := row.Scan(
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Secret,
+		&i.Enabled,
+		&i.LastStep,
+	)
+	return i, err
+}
+
+const upsertUserTwoFactor = `-- name: UpsertUserTwoFactor :one
+INSERT INTO "user_two_factor"(user_id, secret)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+    SET secret     = excluded.secret,
+        enabled    = false,
+        last_step  = 0,
+        updated_at = now()
+returning user_id, created_at, updated_at, secret, enabled, last_step
+`
+
+type UpsertUserTwoFactorParams struct {
+	UserID int64  `json:"user_id"`
+	Secret string `json:"secret"`
+}
+
+func (q *Queries) UpsertUserTwoFactor(ctx context.Context, arg UpsertUserTwoFactorParams) (UserTwoFactor, error) {
+	row := q.db.QueryRowContext(ctx, upsertUserTwoFactor, arg.UserID, arg.Secret)
+	var i UserTwoFactor
+	err := row.Scan(
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Secret,
+		&i.Enabled,
+		&i.LastStep,
+	)
+	return i, err
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :execrows
+update "user_recovery_code"
+set used_at = now()
+where user_id = $1
+  and code_hash = $2
+  and used_at IS NULL
+`
+
+type UseRecoveryCodeParams struct {
+	UserID   int64  `json:"user_id"`
+	CodeHash string `json:"code_hash"`
+}
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, useRecoveryCode, arg.UserID, arg.CodeHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const useTwoFactorStep = `-- name: UseTwoFactorStep :execrows
+update "user_two_factor"
+set last_step  = $2,
+    updated_at = now()
+where user_id = $1
+  and enabled = true
+  and last_step < $2
+`
+
+type UseTwoFactorStepParams struct {
+	UserID   int64 `json:"user_id"`
+	LastStep int64 `json:"last_step"`
+}
+
+func (q *Queries) UseTwoFactorStep(ctx context.Context, arg UseTwoFactorStepParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, useTwoFactorStep, arg.UserID, arg.LastStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -50,20 +50,20 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 	return i, err
 }
 
-const deleteUser = `-- name: DeleteUser :execrows
+const deleteUserByID = `-- name: DeleteUserByID :execrows
 update "user"
 set deleted_at =$2
 where id = $1
   and deleted_at is null
 `
 
-type DeleteUserParams struct {
+type DeleteUserByIDParams struct {
 	ID        int64        `json:"id"`
 	DeletedAt sql.NullTime `json:"deleted_at"`
 }
 
-func (q *Queries) DeleteUser(ctx context.Context, arg DeleteUserParams) (int64, error) {
-	result, err := q.db.ExecContext(ctx, deleteUser, arg.ID, arg.DeletedAt)
+func (q *Queries) DeleteUserByID(ctx context.Context, arg DeleteUserByIDParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteUserByID, arg.ID, arg.DeletedAt)
 	if err != nil {
 		return 0, err
 	}
@@ -166,6 +166,37 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 	return items, nil
 }
 
+const restoreUserByID = `-- name: RestoreUserByID :one
+update "user"
+set deleted_at = null,
+    updated_at = $2
+where id = $1
+  and deleted_at is not null
+returning id, created_at, updated_at, deleted_at, email, password, nickname, gender, role
+`
+
+type RestoreUserByIDParams struct {
+	ID        int64     `json:"id"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (q *Queries) RestoreUserByID(ctx context.Context, arg RestoreUserByIDParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, restoreUserByID, arg.ID, arg.UpdatedAt)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Email,
+		&i.Password,
+		&i.Nickname,
+		&i.Gender,
+		&i.Role,
+	)
+	return i, err
+}
+
 const updateUser = `-- name: UpdateUser :one
 update "user"
 set updated_at = $1,
@@ -209,3 +240,24 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 	)
 	return i, err
 }
+
+const updateUserPasswordHash = `-- name: UpdateUserPasswordHash :execrows
+update "user"
+set password = $1
+where id = $2
+  and password = $3
+`
+
+type UpdateUserPasswordHashParams struct {
+	NewPassword string `json:"new_password"`
+	ID          int64  `json:"id"`
+	OldPassword string `json:"old_password"`
+}
+
+func (q *Queries) UpdateUserPasswordHash(ctx context.Context, arg UpdateUserPasswordHashParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateUserPasswordHash, arg.NewPassword, arg.ID, arg.OldPassword)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
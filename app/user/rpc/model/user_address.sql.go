@@ -0,0 +1,249 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: user_address.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const clearUserDefaultAddress = `-- name: ClearUserDefaultAddress :exec
+update "user_address"
+set updated_at = $1,
+    is_default = false
+where user_id = $2
+  and is_default = true
+  and deleted_at IS NULL
+`
+
+type ClearUserDefaultAddressParams struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	UserID    int64     `json:"user_id"`
+}
+
+func (q *Queries) ClearUserDefaultAddress(ctx context.Context, arg ClearUserDefaultAddressParams) error {
+	_, err := q.db.ExecContext(ctx, clearUserDefaultAddress, arg.UpdatedAt, arg.UserID)
+	return err
+}
+
+const createUserAddress = `-- name: CreateUserAddress :one
+INSERT INTO "user_address"(user_id,
+                           name,
+                           mobile,
+                           address,
+                           post,
+                           is_default)
+VALUES ($1, $2, $3, $4, $5, $6)
+returning id, created_at, updated_at, deleted_at, user_id, name, mobile, address, post, is_default
+`
+
+type CreateUserAddressParams struct {
+	UserID    int64  `json:"user_id"`
+	Name      string `json:"name"`
+	Mobile    string `json:"mobile"`
+	Address   string `json:"address"`
+	Post      string `json:"post"`
+	IsDefault bool   `json:"is_default"`
+}
+
+func (q *Queries) CreateUserAddress(ctx context.Context, arg CreateUserAddressParams) (UserAddress, error) {
+	row := q.db.QueryRowContext(ctx, createUserAddress,
+		arg.UserID,
+		arg.Name,
+		arg.Mobile,
+		arg.Address,
+		arg.Post,
+		arg.IsDefault,
+	)
+	var i UserAddress
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.UserID,
+		&i.Name,
+		&i.Mobile,
+		&i.Address,
+		&i.Post,
+		&i.IsDefault,
+	)
+	return i, err
+}
+
+const deleteUserAddress = `-- name: DeleteUserAddress :execrows
+update "user_address"
+set deleted_at = $1
+where id = $2
+  and user_id = $3
+  and deleted_at IS NULL
+`
+
+type DeleteUserAddressParams struct {
+	DeletedAt sql.NullTime `json:"deleted_at"`
+	ID        int64        `json:"id"`
+	UserID    int64        `json:"user_id"`
+}
+
+func (q *Queries) DeleteUserAddress(ctx context.Context, arg DeleteUserAddressParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteUserAddress, arg.DeletedAt, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getUserAddress = `-- name: GetUserAddress :one
+SELECT id, created_at, updated_at, deleted_at, user_id, name, mobile, address, post, is_default
+FROM "user_address"
+WHERE deleted_at IS NULL
+  and id = $1
+  and user_id = $2
+LIMIT 1
+`
+
+type GetUserAddressParams struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+}
+
+func (q *Queries) GetUserAddress(ctx context.Context, arg GetUserAddressParams) (UserAddress, error) {
+	row := q.db.QueryRowContext(ctx, getUserAddress, arg.ID, arg.UserID)
+	var i UserAddress
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.UserID,
+		&i.Name,
+		&i.Mobile,
+		&i.Address,
+		&i.Post,
+		&i.IsDefault,
+	)
+	return i, err
+}
+
+const getUserDefaultAddress = `-- name: GetUserDefaultAddress :one
+SELECT id, created_at, updated_at, deleted_at, user_id, name, mobile, address, post, is_default
+FROM "user_address"
+WHERE deleted_at IS NULL
+  and user_id = $1
+  and is_default = true
+LIMIT 1
+`
+
+func (q *Queries) GetUserDefaultAddress(ctx context.Context, userID int64) (UserAddress, error) {
+	row := q.db.QueryRowContext(ctx, getUserDefaultAddress, userID)
+	var i UserAddress
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.UserID,
+		&i.Name,
+		&i.Mobile,
+		&i.Address,
+		&i.Post,
+		&i.IsDefault,
+	)
+	return i, err
+}
+
+const listUserAddresses = `-- name: ListUserAddresses :many
+SELECT id, created_at, updated_at, deleted_at, user_id, name, mobile, address, post, is_default
+FROM "user_address"
+WHERE deleted_at IS NULL
+  and user_id = $1
+order by is_default desc, id
+`
+
+func (q *Queries) ListUserAddresses(ctx context.Context, userID int64) ([]UserAddress, error) {
+	rows, err := q.db.QueryContext(ctx, listUserAddresses, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserAddress
+	for rows.Next() {
+		var i UserAddress
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.UserID,
+			&i.Name,
+			&i.Mobile,
+			&i.Address,
+			&i.Post,
+			&i.IsDefault,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateUserAddress = `-- name: UpdateUserAddress :one
+update "user_address"
+set updated_at = $1,
+    name       = $2,
+    mobile     = $3,
+    address    = $4,
+    post       = $5,
+    is_default = $6
+where id = $7
+  and user_id = $8
+  and deleted_at IS NULL
+returning id, created_at, updated_at, deleted_at, user_id, name, mobile, address, post, is_default
+`
+
+type UpdateUserAddressParams struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	Name      string    `json:"name"`
+	Mobile    string    `json:"mobile"`
+	Address   string    `json:"address"`
+	Post      string    `json:"post"`
+	IsDefault bool      `json:"is_default"`
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+}
+
+func (q *Queries) UpdateUserAddress(ctx context.Context, arg UpdateUserAddressParams) (UserAddress, error) {
+	row := q.db.QueryRowContext(ctx, updateUserAddress,
+		arg.UpdatedAt,
+		arg.Name,
+		arg.Mobile,
+		arg.Address,
+		arg.Post,
+		arg.IsDefault,
+		arg.ID,
+		arg.UserID,
+	)
+	var i UserAddress
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.UserID,
+		&i.Name,
+		&i.Mobile,
+		&i.Address,
+		&i.Post,
+		&i.IsDefault,
+	)
+	return i, err
+}
@@ -1,14 +1,12 @@
 package main
 
 import (
-	"crypto/sha512"
 	"fmt"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
 
-	"github.com/anaskhan96/go-password-encoder"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -55,9 +53,8 @@ func main() {
 
 	// 数据库的连接
 	sqlStore := model.NewSQLStore(global.DB)
-	// 验证密码的设置
-	options := &password.Options{SaltLen: 16, Iterations: 100, KeyLen: 32, HashFunction: sha512.New}
-	userServer := handler.NewUserServer(sqlStore, options)
+	// 保存和验证密码的算法
+	userServer := handler.NewUserServer(sqlStore, initialize.InitHasher())
 	proto.RegisterUserServer(grpcServer, userServer)
 
 	// 优先使用配置的端口
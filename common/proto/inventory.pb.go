@@ -134,6 +134,101 @@ func (x *GoodInvInfo) GetNum() int32 {
 	return 0
 }
 
+type BatchInvRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoodsIds []int32 `protobuf:"varint,1,rep,packed,name=goodsIds,proto3" json:"goodsIds,omitempty"`
+}
+
+func (x *BatchInvRequest) Reset() {
+	*x = BatchInvRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inventory_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchInvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchInvRequest) ProtoMessage() {}
+
+func (x *BatchInvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchInvRequest.ProtoReflect.Descriptor instead.
+func (*BatchInvRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BatchInvRequest) GetGoodsIds() []int32 {
+	if x != nil {
+		return x.GoodsIds
+	}
+	return nil
+}
+
+// 没有库存记录的商品不会返回
+type BatchInvResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoodsInfo []*GoodInvInfo `protobuf:"bytes,1,rep,name=goodsInfo,proto3" json:"goodsInfo,omitempty"`
+}
+
+func (x *BatchInvResponse) Reset() {
+	*x = BatchInvResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inventory_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchInvResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchInvResponse) ProtoMessage() {}
+
+func (x *BatchInvResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchInvResponse.ProtoReflect.Descriptor instead.
+func (*BatchInvResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchInvResponse) GetGoodsInfo() []*GoodInvInfo {
+	if x != nil {
+		return x.GoodsInfo
+	}
+	return nil
+}
+
 var File_inventory_proto protoreflect.FileDescriptor
 
 var file_inventory_proto_rawDesc = []byte{
@@ -147,17 +242,28 @@ var file_inventory_proto_rawDesc = []byte{
 	0x22, 0x39, 0x0a, 0x0b, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x76, 0x49, 0x6e, 0x66, 0x6f, 0x12,
 	0x18, 0x0a, 0x07, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
 	0x52, 0x07, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x32, 0x8e, 0x01, 0x0a, 0x09,
-	0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x06, 0x53, 0x65, 0x74,
-	0x49, 0x6e, 0x76, 0x12, 0x0c, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x76, 0x49, 0x6e, 0x66,
-	0x6f, 0x1a, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x09, 0x49, 0x6e, 0x76,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x0c, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x76,
-	0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x76, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x19, 0x0a, 0x04, 0x53, 0x65, 0x6c, 0x6c, 0x12, 0x09, 0x2e, 0x53, 0x65, 0x6c,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x1d, 0x0a,
-	0x08, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x09, 0x2e, 0x53, 0x65, 0x6c, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x09, 0x5a, 0x07,
-	0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x22, 0x2d, 0x0a, 0x0f, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x08, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x64, 0x73, 0x22, 0x3e, 0x0a, 0x10, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
+	0x0a, 0x09, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x76, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x09, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x32, 0xc5, 0x01, 0x0a, 0x09, 0x69,
+	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x49,
+	0x6e, 0x76, 0x12, 0x0c, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x76, 0x49, 0x6e, 0x66, 0x6f,
+	0x1a, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x0c, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x76, 0x49,
+	0x6e, 0x66, 0x6f, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x76, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x35, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x12, 0x10, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x76,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x53, 0x65, 0x6c, 0x6c,
+	0x12, 0x09, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x06, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x09, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x06, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -172,27 +278,32 @@ func file_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_proto_rawDescData
 }
 
-var file_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_inventory_proto_goTypes = []interface{}{
-	(*SellInfo)(nil),    // 0: SellInfo
-	(*GoodInvInfo)(nil), // 1: GoodInvInfo
-	(*Empty)(nil),       // 2: Empty
+	(*SellInfo)(nil),         // 0: SellInfo
+	(*GoodInvInfo)(nil),      // 1: GoodInvInfo
+	(*BatchInvRequest)(nil),  // 2: BatchInvRequest
+	(*BatchInvResponse)(nil), // 3: BatchInvResponse
+	(*Empty)(nil),            // 4: Empty
 }
 var file_inventory_proto_depIdxs = []int32{
 	1, // 0: SellInfo.goodsInfo:type_name -> GoodInvInfo
-	1, // 1: inventory.SetInv:input_type -> GoodInvInfo
-	1, // 2: inventory.InvDetail:input_type -> GoodInvInfo
-	0, // 3: inventory.Sell:input_type -> SellInfo
-	0, // 4: inventory.Rollback:input_type -> SellInfo
-	2, // 5: inventory.SetInv:output_type -> Empty
-	1, // 6: inventory.InvDetail:output_type -> GoodInvInfo
-	2, // 7: inventory.Sell:output_type -> Empty
-	2, // 8: inventory.Rollback:output_type -> Empty
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: BatchInvResponse.goodsInfo:type_name -> GoodInvInfo
+	1, // 2: inventory.SetInv:input_type -> GoodInvInfo
+	1, // 3: inventory.InvDetail:input_type -> GoodInvInfo
+	2, // 4: inventory.BatchInvDetail:input_type -> BatchInvRequest
+	0, // 5: inventory.Sell:input_type -> SellInfo
+	0, // 6: inventory.Rollback:input_type -> SellInfo
+	4, // 7: inventory.SetInv:output_type -> Empty
+	1, // 8: inventory.InvDetail:output_type -> GoodInvInfo
+	3, // 9: inventory.BatchInvDetail:output_type -> BatchInvResponse
+	4, // 10: inventory.Sell:output_type -> Empty
+	4, // 11: inventory.Rollback:output_type -> Empty
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_inventory_proto_init() }
@@ -226,6 +337,30 @@ func file_inventory_proto_init() {
 				return nil
 			}
 		}
+		file_inventory_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchInvRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inventory_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchInvResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -233,7 +368,7 @@ func file_inventory_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inventory_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -261,6 +396,7 @@ const _ = grpc.SupportPackageIsVersion6
 type InventoryClient interface {
 	SetInv(ctx context.Context, in *GoodInvInfo, opts ...grpc.CallOption) (*Empty, error)
 	InvDetail(ctx context.Context, in *GoodInvInfo, opts ...grpc.CallOption) (*GoodInvInfo, error)
+	BatchInvDetail(ctx context.Context, in *BatchInvRequest, opts ...grpc.CallOption) (*BatchInvResponse, error)
 	Sell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*Empty, error)
 	Rollback(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*Empty, error)
 }
@@ -291,6 +427,15 @@ func (c *inventoryClient) InvDetail(ctx context.Context, in *GoodInvInfo, opts .
 	return out, nil
 }
 
+func (c *inventoryClient) BatchInvDetail(ctx context.Context, in *BatchInvRequest, opts ...grpc.CallOption) (*BatchInvResponse, error) {
+	out := new(BatchInvResponse)
+	err := c.cc.Invoke(ctx, "/inventory/BatchInvDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryClient) Sell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/inventory/Sell", in, out, opts...)
@@ -313,6 +458,7 @@ func (c *inventoryClient) Rollback(ctx context.Context, in *SellInfo, opts ...gr
 type InventoryServer interface {
 	SetInv(context.Context, *GoodInvInfo) (*Empty, error)
 	InvDetail(context.Context, *GoodInvInfo) (*GoodInvInfo, error)
+	BatchInvDetail(context.Context, *BatchInvRequest) (*BatchInvResponse, error)
 	Sell(context.Context, *SellInfo) (*Empty, error)
 	Rollback(context.Context, *SellInfo) (*Empty, error)
 }
@@ -327,6 +473,9 @@ func (*UnimplementedInventoryServer) SetInv(context.Context, *GoodInvInfo) (*Emp
 func (*UnimplementedInventoryServer) InvDetail(context.Context, *GoodInvInfo) (*GoodInvInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvDetail not implemented")
 }
+func (*UnimplementedInventoryServer) BatchInvDetail(context.Context, *BatchInvRequest) (*BatchInvResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchInvDetail not implemented")
+}
 func (*UnimplementedInventoryServer) Sell(context.Context, *SellInfo) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sell not implemented")
 }
@@ -374,6 +523,24 @@ func _Inventory_InvDetail_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Inventory_BatchInvDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchInvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).BatchInvDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inventory/BatchInvDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).BatchInvDetail(ctx, req.(*BatchInvRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Inventory_Sell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SellInfo)
 	if err := dec(in); err != nil {
@@ -422,6 +589,10 @@ var _Inventory_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InvDetail",
 			Handler:    _Inventory_InvDetail_Handler,
 		},
+		{
+			MethodName: "BatchInvDetail",
+			Handler:    _Inventory_BatchInvDetail_Handler,
+		},
 		{
 			MethodName: "Sell",
 			Handler:    _Inventory_Sell_Handler,
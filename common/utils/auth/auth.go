@@ -0,0 +1,181 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/utils/paseto"
+)
+
+// 迁移时创建的两个角色的ID
+const (
+	RoleUser  int32 = 1 // 普通用户
+	RoleAdmin int32 = 2 // 管理员 拥有所有的权限
+)
+
+// 权限的 code 格式为 资源:操作，和 user 服务中 permission 表的数据对应
+const (
+	PermGoodsWrite    = "goods:write"     // 创建、修改和删除商品
+	PermCouponWrite   = "coupon:write"    // 创建优惠券模板
+	PermShipmentWrite = "shipment:write"  // 订单发货
+	PermOrderReadAll  = "order:read_all"  // 查看所有用户的订单
+	PermOrderWriteAll = "order:write_all" // 替其他用户下单和修改订单
+	PermReportRead    = "report:read"     // 查看销售报表
+	PermUserReadAll   = "user:read_all"   // 查看所有用户的信息
+	PermUserWriteAll  = "user:write_all"  // 修改所有用户的信息
+	PermRoleManage    = "role:manage"     // 管理角色和用户的角色
+)
+
+//
+// Authenticator
+//  @Description: 所有 api 服务共用的认证，验证 token 并检查是否被吊销
+//
+type Authenticator struct {
+	maker   *paseto.PasetoMaker
+	revoker *paseto.Revoker
+	logger  *zap.Logger
+}
+
+//
+// NewAuthenticator
+//  @Description: 创建 Authenticator
+//  @param maker
+//  @param revoker
+//  @param logger
+//  @return *Authenticator
+//
+func NewAuthenticator(maker *paseto.PasetoMaker, revoker *paseto.Revoker, logger *zap.Logger) *Authenticator {
+	return &Authenticator{maker: maker, revoker: revoker, logger: logger}
+}
+
+//
+// Paseto
+//  @Description: 验证请求中的 access token，成功后把载荷放到 ctx 中
+//  @receiver authenticator
+//  @return gin.HandlerFunc
+//
+func (authenticator *Authenticator) Paseto() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		tokenHeader := ctx.Request.Header.Get("Authorization")
+		if tokenHeader == "" {
+			model.FailWithMsg("token 无效", ctx)
+			ctx.Abort()
+			return
+		}
+		check := strings.SplitN(tokenHeader, " ", 2)
+		if len(check) != 2 || check[0] != "Bearer" {
+			model.FailWithMsg("token 格式错误", ctx)
+			ctx.Abort()
+			return
+		}
+		payload, err := authenticator.maker.VerifyToken(check[1])
+		if errors.Is(err, paseto.ErrExpiredToken) {
+			model.FailWithMsg("token 过期", ctx)
+			ctx.Abort()
+			return
+		} else if err != nil {
+			model.FailWithMsg("token 格式错误", ctx)
+			ctx.Abort()
+			return
+		}
+		// 退出登录、修改密码或者注销账号后 token 会被吊销
+		err = authenticator.revoker.Check(ctx, payload)
+		if errors.Is(err, paseto.ErrRevokedToken) {
+			model.FailWithMsg("token 已失效", ctx)
+			ctx.Abort()
+			return
+		} else if err != nil {
+			authenticator.logger.Error("检查token是否吊销失败", zap.Error(err))
+			model.FailWithMsg("系统错误，请稍后重试", ctx)
+			ctx.Abort()
+			return
+		}
+		ctx.Set("payload", payload)
+	}
+}
+
+//
+// HasPermission
+//  @Description: 权限列表中是否有 permission
+//  @param permissions
+//  @param permission
+//  @return bool
+//
+func HasPermission(permissions []string, permission string) bool {
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+//
+// RequirePermission
+//  @Description: 声明路由需要的权限，需要拥有所有的权限才能访问，需要在 Paseto 之后使用
+//  @param permissions
+//  @return gin.HandlerFunc
+//
+func RequirePermission(permissions ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		payload, err := paseto.GetPayloadFormCtx(ctx)
+		if err == nil {
+			granted := true
+			for _, permission := range permissions {
+				granted = granted && HasPermission(payload.Permissions, permission)
+			}
+			if granted {
+				return
+			}
+		}
+		model.FailWithMsg("权限不足", ctx)
+		ctx.Abort()
+	}
+}
+
+//
+// CheckOwner
+//  @Description: 检查资源是否属于当前用户，有 permission 权限时可以访问所有用户的资源
+//  不属于当前用户时已经返回了错误信息
+//  @param ctx
+//  @param ownerID 资源所属的用户
+//  @param permission 访问其他用户的资源需要的权限
+//  @return bool
+//
+func CheckOwner(ctx *gin.Context, ownerID int32, permission string) bool {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err == nil && (payload.UID == ownerID || HasPermission(payload.Permissions, permission)) {
+		return true
+	}
+	model.FailWithMsg("权限不足", ctx)
+	return false
+}
+
+//
+// ResolveUserID
+//  @Description: 获得请求要操作的用户，没有指定时为当前用户，指定其他用户时需要有 permission 权限
+//  失败时已经返回了错误信息
+//  @param ctx
+//  @param uid 请求中的用户ID 可以为 0
+//  @param permission 操作其他用户需要的权限
+//  @return int32
+//  @return bool
+//
+func ResolveUserID(ctx *gin.Context, uid int32, permission string) (int32, bool) {
+	payload, err := paseto.GetPayloadFormCtx(ctx)
+	if err != nil {
+		model.FailWithMsg("权限不足", ctx)
+		return 0, false
+	}
+	if uid == 0 {
+		return payload.UID, true
+	}
+	if !CheckOwner(ctx, uid, permission) {
+		return 0, false
+	}
+	return uid, true
+}
@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jimyag/shop/common/model"
+	"github.com/jimyag/shop/common/utils/paseto"
+)
+
+func newTestContext(payload *paseto.Payload) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	if payload != nil {
+		ctx.Set("payload", payload)
+	}
+	return ctx, recorder
+}
+
+func responseMsg(t *testing.T, recorder *httptest.ResponseRecorder) interface{} {
+	rsp := model.Response{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+	return rsp.Msg
+}
+
+func TestRequirePermission(t *testing.T) {
+	handler := RequirePermission(PermGoodsWrite)
+
+	ctx, _ := newTestContext(&paseto.Payload{UID: 1, Permissions: []string{PermOrderReadAll, PermGoodsWrite}})
+	handler(ctx)
+	require.False(t, ctx.IsAborted())
+
+	// 数字的角色不再用来判断权限
+	ctx, recorder := newTestContext(&paseto.Payload{UID: 1, Role: RoleAdmin, Permissions: []string{PermOrderReadAll}})
+	handler(ctx)
+	require.True(t, ctx.IsAborted())
+	require.Equal(t, "权限不足", responseMsg(t, recorder))
+
+	// 需要拥有所有的权限
+	ctx, _ = newTestContext(&paseto.Payload{UID: 1, Permissions: []string{PermGoodsWrite}})
+	RequirePermission(PermGoodsWrite, PermReportRead)(ctx)
+	require.True(t, ctx.IsAborted())
+
+	// 没有经过认证
+	ctx, _ = newTestContext(nil)
+	handler(ctx)
+	require.True(t, ctx.IsAborted())
+}
+
+func TestCheckOwner(t *testing.T) {
+	ctx, _ := newTestContext(&paseto.Payload{UID: 1})
+	require.True(t, CheckOwner(ctx, 1, PermOrderReadAll))
+
+	ctx, recorder := newTestContext(&paseto.Payload{UID: 1, Permissions: []string{PermUserReadAll}})
+	require.False(t, CheckOwner(ctx, 2, PermOrderReadAll))
+	require.Equal(t, "权限不足", responseMsg(t, recorder))
+
+	// 有权限时可以访问其他用户的资源
+	ctx, _ = newTestContext(&paseto.Payload{UID: 1, Permissions: []string{PermOrderReadAll}})
+	require.True(t, CheckOwner(ctx, 2, PermOrderReadAll))
+
+	ctx, _ = newTestContext(nil)
+	require.False(t, CheckOwner(ctx, 1, PermOrderReadAll))
+}
+
+func TestResolveUserID(t *testing.T) {
+	ctx, _ := newTestContext(&paseto.Payload{UID: 1})
+	uid, ok := ResolveUserID(ctx, 0, PermUserWriteAll)
+	require.True(t, ok)
+	require.Equal(t, int32(1), uid)
+
+	// 没有权限时不能操作其他用户
+	ctx, recorder := newTestContext(&paseto.Payload{UID: 1})
+	_, ok = ResolveUserID(ctx, 2, PermUserWriteAll)
+	require.False(t, ok)
+	require.Equal(t, "权限不足", responseMsg(t, recorder))
+
+	ctx, _ = newTestContext(&paseto.Payload{UID: 1, Permissions: []string{PermUserWriteAll}})
+	uid, ok = ResolveUserID(ctx, 2, PermUserWriteAll)
+	require.True(t, ok)
+	require.Equal(t, int32(2), uid)
+
+	ctx, _ = newTestContext(nil)
+	_, ok = ResolveUserID(ctx, 0, PermUserWriteAll)
+	require.False(t, ok)
+}
+
+func TestAuthenticator_Paseto(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	maker, err := paseto.NewPasetoMaker(privateKey, publicKey, time.Hour, 24*time.Hour)
+	require.NoError(t, err)
+	authenticator := NewAuthenticator(maker, nil, zap.NewNop())
+	_, refreshPayload, err := maker.CreateRefreshToken(&paseto.Payload{UID: 1})
+	require.NoError(t, err)
+	refreshToken, _, err := maker.CreateRefreshToken(refreshPayload)
+	require.NoError(t, err)
+
+	// 验证失败的 token 不会检查是否吊销
+	for header, msg := range map[string]string{
+		"":                       "token 无效",
+		"Token abc":              "token 格式错误",
+		"Bearer abc":             "token 格式错误",
+		"Bearer " + refreshToken: "token 格式错误",
+	} {
+		ctx, recorder := newTestContext(nil)
+		ctx.Request.Header.Set("Authorization", header)
+		authenticator.Paseto()(ctx)
+		require.True(t, ctx.IsAborted(), header)
+		require.Equal(t, msg, responseMsg(t, recorder), header)
+	}
+}
@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/common/utils/paseto"
+)
+
+// 调用者的身份在 grpc metadata 中的 key
+const (
+	metadataUID         = "x-caller-uid"
+	metadataRole        = "x-caller-role"
+	metadataPermissions = "x-caller-permissions"
+)
+
+//
+// Caller
+//  @Description: 发起 grpc 调用的用户
+//
+type Caller struct {
+	UID         int32
+	Role        int32
+	Permissions []string
+}
+
+//
+// UnaryClientInterceptor
+//  @Description: api 调用 grpc 时把 ctx 中当前用户的身份放到 metadata 中，rpc 服务用它检查权限
+//  @return grpc.UnaryClientInterceptor
+//
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		// gin.Context 的 Value 会返回 ctx.Set 的值
+		if payload, ok := ctx.Value("payload").(*paseto.Payload); ok && payload != nil {
+			ctx = NewOutgoingContext(ctx, Caller{UID: payload.UID, Role: payload.Role, Permissions: payload.Permissions})
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+//
+// NewOutgoingContext
+//  @Description: 以 caller 的身份调用 rpc 服务
+//  @param ctx
+//  @param caller
+//  @return context.Context
+//
+func NewOutgoingContext(ctx context.Context, caller Caller) context.Context {
+	kv := []string{
+		metadataUID, strconv.Itoa(int(caller.UID)),
+		metadataRole, strconv.Itoa(int(caller.Role)),
+	}
+	for _, permission := range caller.Permissions {
+		kv = append(kv, metadataPermissions, permission)
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+//
+// CallerFromContext
+//  @Description: rpc 服务从 metadata 中获得调用者的身份
+//  @param ctx
+//  @return Caller
+//  @return bool 没有调用者时为 false，说明是服务之间的内部调用
+//
+func CallerFromContext(ctx context.Context) (Caller, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return Caller{}, false
+	}
+	uids, roles := md.Get(metadataUID), md.Get(metadataRole)
+	if len(uids) == 0 && len(roles) == 0 {
+		return Caller{}, false
+	}
+	// 无法解析时返回零值，零值的调用者不能访问任何用户的资源
+	if len(uids) == 0 || len(roles) == 0 {
+		return Caller{}, true
+	}
+	uid, err := strconv.Atoi(uids[0])
+	if err != nil || uid <= 0 {
+		return Caller{}, true
+	}
+	role, err := strconv.Atoi(roles[0])
+	if err != nil {
+		return Caller{}, true
+	}
+	return Caller{UID: int32(uid), Role: int32(role), Permissions: md.Get(metadataPermissions)}, true
+}
+
+//
+// CheckCaller
+//  @Description: 检查调用者是否可以访问属于 ownerID 的资源，有 permission 权限时可以访问其他用户的资源
+//  @param ctx
+//  @param ownerID
+//  @param permission 访问其他用户的资源需要的权限
+//  @return error grpc 的错误
+//
+func CheckCaller(ctx context.Context, ownerID int32, permission string) error {
+	caller, ok := CallerFromContext(ctx)
+	if !ok || (caller.UID != 0 && caller.UID == ownerID) || HasPermission(caller.Permissions, permission) {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "权限不足")
+}
+
+//
+// CheckCallerPermission
+//  @Description: 检查调用者是否有 permission 权限
+//  @param ctx
+//  @param permission
+//  @return error grpc 的错误
+//
+func CheckCallerPermission(ctx context.Context, permission string) error {
+	caller, ok := CallerFromContext(ctx)
+	if !ok || HasPermission(caller.Permissions, permission) {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "权限不足")
+}
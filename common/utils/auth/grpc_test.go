@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/jimyag/shop/common/utils/paseto"
+)
+
+// incomingContext 模拟 rpc 服务收到的 ctx
+func incomingContext(t *testing.T, payload *paseto.Payload) context.Context {
+	ginCtx, _ := newTestContext(payload)
+	var outgoing context.Context
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoing = ctx
+		return nil
+	}
+	require.NoError(t, UnaryClientInterceptor()(ginCtx, "/Test", nil, nil, nil, invoker))
+	md, _ := metadata.FromOutgoingContext(outgoing)
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	permissions := []string{PermOrderReadAll, PermReportRead}
+	caller, ok := CallerFromContext(incomingContext(t, &paseto.Payload{UID: 3, Role: RoleUser, Permissions: permissions}))
+	require.True(t, ok)
+	require.Equal(t, Caller{UID: 3, Role: RoleUser, Permissions: permissions}, caller)
+
+	// 没有登录的请求不会带上身份
+	_, ok = CallerFromContext(incomingContext(t, nil))
+	require.False(t, ok)
+}
+
+func TestCheckCaller(t *testing.T) {
+	user := incomingContext(t, &paseto.Payload{UID: 3, Role: RoleUser})
+	require.NoError(t, CheckCaller(user, 3, PermUserReadAll))
+	require.Equal(t, codes.PermissionDenied, status.Code(CheckCaller(user, 4, PermUserReadAll)))
+	require.Equal(t, codes.PermissionDenied, status.Code(CheckCallerPermission(user, PermRoleManage)))
+
+	admin := incomingContext(t, &paseto.Payload{UID: 1, Role: RoleAdmin, Permissions: []string{PermUserReadAll, PermRoleManage}})
+	require.NoError(t, CheckCaller(admin, 4, PermUserReadAll))
+	require.Equal(t, codes.PermissionDenied, status.Code(CheckCaller(admin, 4, PermUserWriteAll)))
+	require.NoError(t, CheckCallerPermission(admin, PermRoleManage))
+
+	// 服务之间的内部调用没有调用者
+	require.NoError(t, CheckCaller(context.Background(), 4, PermUserReadAll))
+	require.NoError(t, CheckCallerPermission(context.Background(), PermRoleManage))
+
+	// 无法解析的身份不能访问任何用户的资源
+	forged := metadata.NewIncomingContext(context.Background(), metadata.Pairs(metadataUID, "x", metadataRole, "2", metadataPermissions, PermRoleManage))
+	caller, ok := CallerFromContext(forged)
+	require.True(t, ok)
+	require.Equal(t, Caller{}, caller)
+	require.Error(t, CheckCaller(forged, 0, PermUserReadAll))
+	require.Error(t, CheckCallerPermission(forged, PermRoleManage))
+}
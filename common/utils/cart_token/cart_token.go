@@ -0,0 +1,66 @@
+package cart_token
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/jimyag/shop/common/utils/uuid"
+)
+
+const (
+	Header     = "X-Cart-Token" // 游客购物车标识的 header
+	CookieName = "cart_token"   // 游客购物车标识的 cookie
+	MaxLength  = 64             // 标识的最大长度
+	cookieAge  = 30 * 24 * 3600 // cookie 的有效期 30 天
+)
+
+//
+// FromRequest
+//  @Description: 从请求中获取游客购物车的标识，优先使用 header
+//  @param ctx
+//  @return string 没有或者不合法时返回空
+//
+func FromRequest(ctx *gin.Context) string {
+	token := strings.TrimSpace(ctx.GetHeader(Header))
+	if token == "" {
+		token, _ = ctx.Cookie(CookieName)
+		token = strings.TrimSpace(token)
+	}
+	if len(token) > MaxLength {
+		return ""
+	}
+	return token
+}
+
+//
+// New
+//  @Description: 生成新的游客购物车标识，使用随机的 uuid 不能被猜测
+//  @return string
+//
+func New() string {
+	return uuid.GetUUid().String()
+}
+
+//
+// Set
+//  @Description: 在响应中返回游客购物车的标识
+//  @param ctx
+//  @param token
+//
+func Set(ctx *gin.Context, token string) {
+	ctx.Header(Header, token)
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(CookieName, token, cookieAge, "/", "", false, true)
+}
+
+//
+// Clear
+//  @Description: 合并购物车之后清除游客购物车的标识
+//  @param ctx
+//
+func Clear(ctx *gin.Context) {
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(CookieName, "", -1, "/", "", false, true)
+}
@@ -0,0 +1,47 @@
+package cart_token
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newContext(header, cookie string) *gin.Context {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	if header != "" {
+		ctx.Request.Header.Set(Header, header)
+	}
+	if cookie != "" {
+		ctx.Request.AddCookie(&http.Cookie{Name: CookieName, Value: cookie})
+	}
+	return ctx
+}
+
+func TestFromRequest(t *testing.T) {
+	require.Empty(t, FromRequest(newContext("", "")))
+	require.Equal(t, "from-cookie", FromRequest(newContext("", "from-cookie")))
+	// header 优先
+	require.Equal(t, "from-header", FromRequest(newContext("from-header", "from-cookie")))
+	// 太长的标识不合法
+	require.Empty(t, FromRequest(newContext(strings.Repeat("a", MaxLength+1), "")))
+}
+
+func TestNew(t *testing.T) {
+	token := New()
+	require.NotEmpty(t, token)
+	require.LessOrEqual(t, len(token), MaxLength)
+	require.NotEqual(t, token, New())
+}
+
+func TestSet(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+	Set(ctx, "token")
+	require.Equal(t, "token", recorder.Header().Get(Header))
+	require.Contains(t, recorder.Header().Get("Set-Cookie"), CookieName+"=token")
+}
@@ -0,0 +1,91 @@
+package hasher
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const argon2idPrefix = "$argon2id$"
+
+//
+// Argon2id
+//  @Description: argon2id 算法，保存为 PHC 格式 $argon2id$v=19$m=65536,t=3,p=2$salt$hash
+//
+type Argon2id struct {
+	Memory  uint32 // 使用的内存 单位 KiB
+	Time    uint32 // 迭代的次数
+	Threads uint8  // 并行的线程数
+	SaltLen int    // 盐的长度 字节
+	KeyLen  uint32 // hash 的长度 字节
+}
+
+// DefaultArgon2id OWASP 推荐的参数
+var DefaultArgon2id = Argon2id{Memory: 64 * 1024, Time: 3, Threads: 2, SaltLen: 16, KeyLen: 32}
+
+type argon2idHash struct {
+	Argon2id
+	salt []byte
+	key  []byte
+}
+
+func (a Argon2id) parse(encoded string) (argon2idHash, error) {
+	// "", argon2id, v=19, m=..,t=..,p=.., salt, hash
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return argon2idHash{}, ErrMalformedHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return argon2idHash{}, ErrMalformedHash
+	}
+	h := argon2idHash{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &h.Memory, &h.Time, &h.Threads); err != nil {
+		return argon2idHash{}, ErrMalformedHash
+	}
+	var err error
+	if h.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return argon2idHash{}, ErrMalformedHash
+	}
+	if h.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(h.key) == 0 {
+		return argon2idHash{}, ErrMalformedHash
+	}
+	h.SaltLen = len(h.salt)
+	h.KeyLen = uint32(len(h.key))
+	return h, nil
+}
+
+func (a Argon2id) Match(encoded string) bool {
+	return strings.HasPrefix(encoded, argon2idPrefix)
+}
+
+func (a Argon2id) Hash(password string) (string, error) {
+	salt := make([]byte, a.SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, a.Time, a.Memory, a.Threads, a.KeyLen)
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argon2idPrefix, argon2.Version, a.Memory, a.Time, a.Threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func (a Argon2id) Verify(password string, encoded string) (bool, error) {
+	h, err := a.parse(encoded)
+	if err != nil {
+		return false, err
+	}
+	key := argon2.IDKey([]byte(password), h.salt, h.Time, h.Memory, h.Threads, h.KeyLen)
+	return subtle.ConstantTimeCompare(key, h.key) == 1, nil
+}
+
+func (a Argon2id) NeedsRehash(encoded string) bool {
+	h, err := a.parse(encoded)
+	if err != nil {
+		return true
+	}
+	return h.Memory < a.Memory || h.Time < a.Time || h.Threads < a.Threads || h.KeyLen < a.KeyLen || h.SaltLen < a.SaltLen
+}
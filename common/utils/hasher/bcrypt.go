@@ -0,0 +1,43 @@
+package hasher
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+//
+// Bcrypt
+//  @Description: bcrypt 算法，使用 bcrypt 自己的格式 $2a$12$...，密码最多使用前 72 个字节
+//
+type Bcrypt struct {
+	Cost int
+}
+
+// DefaultBcrypt 默认的参数
+var DefaultBcrypt = Bcrypt{Cost: 12}
+
+func (b Bcrypt) Match(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") || strings.HasPrefix(encoded, "$2b$") || strings.HasPrefix(encoded, "$2y$")
+}
+
+func (b Bcrypt) Hash(password string) (string, error) {
+	encoded, err := bcrypt.GenerateFromPassword([]byte(password), b.Cost)
+	return string(encoded), err
+}
+
+func (b Bcrypt) Verify(password string, encoded string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	} else if err != nil {
+		return false, ErrMalformedHash
+	}
+	return true, nil
+}
+
+func (b Bcrypt) NeedsRehash(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	return err != nil || cost < b.Cost
+}
@@ -0,0 +1,85 @@
+package hasher
+
+import (
+	"errors"
+)
+
+// 解析保存的密码时返回的错误
+var (
+	ErrUnknownAlgorithm = errors.New("unknown password hash algorithm")
+	ErrMalformedHash    = errors.New("malformed password hash")
+)
+
+//
+// Algorithm
+//  @Description: 一种密码 hash 算法，算法和参数都编码在生成的字符串中
+//
+type Algorithm interface {
+	// Match 保存的密码是否使用这种算法
+	Match(encoded string) bool
+	// Hash 使用当前的参数计算密码的 hash
+	Hash(password string) (string, error)
+	// Verify 检查密码，密码错误时返回 false 和 nil
+	Verify(password string, encoded string) (bool, error)
+	// NeedsRehash 保存的密码的参数是否比当前的参数弱
+	NeedsRehash(encoded string) bool
+}
+
+//
+// Hasher
+//  @Description: 使用首选的算法保存密码，同时可以验证其他算法保存的旧密码
+//
+type Hasher struct {
+	preferred  Algorithm
+	algorithms []Algorithm
+}
+
+//
+// NewHasher
+//  @Description: 创建 Hasher
+//  @param preferred 新密码使用的算法
+//  @param others 还需要支持验证的算法
+//  @return *Hasher
+//
+func NewHasher(preferred Algorithm, others ...Algorithm) *Hasher {
+	return &Hasher{
+		preferred:  preferred,
+		algorithms: append([]Algorithm{preferred}, others...),
+	}
+}
+
+//
+// Hash
+//  @Description: 使用首选的算法计算密码的 hash
+//  @receiver h
+//  @param password
+//  @return string
+//  @return error
+//
+func (h *Hasher) Hash(password string) (string, error) {
+	return h.preferred.Hash(password)
+}
+
+//
+// Verify
+//  @Description: 检查密码，密码正确并且保存的 hash 不是首选的算法或参数时需要重新计算
+//  @receiver h
+//  @param password
+//  @param encoded 保存的密码
+//  @return ok 密码是否正确
+//  @return rehash 是否需要使用 Hash 重新计算并保存
+//  @return err 不支持的算法或者格式错误
+//
+func (h *Hasher) Verify(password string, encoded string) (ok bool, rehash bool, err error) {
+	for _, algorithm := range h.algorithms {
+		if !algorithm.Match(encoded) {
+			continue
+		}
+		ok, err = algorithm.Verify(password, encoded)
+		if err != nil || !ok {
+			return false, false, err
+		}
+		return true, algorithm != h.preferred || algorithm.NeedsRehash(encoded), nil
+	}
+	return false, false, ErrUnknownAlgorithm
+}
@@ -0,0 +1,109 @@
+package hasher
+
+import (
+	"crypto/sha512"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/anaskhan96/go-password-encoder"
+	"github.com/stretchr/testify/require"
+)
+
+// 测试使用较小的参数
+var (
+	testArgon2id = Argon2id{Memory: 1024, Time: 1, Threads: 1, SaltLen: 16, KeyLen: 32}
+	testBcrypt   = Bcrypt{Cost: 4}
+	testPBKDF2   = PBKDF2{Iterations: 1000, SaltLen: 16, KeyLen: 32, LegacyIterations: 100}
+)
+
+func TestAlgorithms(t *testing.T) {
+	for _, algorithm := range []Algorithm{testArgon2id, testBcrypt, testPBKDF2} {
+		encoded, err := algorithm.Hash("secret123")
+		require.NoError(t, err)
+		require.True(t, algorithm.Match(encoded))
+		require.False(t, algorithm.NeedsRehash(encoded))
+
+		ok, err := algorithm.Verify("secret123", encoded)
+		require.NoError(t, err)
+		require.True(t, ok)
+		ok, err = algorithm.Verify("secret124", encoded)
+		require.NoError(t, err)
+		require.False(t, ok)
+
+		// 每次使用不同的盐
+		other, err := algorithm.Hash("secret123")
+		require.NoError(t, err)
+		require.NotEqual(t, encoded, other)
+	}
+}
+
+func TestArgon2id_Format(t *testing.T) {
+	encoded, err := testArgon2id.Hash("secret123")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(encoded, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	// 参数变强之后需要重新计算
+	stronger := testArgon2id
+	stronger.Time = 2
+	require.True(t, stronger.NeedsRehash(encoded))
+
+	_, err = testArgon2id.Verify("secret123", "$argon2id$v=19$m=1024$salt$hash")
+	require.ErrorIs(t, err, ErrMalformedHash)
+}
+
+func TestPBKDF2_Legacy(t *testing.T) {
+	// 原来使用 go-password-encoder 保存的密码
+	options := &password.Options{SaltLen: 16, Iterations: 100, KeyLen: 32, HashFunction: sha512.New}
+	salt, key := password.Encode("secret123", options)
+	legacy := fmt.Sprintf("$pbkdf2-sha512$%s$%s", salt, key)
+
+	require.True(t, testPBKDF2.Match(legacy))
+	ok, err := testPBKDF2.Verify("secret123", legacy)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = testPBKDF2.Verify("secret124", legacy)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.True(t, testPBKDF2.NeedsRehash(legacy))
+
+	encoded, err := testPBKDF2.Hash("secret123")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(encoded, "$pbkdf2-sha512$i=1000$"))
+}
+
+func TestHasher_Verify(t *testing.T) {
+	options := &password.Options{SaltLen: 16, Iterations: 100, KeyLen: 32, HashFunction: sha512.New}
+	salt, key := password.Encode("secret123", options)
+	legacy := fmt.Sprintf("$pbkdf2-sha512$%s$%s", salt, key)
+	bcryptHash, err := testBcrypt.Hash("secret123")
+	require.NoError(t, err)
+
+	h := NewHasher(testArgon2id, testBcrypt, testPBKDF2)
+	current, err := h.Hash("secret123")
+	require.NoError(t, err)
+	require.True(t, testArgon2id.Match(current))
+
+	// 旧的密码还可以使用，验证通过后需要使用首选的算法重新计算
+	for _, encoded := range []string{legacy, bcryptHash} {
+		ok, rehash, err := h.Verify("secret123", encoded)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.True(t, rehash)
+
+		ok, rehash, err = h.Verify("wrong", encoded)
+		require.NoError(t, err)
+		require.False(t, ok)
+		require.False(t, rehash)
+	}
+
+	ok, rehash, err := h.Verify("secret123", current)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.False(t, rehash)
+
+	_, _, err = h.Verify("secret123", "$md5$abc")
+	require.ErrorIs(t, err, ErrUnknownAlgorithm)
+	_, _, err = NewHasher(testArgon2id).Verify("secret123", legacy)
+	require.ErrorIs(t, err, ErrUnknownAlgorithm)
+}
@@ -0,0 +1,96 @@
+package hasher
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const pbkdf2Prefix = "$pbkdf2-sha512$"
+
+// 和 go-password-encoder 生成的盐使用相同的字符
+const saltAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+//
+// PBKDF2
+//  @Description: pbkdf2-sha512 算法，保存为 $pbkdf2-sha512$i=210000$salt$hash
+//  也可以验证原来没有记录迭代次数的 $pbkdf2-sha512$salt$hash，使用 LegacyIterations
+//
+type PBKDF2 struct {
+	Iterations       int // 迭代的次数
+	SaltLen          int // 盐的长度
+	KeyLen           int // hash 的长度 字节
+	LegacyIterations int // 旧格式的密码使用的迭代次数
+}
+
+// DefaultPBKDF2 OWASP 推荐的参数，旧格式的密码使用原来配置的 100 次迭代
+var DefaultPBKDF2 = PBKDF2{Iterations: 210000, SaltLen: 16, KeyLen: 32, LegacyIterations: 100}
+
+type pbkdf2Hash struct {
+	iterations int
+	salt       string
+	key        []byte
+	legacy     bool
+}
+
+func (p PBKDF2) parse(encoded string) (pbkdf2Hash, error) {
+	parts := strings.Split(encoded, "$")
+	h := pbkdf2Hash{}
+	switch {
+	case len(parts) == 4 && parts[1] == "pbkdf2-sha512":
+		// "", pbkdf2-sha512, salt, hash
+		h.iterations, h.salt, h.legacy = p.LegacyIterations, parts[2], true
+	case len(parts) == 5 && parts[1] == "pbkdf2-sha512":
+		// "", pbkdf2-sha512, i=.., salt, hash
+		if _, err := fmt.Sscanf(parts[2], "i=%d", &h.iterations); err != nil || h.iterations <= 0 {
+			return pbkdf2Hash{}, ErrMalformedHash
+		}
+		h.salt = parts[3]
+	default:
+		return pbkdf2Hash{}, ErrMalformedHash
+	}
+	key, err := hex.DecodeString(parts[len(parts)-1])
+	if err != nil || len(key) == 0 {
+		return pbkdf2Hash{}, ErrMalformedHash
+	}
+	h.key = key
+	return h, nil
+}
+
+func (p PBKDF2) Match(encoded string) bool {
+	return strings.HasPrefix(encoded, pbkdf2Prefix)
+}
+
+func (p PBKDF2) Hash(password string) (string, error) {
+	salt := make([]byte, p.SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	for i, b := range salt {
+		salt[i] = saltAlphabet[int(b)%len(saltAlphabet)]
+	}
+	key := pbkdf2.Key([]byte(password), salt, p.Iterations, p.KeyLen, sha512.New)
+	return fmt.Sprintf("%si=%d$%s$%s", pbkdf2Prefix, p.Iterations, salt, hex.EncodeToString(key)), nil
+}
+
+func (p PBKDF2) Verify(password string, encoded string) (bool, error) {
+	h, err := p.parse(encoded)
+	if err != nil {
+		return false, err
+	}
+	key := pbkdf2.Key([]byte(password), []byte(h.salt), h.iterations, len(h.key), sha512.New)
+	return subtle.ConstantTimeCompare(key, h.key) == 1, nil
+}
+
+func (p PBKDF2) NeedsRehash(encoded string) bool {
+	h, err := p.parse(encoded)
+	if err != nil {
+		return true
+	}
+	return h.legacy || h.iterations < p.Iterations || len(h.key) < p.KeyLen || len(h.salt) < p.SaltLen
+}
@@ -0,0 +1,188 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency 没有指定币种时使用的币种
+const DefaultCurrency = "CNY"
+
+// ErrUnsupportedCurrency 没有这个币种的汇率
+var ErrUnsupportedCurrency = errors.New("不支持的币种")
+
+// 最小货币单位不是分的币种，其他币种都是两位小数
+var minorUnits = map[string]int{
+	"JPY": 0,
+	"KRW": 0,
+	"VND": 0,
+}
+
+//
+// MinorUnit
+//  @Description: 币种的小数位数
+//  @param currency
+//  @return int
+//
+func MinorUnit(currency string) int {
+	if unit, ok := minorUnits[NormalizeCurrency(currency)]; ok {
+		return unit
+	}
+	return 2
+}
+
+//
+// NormalizeCurrency
+//  @Description: 币种统一使用大写的 ISO 4217 代码
+//  @param currency
+//  @return string
+//
+func NormalizeCurrency(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
+
+//
+// FromMajor
+//  @Description: 将以主单位表示的浮点数金额转换为该币种的最小单位
+//  @param amount
+//  @param currency
+//  @return int64
+//
+func FromMajor(amount float64, currency string) int64 {
+	return FromYuan(amount) / int64(math.Pow10(2-MinorUnit(currency)))
+}
+
+//
+// ToMajor
+//  @Description: 将最小单位的金额转换为以主单位表示的浮点数，只用于兼容旧的接口
+//  @param amount
+//  @param currency
+//  @return float64
+//
+func ToMajor(amount int64, currency string) float64 {
+	return float64(amount) / math.Pow10(MinorUnit(currency))
+}
+
+//
+// FormatCurrency
+//  @Description: 按照币种的小数位数格式化金额，比如 "12.30 USD" "1200 JPY"
+//  @param amount
+//  @param currency
+//  @return string
+//
+func FormatCurrency(amount int64, currency string) string {
+	currency = NormalizeCurrency(currency)
+	return FormatAmount(amount, currency) + " " + currency
+}
+
+//
+// FormatAmount
+//  @Description: 按照币种的小数位数格式化金额，不带币种，比如 "12.30" "1200"
+//  @param amount
+//  @param currency
+//  @return string
+//
+func FormatAmount(amount int64, currency string) string {
+	if MinorUnit(currency) == 2 {
+		return Format(amount)
+	}
+	return strconv.FormatInt(amount, 10)
+}
+
+//
+// RateProvider
+//  @Description: 汇率的提供者，可以是配置中的静态汇率，也可以是外部的汇率服务
+//
+type RateProvider interface {
+	// Base 基准货币
+	Base() string
+	// Rate 1 单位的 from 可以兑换多少 to
+	Rate(from, to string) (float64, error)
+}
+
+//
+// StaticRateProvider
+//  @Description: 使用固定汇率表的汇率提供者，汇率都是相对于基准货币的
+//
+type StaticRateProvider struct {
+	base  string
+	rates map[string]float64
+}
+
+//
+// NewStaticRateProvider
+//  @Description: 新建固定汇率的提供者
+//  @param base 基准货币
+//  @param rates 1 单位基准货币可以兑换多少该币种
+//  @return *StaticRateProvider
+//
+func NewStaticRateProvider(base string, rates map[string]float64) *StaticRateProvider {
+	base = NormalizeCurrency(base)
+	if base == "" {
+		base = DefaultCurrency
+	}
+	provider := &StaticRateProvider{
+		base:  base,
+		rates: map[string]float64{base: 1},
+	}
+	// viper 会把 key 转为小写
+	for currency, rate := range rates {
+		if rate > 0 {
+			provider.rates[NormalizeCurrency(currency)] = rate
+		}
+	}
+	return provider
+}
+
+func (provider *StaticRateProvider) Base() string {
+	return provider.base
+}
+
+func (provider *StaticRateProvider) Rate(from, to string) (float64, error) {
+	fromRate, ok := provider.rates[NormalizeCurrency(from)]
+	if !ok {
+		return 0, ErrUnsupportedCurrency
+	}
+	toRate, ok := provider.rates[NormalizeCurrency(to)]
+	if !ok {
+		return 0, ErrUnsupportedCurrency
+	}
+	return toRate / fromRate, nil
+}
+
+//
+// ConvertByRate
+//  @Description: 按照给定的汇率转换金额，会处理两个币种小数位数不同的情况
+//  @param amount from 币种的最小单位
+//  @param rate 1 单位的 from 可以兑换多少 to
+//  @param from
+//  @param to
+//  @return int64 to 币种的最小单位
+//
+func ConvertByRate(amount int64, rate float64, from, to string) int64 {
+	if NormalizeCurrency(from) == NormalizeCurrency(to) {
+		return amount
+	}
+	return ApplyRate(amount, rate*math.Pow10(MinorUnit(to)-MinorUnit(from)))
+}
+
+//
+// Convert
+//  @Description: 使用汇率提供者转换金额
+//  @param amount
+//  @param from
+//  @param to
+//  @param provider
+//  @return int64 转换后的金额
+//  @return float64 使用的汇率
+//  @return error
+//
+func Convert(amount int64, from, to string, provider RateProvider) (int64, float64, error) {
+	rate, err := provider.Rate(from, to)
+	if err != nil {
+		return 0, 0, err
+	}
+	return ConvertByRate(amount, rate, from, to), rate, nil
+}
@@ -0,0 +1,77 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestProvider() *StaticRateProvider {
+	// viper 读出来的 key 是小写的
+	return NewStaticRateProvider("cny", map[string]float64{
+		"usd": 0.14,
+		"jpy": 20,
+		"eur": 0,
+	})
+}
+
+func TestStaticRateProvider(t *testing.T) {
+	provider := newTestProvider()
+	require.Equal(t, "CNY", provider.Base())
+
+	rate, err := provider.Rate("CNY", "USD")
+	require.NoError(t, err)
+	require.Equal(t, 0.14, rate)
+
+	rate, err = provider.Rate("USD", "CNY")
+	require.NoError(t, err)
+	require.InDelta(t, 1/0.14, rate, 1e-9)
+
+	rate, err = provider.Rate("cny", "cny")
+	require.NoError(t, err)
+	require.Equal(t, 1.0, rate)
+
+	// 汇率不合法的币种被忽略
+	_, err = provider.Rate("CNY", "EUR")
+	require.ErrorIs(t, err, ErrUnsupportedCurrency)
+	_, err = provider.Rate("GBP", "CNY")
+	require.ErrorIs(t, err, ErrUnsupportedCurrency)
+}
+
+func TestConvert(t *testing.T) {
+	provider := newTestProvider()
+
+	// 100.00 CNY = 14.00 USD
+	amount, rate, err := Convert(10000, "CNY", "USD", provider)
+	require.NoError(t, err)
+	require.Equal(t, int64(1400), amount)
+	require.Equal(t, 0.14, rate)
+
+	// 日元没有小数 100.00 CNY = 2000 JPY
+	amount, _, err = Convert(10000, "CNY", "JPY", provider)
+	require.NoError(t, err)
+	require.Equal(t, int64(2000), amount)
+
+	amount, _, err = Convert(2000, "JPY", "CNY", provider)
+	require.NoError(t, err)
+	require.Equal(t, int64(10000), amount)
+
+	// 相同的币种不转换
+	require.Equal(t, int64(123), ConvertByRate(123, 2, "usd", "USD"))
+
+	_, _, err = Convert(10000, "CNY", "GBP", provider)
+	require.ErrorIs(t, err, ErrUnsupportedCurrency)
+}
+
+func TestMinorUnit(t *testing.T) {
+	require.Equal(t, 2, MinorUnit("CNY"))
+	require.Equal(t, 0, MinorUnit("jpy"))
+	require.Equal(t, int64(1200), FromMajor(1200, "JPY"))
+	require.Equal(t, int64(1999), FromMajor(19.99, "USD"))
+	require.Equal(t, 1200.0, ToMajor(1200, "JPY"))
+	require.Equal(t, 19.99, ToMajor(1999, "USD"))
+	require.Equal(t, "19.99 USD", FormatCurrency(1999, "usd"))
+	require.Equal(t, "1200 JPY", FormatCurrency(1200, "JPY"))
+	require.Equal(t, "19.99", FormatAmount(1999, "USD"))
+	require.Equal(t, "1200", FormatAmount(1200, "JPY"))
+}
@@ -0,0 +1,139 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// CentsPerYuan 金额统一使用最小的货币单位 分 保存和计算
+const CentsPerYuan = 100
+
+// ErrInvalidAmount 金额的格式不正确
+var ErrInvalidAmount = errors.New("金额的格式不正确")
+
+//
+// Parse
+//  @Description: 将 "12.30" 这种十进制的金额解析为分，超过两位的小数四舍五入
+//  @param s
+//  @return int64
+//  @return error
+//
+func Parse(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+	if s == "" {
+		return 0, ErrInvalidAmount
+	}
+
+	integer, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		integer, fraction = s[:i], s[i+1:]
+	}
+	if integer == "" {
+		integer = "0"
+	}
+	for _, c := range integer + fraction {
+		if c < '0' || c > '9' {
+			return 0, ErrInvalidAmount
+		}
+	}
+
+	yuan, err := strconv.ParseInt(integer, 10, 64)
+	if err != nil || yuan > math.MaxInt64/CentsPerYuan-1 {
+		return 0, ErrInvalidAmount
+	}
+	// 补齐两位小数，多出来的部分用于四舍五入
+	fraction += "000"
+	cents := yuan*CentsPerYuan + int64(fraction[0]-'0')*10 + int64(fraction[1]-'0')
+	if fraction[2] >= '5' {
+		cents++
+	}
+	if negative {
+		cents = -cents
+	}
+	return cents, nil
+}
+
+//
+// FromYuan
+//  @Description: 将以元为单位的浮点数转换为分，使用浮点数最短的十进制表示再解析，避免 0.285*100 这种误差
+//  @param yuan
+//  @return int64
+//
+func FromYuan(yuan float64) int64 {
+	cents, err := Parse(strconv.FormatFloat(yuan, 'f', -1, 64))
+	if err != nil {
+		// NaN 和 Inf 这种值没有意义
+		return 0
+	}
+	return cents
+}
+
+//
+// ToYuan
+//  @Description: 将分转换为以元为单位的浮点数，只用于兼容旧的接口，不要再用它参与计算
+//  @param cents
+//  @return float64
+//
+func ToYuan(cents int64) float64 {
+	return float64(cents) / CentsPerYuan
+}
+
+//
+// Format
+//  @Description: 将分格式化为 "12.30"
+//  @param cents
+//  @return string
+//
+func Format(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	fraction := strconv.FormatInt(cents%CentsPerYuan, 10)
+	if len(fraction) < 2 {
+		fraction = "0" + fraction
+	}
+	return sign + strconv.FormatInt(cents/CentsPerYuan, 10) + "." + fraction
+}
+
+//
+// Mul
+//  @Description: 单价乘以数量
+//  @param cents
+//  @param nums
+//  @return int64
+//
+func Mul(cents int64, nums int32) int64 {
+	return cents * int64(nums)
+}
+
+//
+// ApplyRate
+//  @Description: 金额乘以一个比例，比如折扣率和汇率，结果四舍五入到分
+//  @param cents
+//  @param rate
+//  @return int64
+//
+func ApplyRate(cents int64, rate float64) int64 {
+	return int64(math.Round(float64(cents) * rate))
+}
+
+//
+// Share
+//  @Description: 按照 part/whole 的比例分摊 total，结果四舍五入到分
+//  @param total
+//  @param part
+//  @param whole
+//  @return int64
+//
+func Share(total, part, whole int64) int64 {
+	if whole == 0 {
+		return 0
+	}
+	return (2*total*part + whole) / (2 * whole)
+}
@@ -0,0 +1,73 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFloatDrift(t *testing.T) {
+	// 浮点数会有误差，分不会
+	a, b := 0.1, 0.2
+	require.NotEqual(t, 0.3, a+b)
+	require.Equal(t, FromYuan(0.3), FromYuan(0.1)+FromYuan(0.2))
+	require.Equal(t, int64(30), FromYuan(0.1)+FromYuan(0.2))
+	require.Equal(t, "0.30", Format(FromYuan(0.1)+FromYuan(0.2)))
+
+	var sum int64
+	for i := 0; i < 10; i++ {
+		sum += FromYuan(0.1)
+	}
+	require.Equal(t, int64(100), sum)
+
+	require.Equal(t, int64(29), FromYuan(0.285))
+	require.Equal(t, int64(1999), FromYuan(float64(float32(19.99))))
+	require.Zero(t, FromYuan(math.NaN()))
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		in    string
+		cents int64
+		ok    bool
+	}{
+		{"0.1", 10, true},
+		{"0.2", 20, true},
+		{"12", 1200, true},
+		{"12.3", 1230, true},
+		{".5", 50, true},
+		{"-1.05", -105, true},
+		{"1.005", 101, true},
+		{"1.004", 100, true},
+		{"", 0, false},
+		{"abc", 0, false},
+		{"1.2.3", 0, false},
+		{"99999999999999999999", 0, false},
+	}
+	for _, tc := range testCases {
+		cents, err := Parse(tc.in)
+		if !tc.ok {
+			require.ErrorIs(t, err, ErrInvalidAmount, tc.in)
+			continue
+		}
+		require.NoError(t, err, tc.in)
+		require.Equal(t, tc.cents, cents, tc.in)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	require.Equal(t, "0.00", Format(0))
+	require.Equal(t, "0.05", Format(5))
+	require.Equal(t, "12.30", Format(1230))
+	require.Equal(t, "-1.05", Format(-105))
+	require.Equal(t, 12.3, ToYuan(1230))
+}
+
+func TestArithmetic(t *testing.T) {
+	require.Equal(t, int64(3000), Mul(1000, 3))
+	require.Equal(t, int64(6666), ApplyRate(8333, 0.8))
+	require.Equal(t, int64(333), Share(1000, 1, 3))
+	require.Equal(t, int64(667), Share(1000, 2, 3))
+	require.Zero(t, Share(1000, 1, 0))
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/o1egl/paseto"
+
+	"github.com/jimyag/shop/common/utils/uuid"
 )
 
 //
@@ -12,10 +14,11 @@ import (
 //  @Description: is a PASETO token maker
 //
 type PasetoMaker struct {
-	pastor     *paseto.V2
-	privateKey ed25519.PrivateKey
-	publicKey  ed25519.PublicKey
-	duration   time.Duration
+	pastor          *paseto.V2
+	privateKey      ed25519.PrivateKey
+	publicKey       ed25519.PublicKey
+	duration        time.Duration
+	refreshDuration time.Duration
 }
 
 //
@@ -23,51 +26,114 @@ type PasetoMaker struct {
 //  @Description: creates a new PasetoMaker
 //  @param privateKey 私钥
 //  @param publicKey 公钥
-//  @param duration 有效时间 小时
+//  @param duration access token 的有效时间
+//  @param refreshDuration refresh token 的有效时间
 //  @return *PasetoMaker
 //  @return error
 //
-func NewPasetoMaker(privateKey ed25519.PrivateKey, publicKey ed25519.PublicKey, duration time.Duration) (*PasetoMaker, error) {
+func NewPasetoMaker(privateKey ed25519.PrivateKey, publicKey ed25519.PublicKey, duration time.Duration, refreshDuration time.Duration) (*PasetoMaker, error) {
 	maker := &PasetoMaker{
-		pastor:     paseto.NewV2(),
-		privateKey: privateKey,
-		publicKey:  publicKey,
-		duration:   duration,
+		pastor:          paseto.NewV2(),
+		privateKey:      privateKey,
+		publicKey:       publicKey,
+		duration:        duration,
+		refreshDuration: refreshDuration,
 	}
 	return maker, nil
 }
 
 //
 // CreateToken
-//  @Description: 使用载荷生成token
+//  @Description: 使用载荷生成 access token，会设置载荷的ID、类型和有效期
 //  @receiver maker
 //  @param payload
 //  @return string
 //  @return error
 //
 func (maker *PasetoMaker) CreateToken(payload *Payload) (string, error) {
+	return maker.createToken(payload, AccessToken, maker.duration)
+}
+
+//
+// CreateRefreshToken
+//  @Description: 使用载荷生成 refresh token，不会修改传入的载荷
+//  @receiver maker
+//  @param payload
+//  @return string
+//  @return *Payload refresh token 的载荷
+//  @return error
+//
+func (maker *PasetoMaker) CreateRefreshToken(payload *Payload) (string, *Payload, error) {
+	refreshPayload := *payload
+	token, err := maker.createToken(&refreshPayload, RefreshToken, maker.refreshDuration)
+	return token, &refreshPayload, err
+}
+
+//
+// CreateChallengeToken
+//  @Description: 密码验证通过后生成两步验证的 challenge token，会设置载荷的ID、类型和有效期
+//  @receiver maker
+//  @param payload
+//  @return string
+//  @return error
+//
+func (maker *PasetoMaker) CreateChallengeToken(payload *Payload) (string, error) {
+	return maker.createToken(payload, Challenge, challengeDuration)
+}
+
+func (maker *PasetoMaker) createToken(payload *Payload, tokenType string, duration time.Duration) (string, error) {
+	payload.ID = uuid.GetUUid().String()
+	payload.Type = tokenType
 	payload.IssuedAt = time.Now()
-	payload.ExpiredAt = time.Now().Add(time.Hour * maker.duration)
+	payload.ExpiredAt = payload.IssuedAt.Add(duration)
 	token, err := maker.pastor.Sign(maker.privateKey, payload, nil)
 	return token, err
 }
 
 //
 // VerifyToken
-//  @Description: 验证token是否合法
+//  @Description: 验证 access token 是否合法
 //  @receiver maker
 //  @param token
 //  @return *Payload
 //  @return error
 //
 func (maker *PasetoMaker) VerifyToken(token string) (*Payload, error) {
+	return maker.verifyToken(token, AccessToken)
+}
+
+//
+// VerifyRefreshToken
+//  @Description: 验证 refresh token 是否合法
+//  @receiver maker
+//  @param token
+//  @return *Payload
+//  @return error
+//
+func (maker *PasetoMaker) VerifyRefreshToken(token string) (*Payload, error) {
+	return maker.verifyToken(token, RefreshToken)
+}
+
+//
+// VerifyChallengeToken
+//  @Description: 验证两步验证的 challenge token 是否合法
+//  @receiver maker
+//  @param token
+//  @return *Payload
+//  @return error
+//
+func (maker *PasetoMaker) VerifyChallengeToken(token string) (*Payload, error) {
+	return maker.verifyToken(token, Challenge)
+}
+
+func (maker *PasetoMaker) verifyToken(token string, tokenType string) (*Payload, error) {
 	payload := &Payload{}
 
 	err := maker.pastor.Verify(token, maker.publicKey, payload, nil)
-	if err != nil {
+	if err != nil || payload.Type != tokenType {
 		return nil, ErrInvalidToken
 	}
-	if payload.Valid() != nil {
+	if err = payload.Valid(); err != nil {
 		return nil, err
 	}
 	return payload, nil
@@ -4,18 +4,24 @@ import (
 	"crypto/ed25519"
 	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
 
-func TestPasetoPublicMaker(t *testing.T) {
+func newTestMaker(t *testing.T, duration time.Duration) *PasetoMaker {
 	b, _ := hex.DecodeString("b4cbfb43df4ce210727d953e4a713307fa19bb7d9f85041438d9e11b942a37741eb9dbbbbc047c03fd70604e0071f0987e16b28b757225c11f00415d0e20b1a2")
 	privateKey := ed25519.PrivateKey(b)
 
 	b, _ = hex.DecodeString("1eb9dbbbbc047c03fd70604e0071f0987e16b28b757225c11f00415d0e20b1a2")
 	publicKey := ed25519.PublicKey(b)
-	maker, err := NewPasetoMaker(privateKey, publicKey, 6)
+	maker, err := NewPasetoMaker(privateKey, publicKey, duration, 24*time.Hour)
 	require.NoError(t, err)
+	return maker
+}
+
+func TestPasetoPublicMaker(t *testing.T) {
+	maker := newTestMaker(t, time.Hour)
 
 	payload := &Payload{
 		UID:  11,
@@ -32,4 +38,64 @@ func TestPasetoPublicMaker(t *testing.T) {
 
 	require.NotZero(t, payloads.UID)
 	require.Equal(t, int32(11), payloads.UID)
+	require.NotEmpty(t, payloads.ID)
+	require.Equal(t, AccessToken, payloads.Type)
+}
+
+func TestPasetoMaker_CreateRefreshToken(t *testing.T) {
+	maker := newTestMaker(t, time.Hour)
+	payload, err := NewPayload(11, 1, nil)
+	require.NoError(t, err)
+
+	accessToken, err := maker.CreateToken(payload)
+	require.NoError(t, err)
+	refreshToken, refreshPayload, err := maker.CreateRefreshToken(payload)
+	require.NoError(t, err)
+	require.Equal(t, payload.Family, refreshPayload.Family)
+	require.NotEqual(t, payload.ID, refreshPayload.ID)
+	require.Equal(t, AccessToken, payload.Type)
+
+	verified, err := maker.VerifyRefreshToken(refreshToken)
+	require.NoError(t, err)
+	require.Equal(t, refreshPayload.ID, verified.ID)
+	require.True(t, verified.ExpiredAt.After(payload.ExpiredAt))
+
+	// 两种 token 不能混用
+	_, err = maker.VerifyToken(refreshToken)
+	require.ErrorIs(t, err, ErrInvalidToken)
+	_, err = maker.VerifyRefreshToken(accessToken)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestPasetoMaker_CreateChallengeToken(t *testing.T) {
+	maker := newTestMaker(t, time.Hour)
+	payload, err := NewPayload(11, 1, nil)
+	require.NoError(t, err)
+
+	challengeToken, err := maker.CreateChallengeToken(payload)
+	require.NoError(t, err)
+	verified, err := maker.VerifyChallengeToken(challengeToken)
+	require.NoError(t, err)
+	require.Equal(t, Challenge, verified.Type)
+	require.Equal(t, payload.UID, verified.UID)
+	require.WithinDuration(t, time.Now().Add(challengeDuration), verified.ExpiredAt, time.Second)
+
+	// challenge token 不能用来访问接口和刷新
+	_, err = maker.VerifyToken(challengeToken)
+	require.ErrorIs(t, err, ErrInvalidToken)
+	_, err = maker.VerifyRefreshToken(challengeToken)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestPasetoMaker_ExpiredToken(t *testing.T) {
+	maker := newTestMaker(t, -time.Minute)
+	token, err := maker.CreateToken(&Payload{UID: 11})
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.ErrorIs(t, err, ErrExpiredToken)
+	require.Nil(t, payload)
+
+	_, err = maker.VerifyToken("v2.public.invalid")
+	require.ErrorIs(t, err, ErrInvalidToken)
 }
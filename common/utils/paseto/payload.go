@@ -5,37 +5,57 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/jimyag/shop/common/utils/uuid"
 )
 
 // 验证token时返回的错误
 var (
 	ErrInvalidToken = errors.New("token is invalid")
 	ErrExpiredToken = errors.New("token has expired")
+	ErrRevokedToken = errors.New("token has been revoked")
+)
+
+// token 的类型
+const (
+	AccessToken  = "access"    // 访问接口使用的 token 有效期短
+	RefreshToken = "refresh"   // 只能用来换取新的 token 每次使用后都会轮换
+	Challenge    = "challenge" // 密码验证通过后等待两步验证 只能用来换取新的 token
 )
 
+// 两步验证需要在这个时间内完成
+const challengeDuration = 5 * time.Minute
+
 //
 // Payload
 //  @Description: token认证的载荷
 //
 type Payload struct {
-	IssuedAt  time.Time
-	ExpiredAt time.Time
-	UID       int32
-	Role      int32
+	ID          string // token 的唯一ID jti
+	Family      string // 同一次登录签发的 token 属于同一个 family，刷新后不变
+	Type        string // token 的类型 access、refresh 或 challenge
+	IssuedAt    time.Time
+	ExpiredAt   time.Time
+	UID         int32
+	Role        int32
+	Permissions []string // 登录时角色拥有的权限
 }
 
 //
 // NewPayload
-//  @Description:
+//  @Description: 登录时生成载荷，开始一个新的 family
 //  @param uid
 //  @param role
+//  @param permissions 角色拥有的权限
 //  @return *Payload
 //  @return error
 //
-func NewPayload(uid int32, role int32) (*Payload, error) {
+func NewPayload(uid int32, role int32, permissions []string) (*Payload, error) {
 	payload := &Payload{
-		UID:  uid,
-		Role: role,
+		Family:      uuid.GetUUid().String(),
+		UID:         uid,
+		Role:        role,
+		Permissions: permissions,
 	}
 	return payload, nil
 }
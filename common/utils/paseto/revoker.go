@@ -0,0 +1,150 @@
+package paseto
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// rotateScript 只有当前的 refresh token 才能轮换，否则说明旧的 refresh token 被重复使用了
+var rotateScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+	return 1
+end
+return 0
+`)
+
+//
+// Revoker
+//  @Description: 在 redis 中记录被吊销的 token，所有服务验证 token 后都需要检查
+//  吊销的粒度是 family（一次登录签发的所有 token）和用户（用户在某个时间前签发的所有 token）
+//
+type Revoker struct {
+	client          *redis.Client
+	refreshDuration time.Duration
+}
+
+//
+// NewRevoker
+//  @Description: 创建 Revoker
+//  @param client
+//  @param refreshDuration refresh token 的有效时间，吊销的记录保存这么久之后所有相关的 token 都已经过期
+//  @return *Revoker
+//
+func NewRevoker(client *redis.Client, refreshDuration time.Duration) *Revoker {
+	return &Revoker{client: client, refreshDuration: refreshDuration}
+}
+
+func familyRevokedKey(family string) string {
+	return "token:revoked:family:" + family
+}
+
+func userRevokedKey(uid int32) string {
+	return fmt.Sprintf("token:revoked:user:%d", uid)
+}
+
+func refreshKey(family string) string {
+	return "token:refresh:" + family
+}
+
+//
+// Check
+//  @Description: 检查 token 是否被吊销
+//  @receiver revoker
+//  @param ctx
+//  @param payload
+//  @return error 被吊销时返回 ErrRevokedToken
+//
+func (revoker *Revoker) Check(ctx context.Context, payload *Payload) error {
+	pipe := revoker.client.Pipeline()
+	familyCmd := pipe.Exists(ctx, familyRevokedKey(payload.Family))
+	userCmd := pipe.Get(ctx, userRevokedKey(payload.UID))
+	_, err := pipe.Exec(ctx)
+	if err != nil && err != redis.Nil {
+		return err
+	}
+	if familyCmd.Val() > 0 {
+		return ErrRevokedToken
+	}
+	if userCmd.Err() == nil {
+		revokedAt, err := strconv.ParseInt(userCmd.Val(), 10, 64)
+		if err != nil {
+			return err
+		}
+		if payload.IssuedAt.UnixNano() <= revokedAt {
+			return ErrRevokedToken
+		}
+	}
+	return nil
+}
+
+//
+// Register
+//  @Description: 登录时记录 family 当前的 refresh token
+//  @receiver revoker
+//  @param ctx
+//  @param refreshPayload
+//  @return error
+//
+func (revoker *Revoker) Register(ctx context.Context, refreshPayload *Payload) error {
+	return revoker.client.Set(ctx, refreshKey(refreshPayload.Family), refreshPayload.ID, revoker.refreshDuration).Err()
+}
+
+//
+// Rotate
+//  @Description: 使用 refresh token 换取新的 token 时，把 family 当前的 refresh token 换成新的
+//  如果使用的不是当前的 refresh token，说明它已经被轮换过，可能已经泄露，吊销整个 family
+//  @receiver revoker
+//  @param ctx
+//  @param oldPayload 使用的 refresh token 的载荷
+//  @param newPayload 新的 refresh token 的载荷
+//  @return error 重复使用时返回 ErrRevokedToken
+//
+func (revoker *Revoker) Rotate(ctx context.Context, oldPayload *Payload, newPayload *Payload) error {
+	ok, err := rotateScript.Run(ctx, revoker.client,
+		[]string{refreshKey(oldPayload.Family)},
+		oldPayload.ID, newPayload.ID, revoker.refreshDuration.Milliseconds(),
+	).Int()
+	if err != nil {
+		return err
+	}
+	if ok == 0 {
+		if err = revoker.RevokeFamily(ctx, oldPayload.Family); err != nil {
+			return err
+		}
+		return ErrRevokedToken
+	}
+	return nil
+}
+
+//
+// RevokeFamily
+//  @Description: 吊销一次登录签发的所有 token，用于退出登录
+//  @receiver revoker
+//  @param ctx
+//  @param family
+//  @return error
+//
+func (revoker *Revoker) RevokeFamily(ctx context.Context, family string) error {
+	pipe := revoker.client.TxPipeline()
+	pipe.Set(ctx, familyRevokedKey(family), 1, revoker.refreshDuration)
+	pipe.Del(ctx, refreshKey(family))
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+//
+// RevokeUser
+//  @Description: 吊销用户在此之前签发的所有 token，用于修改密码等
+//  @receiver revoker
+//  @param ctx
+//  @param uid
+//  @return error
+//
+func (revoker *Revoker) RevokeUser(ctx context.Context, uid int32) error {
+	return revoker.client.Set(ctx, userRevokedKey(uid), time.Now().UnixNano(), revoker.refreshDuration).Err()
+}
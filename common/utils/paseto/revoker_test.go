@@ -0,0 +1,61 @@
+package paseto
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRevoker(t *testing.T) *Revoker {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:36379"})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		t.Skip("redis 不可用", err)
+	}
+	return NewRevoker(client, time.Minute)
+}
+
+func TestRevoker_Rotate(t *testing.T) {
+	revoker := newTestRevoker(t)
+	maker := newTestMaker(t, time.Hour)
+	ctx := context.Background()
+
+	payload, _ := NewPayload(11, 1, nil)
+	_, err := maker.CreateToken(payload)
+	require.NoError(t, err)
+	_, first, err := maker.CreateRefreshToken(payload)
+	require.NoError(t, err)
+	require.NoError(t, revoker.Register(ctx, first))
+	require.NoError(t, revoker.Check(ctx, first))
+
+	_, second, err := maker.CreateRefreshToken(payload)
+	require.NoError(t, err)
+	require.NoError(t, revoker.Rotate(ctx, first, second))
+
+	// 重复使用已经轮换过的 refresh token 会吊销整个 family
+	_, third, err := maker.CreateRefreshToken(payload)
+	require.NoError(t, err)
+	require.ErrorIs(t, revoker.Rotate(ctx, first, third), ErrRevokedToken)
+	require.ErrorIs(t, revoker.Check(ctx, second), ErrRevokedToken)
+	require.ErrorIs(t, revoker.Check(ctx, payload), ErrRevokedToken)
+}
+
+func TestRevoker_RevokeUser(t *testing.T) {
+	revoker := newTestRevoker(t)
+	maker := newTestMaker(t, time.Hour)
+	ctx := context.Background()
+
+	before, _ := NewPayload(12, 1, nil)
+	_, err := maker.CreateToken(before)
+	require.NoError(t, err)
+	require.NoError(t, revoker.RevokeUser(ctx, 12))
+	require.ErrorIs(t, revoker.Check(ctx, before), ErrRevokedToken)
+
+	// 吊销之后签发的 token 不受影响
+	after, _ := NewPayload(12, 1, nil)
+	_, err = maker.CreateToken(after)
+	require.NoError(t, err)
+	require.NoError(t, revoker.Check(ctx, after))
+}
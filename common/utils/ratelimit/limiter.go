@@ -0,0 +1,107 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// slidingWindowScript 删除窗口之外的请求，窗口内的请求没有达到上限时记录这次请求
+var slidingWindowScript = redis.NewScript(`
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', tonumber(ARGV[1]) - tonumber(ARGV[2]))
+if redis.call('ZCARD', KEYS[1]) >= tonumber(ARGV[3]) then
+	return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[4])
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+return 1
+`)
+
+// 内存中的 key 超过这个数量时清理过期的 key
+const sweepThreshold = 10000
+
+//
+// Limiter
+//  @Description: 滑动窗口限流，窗口内的请求记录在 redis 中，多个实例共享
+//  redis 不可用时使用内存中的记录，只在当前实例内限流
+//
+type Limiter struct {
+	client *redis.Client
+	prefix string
+	limit  int
+	window time.Duration
+	seq    uint64
+
+	mu    sync.Mutex
+	local map[string][]time.Time
+}
+
+//
+// NewLimiter
+//  @Description: 创建滑动窗口限流
+//  @param client 为 nil 时只使用内存
+//  @param prefix redis 中 key 的前缀
+//  @param limit 窗口内最多的请求次数
+//  @param window 窗口的大小
+//  @return *Limiter
+//
+func NewLimiter(client *redis.Client, prefix string, limit int, window time.Duration) *Limiter {
+	return &Limiter{
+		client: client,
+		prefix: prefix,
+		limit:  limit,
+		window: window,
+		local:  make(map[string][]time.Time),
+	}
+}
+
+//
+// Allow
+//  @Description: 检查并记录 key 的一次请求
+//  @receiver l
+//  @param ctx
+//  @param key
+//  @return bool 超过限制时返回 false
+//
+func (l *Limiter) Allow(ctx context.Context, key string) bool {
+	now := time.Now()
+	if l.client != nil {
+		member := strconv.FormatInt(now.UnixNano(), 10) + "-" + strconv.FormatUint(atomic.AddUint64(&l.seq, 1), 10)
+		allowed, err := slidingWindowScript.Run(ctx, l.client, []string{l.prefix + key},
+			now.UnixMilli(), l.window.Milliseconds(), l.limit, member).Int()
+		if err == nil {
+			return allowed == 1
+		}
+	}
+	return l.allowLocal(key, now)
+}
+
+func (l *Limiter) allowLocal(key string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.local) > sweepThreshold {
+		for k, requests := range l.local {
+			if len(requests) == 0 || now.Sub(requests[len(requests)-1]) >= l.window {
+				delete(l.local, k)
+			}
+		}
+	}
+
+	requests := l.local[key]
+	start := 0
+	for start < len(requests) && now.Sub(requests[start]) >= l.window {
+		start++
+	}
+	requests = requests[start:]
+	if len(requests) >= l.limit {
+		l.local[key] = requests
+		return false
+	}
+	l.local[key] = append(requests, now)
+	return true
+}
@@ -0,0 +1,124 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// 连续失败的次数保存的时间，超过这个时间没有失败会重新计数
+const failureTTL = 24 * time.Hour
+
+// failScript 增加失败的次数并刷新过期时间
+var failScript = redis.NewScript(`
+local failures = redis.call('INCR', KEYS[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[1])
+return failures
+`)
+
+//
+// Lockout
+//  @Description: 连续失败达到阈值后临时锁定，之后每次失败锁定的时间翻倍，直到最长的锁定时间
+//
+type Lockout struct {
+	client    *redis.Client
+	prefix    string
+	threshold int
+	base      time.Duration
+	max       time.Duration
+}
+
+//
+// NewLockout
+//  @Description: 创建 Lockout
+//  @param client
+//  @param prefix redis 中 key 的前缀
+//  @param threshold 连续失败多少次后锁定
+//  @param base 第一次锁定的时间
+//  @param max 最长的锁定时间
+//  @return *Lockout
+//
+func NewLockout(client *redis.Client, prefix string, threshold int, base time.Duration, max time.Duration) *Lockout {
+	return &Lockout{client: client, prefix: prefix, threshold: threshold, base: base, max: max}
+}
+
+func (l *Lockout) failuresKey(key string) string {
+	return l.prefix + "failures:" + key
+}
+
+func (l *Lockout) lockKey(key string) string {
+	return l.prefix + "locked:" + key
+}
+
+//
+// Locked
+//  @Description: 获得剩余的锁定时间
+//  @receiver l
+//  @param ctx
+//  @param key
+//  @return time.Duration 没有锁定时返回 0
+//  @return error
+//
+func (l *Lockout) Locked(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := l.client.PTTL(ctx, l.lockKey(key)).Result()
+	if err != nil {
+		return 0, err
+	}
+	// key 不存在时 ttl 为负数
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+//
+// Fail
+//  @Description: 记录一次失败，达到阈值后锁定
+//  @receiver l
+//  @param ctx
+//  @param key
+//  @return time.Duration 锁定的时间，没有锁定时返回 0
+//  @return error
+//
+func (l *Lockout) Fail(ctx context.Context, key string) (time.Duration, error) {
+	failures, err := failScript.Run(ctx, l.client, []string{l.failuresKey(key)}, failureTTL.Milliseconds()).Int()
+	if err != nil {
+		return 0, err
+	}
+	duration := l.backoff(failures)
+	if duration == 0 {
+		return 0, nil
+	}
+	return duration, l.client.Set(ctx, l.lockKey(key), failures, duration).Err()
+}
+
+//
+// Reset
+//  @Description: 成功后清除失败的次数和锁定
+//  @receiver l
+//  @param ctx
+//  @param key
+//  @return error
+//
+func (l *Lockout) Reset(ctx context.Context, key string) error {
+	return l.client.Del(ctx, l.failuresKey(key), l.lockKey(key)).Err()
+}
+
+// backoff 连续失败 failures 次后锁定的时间
+func (l *Lockout) backoff(failures int) time.Duration {
+	if failures < l.threshold {
+		return 0
+	}
+	duration := l.base
+	for i := l.threshold; i < failures; i++ {
+		duration *= 2
+		if duration >= l.max {
+			return l.max
+		}
+	}
+	if duration > l.max {
+		return l.max
+	}
+	return duration
+}
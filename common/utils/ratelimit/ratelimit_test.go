@@ -0,0 +1,89 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jimyag/shop/common/utils/test_util"
+)
+
+func newTestClient(t *testing.T) *redis.Client {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:36379"})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		t.Skip("redis 不可用", err)
+	}
+	return client
+}
+
+func testLimiter(t *testing.T, limiter *Limiter) {
+	ctx := context.Background()
+	key := test_util.RandomString(10)
+	for i := 0; i < 3; i++ {
+		require.True(t, limiter.Allow(ctx, key))
+	}
+	require.False(t, limiter.Allow(ctx, key))
+	// 其他的 key 不受影响
+	require.True(t, limiter.Allow(ctx, key+"other"))
+
+	// 窗口过去之后可以再次请求
+	time.Sleep(120 * time.Millisecond)
+	require.True(t, limiter.Allow(ctx, key))
+}
+
+func TestLimiter_Local(t *testing.T) {
+	testLimiter(t, NewLimiter(nil, "test:limit:", 3, 100*time.Millisecond))
+}
+
+func TestLimiter_Redis(t *testing.T) {
+	testLimiter(t, NewLimiter(newTestClient(t), "test:limit:", 3, 100*time.Millisecond))
+}
+
+func TestLimiter_Fallback(t *testing.T) {
+	// redis 不可用时使用内存中的记录
+	client := redis.NewClient(&redis.Options{Addr: "localhost:1", MaxRetries: -1, DialTimeout: 10 * time.Millisecond})
+	testLimiter(t, NewLimiter(client, "test:limit:", 3, 100*time.Millisecond))
+}
+
+func TestLockout_Backoff(t *testing.T) {
+	lockout := NewLockout(nil, "", 3, time.Minute, 10*time.Minute)
+	require.Equal(t, time.Duration(0), lockout.backoff(1))
+	require.Equal(t, time.Duration(0), lockout.backoff(2))
+	require.Equal(t, time.Minute, lockout.backoff(3))
+	require.Equal(t, 2*time.Minute, lockout.backoff(4))
+	require.Equal(t, 8*time.Minute, lockout.backoff(6))
+	require.Equal(t, 10*time.Minute, lockout.backoff(7))
+	require.Equal(t, 10*time.Minute, lockout.backoff(100))
+}
+
+func TestLockout(t *testing.T) {
+	lockout := NewLockout(newTestClient(t), "test:lockout:", 2, time.Second, time.Minute)
+	ctx := context.Background()
+	key := test_util.RandomString(10)
+
+	duration, err := lockout.Fail(ctx, key)
+	require.NoError(t, err)
+	require.Zero(t, duration)
+	remaining, err := lockout.Locked(ctx, key)
+	require.NoError(t, err)
+	require.Zero(t, remaining)
+
+	duration, err = lockout.Fail(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, time.Second, duration)
+	remaining, err = lockout.Locked(ctx, key)
+	require.NoError(t, err)
+	require.Greater(t, remaining, time.Duration(0))
+
+	duration, err = lockout.Fail(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Second, duration)
+
+	require.NoError(t, lockout.Reset(ctx, key))
+	remaining, err = lockout.Locked(ctx, key)
+	require.NoError(t, err)
+	require.Zero(t, remaining)
+}
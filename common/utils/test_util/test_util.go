@@ -116,3 +116,12 @@ func RandomFloat(min, max float32) float32 {
 func RandomPrice() float32 {
 	return RandomFloat(10.0, 2000.0)
 }
+
+//
+// RandomPriceCents
+//  @Description: 随机价格 单位 分
+//  @return int64
+//
+func RandomPriceCents() int64 {
+	return RandomInt(1000, 200000)
+}
@@ -0,0 +1,117 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// 和常用的认证器 App 保持一致 SHA1 6 位数字 30 秒
+const (
+	Digits = 6
+	Period = 30
+	modulo = 1000000 // 10 的 Digits 次方
+	// 允许客户端的时间前后相差一个周期
+	skew = 1
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+//
+// GenerateSecret
+//  @Description: 生成 160 位的随机密钥，使用 base32 编码
+//  @return string
+//  @return error
+//
+func GenerateSecret() (string, error) {
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(secret), nil
+}
+
+//
+// URI
+//  @Description: 生成 otpauth 的 URI，可以转换为二维码让认证器 App 扫描
+//  @param issuer 发行方 例如 shop
+//  @param account 账号 例如邮箱
+//  @param secret
+//  @return string
+//
+func URI(issuer string, account string, secret string) string {
+	values := url.Values{}
+	values.Set("secret", secret)
+	values.Set("issuer", issuer)
+	values.Set("algorithm", "SHA1")
+	values.Set("digits", fmt.Sprint(Digits))
+	values.Set("period", fmt.Sprint(Period))
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + values.Encode()
+}
+
+//
+// Step
+//  @Description: 时间所在的周期
+//  @param t
+//  @return int64
+//
+func Step(t time.Time) int64 {
+	return t.Unix() / Period
+}
+
+//
+// Code
+//  @Description: 生成某个周期的验证码
+//  @param secret
+//  @param step
+//  @return string
+//  @return error 密钥不是合法的 base32 时返回错误
+//
+func Code(secret string, step int64) (string, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", err
+	}
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%modulo), nil
+}
+
+//
+// Validate
+//  @Description: 检查验证码，允许前后相差一个周期
+//  @param secret
+//  @param code
+//  @param t
+//  @return int64 验证码所在的周期，用来防止同一个验证码被重复使用
+//  @return bool
+//
+func Validate(secret string, code string, t time.Time) (int64, bool) {
+	if len(code) != Digits {
+		return 0, false
+	}
+	current := Step(t)
+	for step := current - skew; step <= current+skew; step++ {
+		expected, err := Code(secret, step)
+		if err != nil {
+			return 0, false
+		}
+		if hmac.Equal([]byte(expected), []byte(code)) {
+			return step, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,58 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCode(t *testing.T) {
+	// RFC 6238 附录 B 中 SHA1 的测试数据，取后 6 位
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	cases := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for unix, expected := range cases {
+		code, err := Code(secret, Step(time.Unix(unix, 0)))
+		require.NoError(t, err)
+		require.Equal(t, expected, code)
+	}
+
+	_, err := Code("不是base32", 1)
+	require.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	require.Len(t, secret, 32)
+
+	now := time.Now()
+	code, err := Code(secret, Step(now))
+	require.NoError(t, err)
+	step, ok := Validate(secret, code, now)
+	require.True(t, ok)
+	require.Equal(t, Step(now), step)
+
+	// 允许前后相差一个周期
+	_, ok = Validate(secret, code, now.Add(Period*time.Second))
+	require.True(t, ok)
+	_, ok = Validate(secret, code, now.Add(-3*Period*time.Second))
+	require.False(t, ok)
+	_, ok = Validate(secret, "12345", now)
+	require.False(t, ok)
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("shop", "a@b.com", "ABC")
+	require.True(t, strings.HasPrefix(uri, "otpauth://totp/shop:a@b.com?"))
+	require.Contains(t, uri, "secret=ABC")
+	require.Contains(t, uri, "issuer=shop")
+}